@@ -0,0 +1,106 @@
+package conns
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Chained IAM Role assumption.
+// The first IAM Role in the provider's assume_role configuration is assumed by aws-sdk-go-base
+// using the base credentials (static, profile, web identity, etc.).
+// Each subsequent IAM Role is assumed using the credentials of the IAM Role before it.
+
+// assumeRoleHopError returns an error naming the hop in the IAM Role chain that failed.
+func assumeRoleHopError(hop, hops int, roleARN string, err error) error {
+	return fmt.Errorf("assuming IAM Role (%s) (hop %d of %d): %w", roleARN, hop, hops, err)
+}
+
+// assumeRoleChain assumes each IAM Role after the first in turn, using the credentials from the one before it.
+// The specified AWS SDK for Go v2 configuration must use the first IAM Role's credentials.
+// Returns a copy of the configuration that uses the last IAM Role's credentials.
+func (c *Config) assumeRoleChain(ctx context.Context, cfg aws_sdkv2.Config) (aws_sdkv2.Config, error) {
+	hops := len(c.AssumeRole)
+
+	for i := 1; i < hops; i++ {
+		ar := c.AssumeRole[i]
+		hop := i + 1
+
+		if ar == nil || ar.RoleARN == "" {
+			return cfg, assumeRoleHopError(hop, hops, "", fmt.Errorf("role ARN not set"))
+		}
+
+		log.Printf("[INFO] Assuming IAM Role (%s) (hop %d of %d)", ar.RoleARN, hop, hops)
+
+		provider := stscreds.NewAssumeRoleProvider(c.stsClient(cfg), ar.RoleARN, func(opts *stscreds.AssumeRoleOptions) {
+			assumeRoleOptions(opts, ar)
+		})
+
+		if _, err := provider.Retrieve(ctx); err != nil {
+			return cfg, assumeRoleHopError(hop, hops, ar.RoleARN, err)
+		}
+
+		cfg = cfg.Copy()
+		cfg.Credentials = aws_sdkv2.NewCredentialsCache(provider)
+	}
+
+	return cfg, nil
+}
+
+// stsClient returns an STS client for the specified AWS SDK for Go v2 configuration,
+// honoring the provider's STS Region and endpoint.
+func (c *Config) stsClient(cfg aws_sdkv2.Config) *sts.Client {
+	return sts.NewFromConfig(cfg, func(o *sts.Options) {
+		o.APIOptions = c.sdkv2APIOptions(names.STS, o.APIOptions)
+		o.Retryer = c.sdkv2Retryer(names.STS, o.Retryer)
+
+		if c.STSRegion != "" {
+			o.Region = c.STSRegion
+		}
+
+		if endpoint := c.Endpoints[names.STS]; endpoint != "" {
+			o.EndpointResolver = sts.EndpointResolverFromURL(endpoint)
+		}
+	})
+}
+
+func assumeRoleOptions(opts *stscreds.AssumeRoleOptions, ar *awsbase.AssumeRole) {
+	opts.RoleSessionName = ar.SessionName
+	opts.Duration = ar.Duration
+
+	if ar.ExternalID != "" {
+		opts.ExternalID = aws_sdkv2.String(ar.ExternalID)
+	}
+
+	if ar.Policy != "" {
+		opts.Policy = aws_sdkv2.String(ar.Policy)
+	}
+
+	for _, v := range ar.PolicyARNs {
+		opts.PolicyARNs = append(opts.PolicyARNs, ststypes.PolicyDescriptorType{
+			Arn: aws_sdkv2.String(v),
+		})
+	}
+
+	for k, v := range ar.Tags {
+		opts.Tags = append(opts.Tags, ststypes.Tag{
+			Key:   aws_sdkv2.String(k),
+			Value: aws_sdkv2.String(v),
+		})
+	}
+
+	if len(ar.TransitiveTagKeys) > 0 {
+		opts.TransitiveTagKeys = ar.TransitiveTagKeys
+	}
+
+	if ar.SourceIdentity != "" {
+		opts.SourceIdentity = aws_sdkv2.String(ar.SourceIdentity)
+	}
+}
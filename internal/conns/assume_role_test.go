@@ -0,0 +1,194 @@
+package conns
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	testAssumeRoleResponse = `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleResult>
+    <AssumedRoleUser>
+      <Arn>%[1]s/session</Arn>
+      <AssumedRoleId>ARO123EXAMPLE123:session</AssumedRoleId>
+    </AssumedRoleUser>
+    <Credentials>
+      <AccessKeyId>%[2]s</AccessKeyId>
+      <SecretAccessKey>SECRET</SecretAccessKey>
+      <SessionToken>TOKEN</SessionToken>
+      <Expiration>2099-12-31T23:59:59Z</Expiration>
+    </Credentials>
+  </AssumeRoleResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</AssumeRoleResponse>`
+
+	testAssumeRoleAccessDeniedResponse = `<ErrorResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <Error>
+    <Type>Sender</Type>
+    <Code>AccessDenied</Code>
+    <Message>Not authorized to perform sts:AssumeRole</Message>
+  </Error>
+  <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+</ErrorResponse>`
+)
+
+// newTestSTSServer returns a mock STS endpoint.
+// Each IAM Role can be assumed only by the credentials of the specified IAM Role (access key ID) before it.
+func newTestSTSServer(t *testing.T, callers map[string]string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		roleARN := r.FormValue("RoleArn")
+		accessKeyID := roleARN[strings.LastIndex(roleARN, "/")+1:] // The IAM Role name.
+
+		if caller, ok := callers[roleARN]; !ok || !strings.Contains(r.Header.Get("Authorization"), "Credential="+caller+"/") {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, testAssumeRoleAccessDeniedResponse)
+			return
+		}
+
+		fmt.Fprintf(w, testAssumeRoleResponse, roleARN, accessKeyID)
+	}))
+
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestConfigAssumeRoleChain(t *testing.T) {
+	t.Parallel()
+
+	const (
+		roleARN1 = "arn:aws:iam::111111111111:role/broker"
+		roleARN2 = "arn:aws:iam::222222222222:role/workload"
+		roleARN3 = "arn:aws:iam::333333333333:role/admin"
+	)
+
+	// The base configuration uses the first IAM Role's credentials.
+	server := newTestSTSServer(t, map[string]string{
+		roleARN2: "broker",
+		roleARN3: "workload",
+	})
+
+	testCases := []struct {
+		Name          string
+		AssumeRole    []*awsbase.AssumeRole
+		ExpectedKeyID string
+		ExpectedError string
+	}{
+		{
+			Name: "single role",
+			AssumeRole: []*awsbase.AssumeRole{
+				{RoleARN: roleARN1},
+			},
+			ExpectedKeyID: "broker",
+		},
+		{
+			Name: "two roles",
+			AssumeRole: []*awsbase.AssumeRole{
+				{RoleARN: roleARN1},
+				{RoleARN: roleARN2, SessionName: "session", ExternalID: "external", Tags: map[string]string{"key": "value"}},
+			},
+			ExpectedKeyID: "workload",
+		},
+		{
+			Name: "three roles",
+			AssumeRole: []*awsbase.AssumeRole{
+				{RoleARN: roleARN1},
+				{RoleARN: roleARN2},
+				{RoleARN: roleARN3, TransitiveTagKeys: []string{"key"}},
+			},
+			ExpectedKeyID: "admin",
+		},
+		{
+			Name: "second hop fails",
+			AssumeRole: []*awsbase.AssumeRole{
+				{RoleARN: roleARN1},
+				{RoleARN: roleARN3},
+			},
+			ExpectedError: fmt.Sprintf("assuming IAM Role (%s) (hop 2 of 2)", roleARN3),
+		},
+		{
+			Name: "third hop fails",
+			AssumeRole: []*awsbase.AssumeRole{
+				{RoleARN: roleARN1},
+				{RoleARN: roleARN2},
+				{RoleARN: roleARN2},
+			},
+			ExpectedError: fmt.Sprintf("assuming IAM Role (%s) (hop 3 of 3)", roleARN2),
+		},
+		{
+			Name: "missing role ARN",
+			AssumeRole: []*awsbase.AssumeRole{
+				{RoleARN: roleARN1},
+				{},
+			},
+			ExpectedError: "(hop 2 of 2): role ARN not set",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			c := &Config{
+				AssumeRole: testCase.AssumeRole,
+				Endpoints: map[string]string{
+					names.STS: server.URL,
+				},
+			}
+			cfg := aws_sdkv2.Config{
+				Credentials: credentials.NewStaticCredentialsProvider("broker", "SECRET", "TOKEN"),
+				HTTPClient:  server.Client(),
+				Region:      "us-west-2", //lintignore:AWSAT003
+			}
+
+			cfg, err := c.assumeRoleChain(ctx, cfg)
+
+			if testCase.ExpectedError != "" {
+				if err == nil {
+					t.Fatalf("expected error containing %q, got none", testCase.ExpectedError)
+				}
+
+				if !strings.Contains(err.Error(), testCase.ExpectedError) {
+					t.Fatalf("expected error containing %q, got %q", testCase.ExpectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			creds, err := cfg.Credentials.Retrieve(ctx)
+
+			if err != nil {
+				t.Fatalf("retrieving credentials: %s", err)
+			}
+
+			if got, want := creds.AccessKeyID, testCase.ExpectedKeyID; got != want {
+				t.Errorf("AccessKeyID: got %s, expected %s", got, want)
+			}
+		})
+	}
+}
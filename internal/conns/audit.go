@@ -0,0 +1,265 @@
+package conns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	smithymiddleware "github.com/aws/smithy-go/middleware"
+)
+
+// API audit log.
+// When enabled, one JSON record is written to the audit log file for each AWS API request (HTTP attempt)
+// made through the provider's shared HTTP client, covering both AWS SDK for Go v1 and v2 API clients.
+
+const (
+	// auditMaxBodySize is the maximum number of bytes of request or response body read for the audit log.
+	auditMaxBodySize = 64 * 1024
+)
+
+// AuditLogConfig configures the API audit log.
+type AuditLogConfig struct {
+	File          string
+	IncludeBodies bool
+}
+
+// auditRecord is a single API audit log record.
+type auditRecord struct {
+	Time         time.Time `json:"time"`
+	Service      string    `json:"service,omitempty"`
+	Operation    string    `json:"operation,omitempty"`
+	Region       string    `json:"region,omitempty"`
+	ResourceType string    `json:"resource_type,omitempty"`
+	ResourceID   string    `json:"resource_id,omitempty"`
+	Method       string    `json:"method"`
+	Host         string    `json:"host"`
+	LatencyMS    int64     `json:"latency_ms"`
+	RetryCount   int       `json:"retry_count"`
+	HTTPStatus   int       `json:"http_status,omitempty"`
+	ErrorCode    string    `json:"error_code,omitempty"`
+	Error        string    `json:"error,omitempty"`
+	RequestBody  *string   `json:"request_body,omitempty"`
+	ResponseBody *string   `json:"response_body,omitempty"`
+}
+
+// auditLogger writes API audit log records to a file.
+type auditLogger struct {
+	includeBodies bool
+	mu            sync.Mutex
+	w             io.Writer
+}
+
+func (l *auditLogger) write(record *auditRecord) {
+	b, err := json.Marshal(record)
+
+	if err != nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	_, _ = l.w.Write(append(b, '\n'))
+}
+
+var (
+	auditLogFilesMu sync.Mutex
+	auditLogFiles   = make(map[string]*os.File)
+)
+
+// newAuditLogger returns an API audit logger for the specified configuration.
+// Audit log files are shared by all provider instances in the process and are never closed.
+func newAuditLogger(c *AuditLogConfig) (*auditLogger, error) {
+	auditLogFilesMu.Lock()
+	defer auditLogFilesMu.Unlock()
+
+	f, ok := auditLogFiles[c.File]
+
+	if !ok {
+		var err error
+
+		f, err = os.OpenFile(c.File, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+
+		if err != nil {
+			return nil, fmt.Errorf("opening API audit log file (%s): %w", c.File, err)
+		}
+
+		auditLogFiles[c.File] = f
+	}
+
+	return &auditLogger{
+		includeBodies: c.IncludeBodies,
+		w:             f,
+	}, nil
+}
+
+// auditOperation holds the details of an AWS API operation.
+// A single operation may result in multiple HTTP attempts.
+type auditOperation struct {
+	attempts  int32
+	operation string
+	region    string
+	service   string
+}
+
+type auditOperationKey struct{}
+
+func withAuditOperation(ctx context.Context, op *auditOperation) context.Context {
+	return context.WithValue(ctx, auditOperationKey{}, op)
+}
+
+// auditTransport is an http.RoundTripper that writes an API audit log record for each request.
+type auditTransport struct {
+	logger *auditLogger
+	next   http.RoundTripper
+}
+
+func (t *auditTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	record := &auditRecord{
+		Host:   req.URL.Host,
+		Method: req.Method,
+		Time:   time.Now().UTC(),
+	}
+
+	if op, ok := ctx.Value(auditOperationKey{}).(*auditOperation); ok {
+		record.Operation = op.operation
+		record.Region = op.region
+		record.RetryCount = int(atomic.AddInt32(&op.attempts, 1)) - 1
+		record.Service = op.service
+	}
+
+	if v, ok := resourceFromContext(ctx); ok {
+		record.ResourceID = v.id
+		record.ResourceType = v.resourceType
+	}
+
+	if t.logger.includeBodies && req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			b, _ := io.ReadAll(io.LimitReader(body, auditMaxBodySize))
+			body.Close()
+			record.RequestBody = aws.String(string(b))
+		}
+	}
+
+	resp, err := t.next.RoundTrip(req)
+
+	record.LatencyMS = time.Since(record.Time).Milliseconds()
+
+	if err != nil {
+		record.Error = err.Error()
+	} else {
+		record.HTTPStatus = resp.StatusCode
+
+		var body []byte
+
+		if resp.StatusCode >= http.StatusMultipleChoices || t.logger.includeBodies {
+			body = peekResponseBody(resp)
+		}
+
+		if resp.StatusCode >= http.StatusMultipleChoices {
+			record.ErrorCode = auditErrorCode(resp.Header, body)
+		}
+
+		if t.logger.includeBodies {
+			record.ResponseBody = aws.String(string(body))
+		}
+	}
+
+	t.logger.write(record)
+
+	return resp, err
+}
+
+// peekResponseBody reads the start of the specified response's body, leaving the body unchanged for the caller.
+func peekResponseBody(resp *http.Response) []byte {
+	if resp.Body == nil {
+		return nil
+	}
+
+	b, _ := io.ReadAll(io.LimitReader(resp.Body, auditMaxBodySize))
+
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{
+		Reader: io.MultiReader(bytes.NewReader(b), resp.Body),
+		Closer: resp.Body,
+	}
+
+	return b
+}
+
+var (
+	auditJSONErrorCodeRegexp = regexp.MustCompile(`"(?:__type|code|Code)"\s*:\s*"([^"]+)"`)
+	auditXMLErrorCodeRegexp  = regexp.MustCompile(`<Code>([^<]+)</Code>`)
+)
+
+// auditErrorCode returns the AWS error code from an API error response.
+func auditErrorCode(header http.Header, body []byte) string {
+	code := header.Get("X-Amzn-Errortype")
+
+	if code == "" {
+		if m := auditJSONErrorCodeRegexp.FindSubmatch(body); m != nil {
+			code = string(m[1])
+		} else if m := auditXMLErrorCodeRegexp.FindSubmatch(body); m != nil {
+			code = string(m[1])
+		}
+	}
+
+	// e.g. "ValidationException:http://internal.amazon.com/coral/com.amazon.coral.validate/" or
+	// "com.amazonaws.dynamodb.v20120810#ResourceNotFoundException".
+	if i := strings.Index(code, ":"); i >= 0 {
+		code = code[:i]
+	}
+	if i := strings.LastIndex(code, "#"); i >= 0 {
+		code = code[i+1:]
+	}
+
+	return code
+}
+
+// configureAuditLog enables the API audit log for the specified AWS SDK for Go v1 session and v2 configuration.
+// Must be called before any API clients are created.
+func configureAuditLog(logger *auditLogger, sess *session.Session, cfg *aws_sdkv2.Config) {
+	wrapTransport(sess, cfg, func(next http.RoundTripper) http.RoundTripper {
+		return &auditTransport{logger: logger, next: next}
+	})
+
+	sess.Handlers.Build.PushBackNamed(request.NamedHandler{
+		Name: "tf-aws.AuditLog",
+		Fn: func(r *request.Request) {
+			r.SetContext(withAuditOperation(r.Context(), &auditOperation{
+				operation: r.Operation.Name,
+				region:    aws.StringValue(r.Config.Region),
+				service:   r.ClientInfo.ServiceID,
+			}))
+		},
+	})
+
+	cfg.APIOptions = append(cfg.APIOptions, func(stack *smithymiddleware.Stack) error {
+		return stack.Initialize.Add(smithymiddleware.InitializeMiddlewareFunc("tf-aws.AuditLog", func(ctx context.Context, in smithymiddleware.InitializeInput, next smithymiddleware.InitializeHandler) (smithymiddleware.InitializeOutput, smithymiddleware.Metadata, error) {
+			ctx = withAuditOperation(ctx, &auditOperation{
+				operation: awsmiddleware_sdkv2.GetOperationName(ctx),
+				region:    awsmiddleware_sdkv2.GetRegion(ctx),
+				service:   awsmiddleware_sdkv2.GetServiceID(ctx),
+			})
+
+			return next.HandleInitialize(ctx, in)
+		}), smithymiddleware.After)
+	})
+}
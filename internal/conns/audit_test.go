@@ -0,0 +1,161 @@
+package conns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestAuditErrorCode(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name     string
+		Header   http.Header
+		Body     string
+		Expected string
+	}{
+		{
+			Name: "no error code",
+		},
+		{
+			Name:     "header",
+			Header:   http.Header{"X-Amzn-Errortype": []string{"ValidationException:http://internal.amazon.com/coral/com.amazon.coral.validate/"}},
+			Expected: "ValidationException",
+		},
+		{
+			Name:     "JSON __type",
+			Body:     `{"__type":"com.amazonaws.dynamodb.v20120810#ResourceNotFoundException","message":"Requested resource not found"}`,
+			Expected: "ResourceNotFoundException",
+		},
+		{
+			Name:     "JSON code",
+			Body:     `{"code":"ThrottlingException","message":"Rate exceeded"}`,
+			Expected: "ThrottlingException",
+		},
+		{
+			Name:     "XML",
+			Body:     `<ErrorResponse><Error><Type>Sender</Type><Code>Throttling</Code><Message>Rate exceeded</Message></Error></ErrorResponse>`,
+			Expected: "Throttling",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := auditErrorCode(testCase.Header, []byte(testCase.Body)), testCase.Expected; got != want {
+				t.Errorf("got %q, expected %q", got, want)
+			}
+		})
+	}
+}
+
+func TestAuditTransport(t *testing.T) {
+	t.Parallel()
+
+	const responseBody = `<ErrorResponse><Error><Code>Throttling</Code><Message>Rate exceeded</Message></Error></ErrorResponse>`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		io.WriteString(w, responseBody)
+	}))
+	t.Cleanup(server.Close)
+
+	testCases := []struct {
+		Name          string
+		IncludeBodies bool
+	}{
+		{
+			Name: "bodies redacted",
+		},
+		{
+			Name:          "bodies included",
+			IncludeBodies: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			client := &http.Client{
+				Transport: &auditTransport{
+					logger: &auditLogger{includeBodies: testCase.IncludeBodies, w: &buf},
+					next:   http.DefaultTransport,
+				},
+			}
+
+			op := &auditOperation{operation: "DescribeStacks", region: "us-west-2", service: "CloudFormation"} //lintignore:AWSAT003
+			ctx := withAuditOperation(NewResourceContext(context.Background(), "aws_cloudformation_stack", "stack-1"), op)
+
+			for i := 0; i < 2; i++ {
+				req, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL, strings.NewReader("Action=DescribeStacks"))
+				if err != nil {
+					t.Fatalf("creating request: %s", err)
+				}
+
+				resp, err := client.Do(req)
+				if err != nil {
+					t.Fatalf("sending request: %s", err)
+				}
+
+				// The response body must be unchanged for the caller.
+				body, err := io.ReadAll(resp.Body)
+				resp.Body.Close()
+				if err != nil {
+					t.Fatalf("reading response body: %s", err)
+				}
+
+				if got, want := string(body), responseBody; got != want {
+					t.Errorf("response body: got %q, expected %q", got, want)
+				}
+			}
+
+			lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+
+			if got, want := len(lines), 2; got != want {
+				t.Fatalf("records: got %d, expected %d", got, want)
+			}
+
+			for i, line := range lines {
+				var record map[string]any
+
+				if err := json.Unmarshal([]byte(line), &record); err != nil {
+					t.Fatalf("decoding record: %s", err)
+				}
+
+				for k, want := range map[string]any{
+					"service":       "CloudFormation",
+					"operation":     "DescribeStacks",
+					"region":        "us-west-2", //lintignore:AWSAT003
+					"resource_type": "aws_cloudformation_stack",
+					"resource_id":   "stack-1",
+					"http_status":   float64(http.StatusBadRequest),
+					"error_code":    "Throttling",
+					"retry_count":   float64(i),
+				} {
+					if got := record[k]; got != want {
+						t.Errorf("record %d %s: got %v, expected %v", i, k, got, want)
+					}
+				}
+
+				for _, k := range []string{"request_body", "response_body"} {
+					if _, ok := record[k]; ok != testCase.IncludeBodies {
+						t.Errorf("record %d %s: present %t, expected %t", i, k, ok, testCase.IncludeBodies)
+					}
+				}
+			}
+		})
+	}
+}
@@ -6,10 +6,16 @@ import (
 	"net/http"
 
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
 )
 
 // InitContext creates context.
+// If tracing is enabled, spans started with the returned context are emitted.
 func (client *AWSClient) InitContext(ctx context.Context) context.Context {
+	if client.tracerProvider != nil {
+		ctx = tracing.NewContext(ctx, client.tracerProvider)
+	}
+
 	return ctx
 }
 
@@ -28,7 +34,7 @@ func (client *AWSClient) RegionalHostname(prefix string) string {
 }
 
 func (client *AWSClient) S3ConnURICleaningDisabled() *s3.S3 {
-	return client.s3ConnURICleaningDisabled
+	return client.s3ConnURICleaningDisabled.Client()
 }
 
 // SetHTTPClient sets the http.Client used for AWS API calls.
@@ -321,6 +321,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/xray"
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type AWSClient struct {
@@ -336,685 +337,686 @@ type AWSClient struct {
 	Session                 *session.Session
 	TerraformVersion        string
 
-	httpClient *http.Client
-
-	ec2Client       lazyClient[*ec2_sdkv2.Client]
-	logsClient      lazyClient[*cloudwatchlogs_sdkv2.Client]
-	rdsClient       lazyClient[*rds_sdkv2.Client]
-	s3controlClient lazyClient[*s3control_sdkv2.Client]
-	ssmClient       lazyClient[*ssm_sdkv2.Client]
-
-	acmConn                          *acm.ACM
-	acmpcaConn                       *acmpca.ACMPCA
-	ampConn                          *prometheusservice.PrometheusService
-	apigatewayConn                   *apigateway.APIGateway
-	apigatewaymanagementapiConn      *apigatewaymanagementapi.ApiGatewayManagementApi
-	apigatewayv2Conn                 *apigatewayv2.ApiGatewayV2
-	accessanalyzerConn               *accessanalyzer.AccessAnalyzer
-	accountConn                      *account.Account
-	alexaforbusinessConn             *alexaforbusiness.AlexaForBusiness
-	amplifyConn                      *amplify.Amplify
-	amplifybackendConn               *amplifybackend.AmplifyBackend
-	amplifyuibuilderConn             *amplifyuibuilder.AmplifyUIBuilder
-	applicationautoscalingConn       *applicationautoscaling.ApplicationAutoScaling
-	appconfigConn                    *appconfig.AppConfig
-	appconfigdataConn                *appconfigdata.AppConfigData
-	appflowConn                      *appflow.Appflow
-	appintegrationsConn              *appintegrationsservice.AppIntegrationsService
-	appmeshConn                      *appmesh.AppMesh
-	apprunnerConn                    *apprunner.AppRunner
-	appstreamConn                    *appstream.AppStream
-	appsyncConn                      *appsync.AppSync
-	applicationcostprofilerConn      *applicationcostprofiler.ApplicationCostProfiler
-	applicationinsightsConn          *applicationinsights.ApplicationInsights
-	athenaConn                       *athena.Athena
-	auditmanagerClient               *auditmanager.Client
-	autoscalingConn                  *autoscaling.AutoScaling
-	autoscalingplansConn             *autoscalingplans.AutoScalingPlans
-	backupConn                       *backup.Backup
-	backupgatewayConn                *backupgateway.BackupGateway
-	batchConn                        *batch.Batch
-	billingconductorConn             *billingconductor.BillingConductor
-	braketConn                       *braket.Braket
-	budgetsConn                      *budgets.Budgets
-	ceConn                           *costexplorer.CostExplorer
-	curConn                          *costandusagereportservice.CostandUsageReportService
-	chimeConn                        *chime.Chime
-	chimesdkidentityConn             *chimesdkidentity.ChimeSDKIdentity
-	chimesdkmeetingsConn             *chimesdkmeetings.ChimeSDKMeetings
-	chimesdkmessagingConn            *chimesdkmessaging.ChimeSDKMessaging
-	cloud9Conn                       *cloud9.Cloud9
-	cloudcontrolClient               *cloudcontrol.Client
-	clouddirectoryConn               *clouddirectory.CloudDirectory
-	cloudformationConn               *cloudformation.CloudFormation
-	cloudfrontConn                   *cloudfront.CloudFront
-	cloudhsmv2Conn                   *cloudhsmv2.CloudHSMV2
-	cloudsearchConn                  *cloudsearch.CloudSearch
-	cloudsearchdomainConn            *cloudsearchdomain.CloudSearchDomain
-	cloudtrailConn                   *cloudtrail.CloudTrail
-	cloudwatchConn                   *cloudwatch.CloudWatch
-	codeartifactConn                 *codeartifact.CodeArtifact
-	codebuildConn                    *codebuild.CodeBuild
-	codecommitConn                   *codecommit.CodeCommit
-	codeguruprofilerConn             *codeguruprofiler.CodeGuruProfiler
-	codegurureviewerConn             *codegurureviewer.CodeGuruReviewer
-	codepipelineConn                 *codepipeline.CodePipeline
-	codestarConn                     *codestar.CodeStar
-	codestarconnectionsConn          *codestarconnections.CodeStarConnections
-	codestarnotificationsConn        *codestarnotifications.CodeStarNotifications
-	cognitoidpConn                   *cognitoidentityprovider.CognitoIdentityProvider
-	cognitoidentityConn              *cognitoidentity.CognitoIdentity
-	cognitosyncConn                  *cognitosync.CognitoSync
-	comprehendClient                 *comprehend.Client
-	comprehendmedicalConn            *comprehendmedical.ComprehendMedical
-	computeoptimizerClient           *computeoptimizer.Client
-	configserviceConn                *configservice.ConfigService
-	connectConn                      *connect.Connect
-	connectcontactlensConn           *connectcontactlens.ConnectContactLens
-	connectparticipantConn           *connectparticipant.ConnectParticipant
-	controltowerConn                 *controltower.ControlTower
-	customerprofilesConn             *customerprofiles.CustomerProfiles
-	daxConn                          *dax.DAX
-	dlmConn                          *dlm.DLM
-	dmsConn                          *databasemigrationservice.DatabaseMigrationService
-	drsConn                          *drs.Drs
-	dsConn                           *directoryservice.DirectoryService
-	databrewConn                     *gluedatabrew.GlueDataBrew
-	dataexchangeConn                 *dataexchange.DataExchange
-	datapipelineConn                 *datapipeline.DataPipeline
-	datasyncConn                     *datasync.DataSync
-	deployConn                       *codedeploy.CodeDeploy
-	detectiveConn                    *detective.Detective
-	devopsguruConn                   *devopsguru.DevOpsGuru
-	devicefarmConn                   *devicefarm.DeviceFarm
-	directconnectConn                *directconnect.DirectConnect
-	discoveryConn                    *applicationdiscoveryservice.ApplicationDiscoveryService
-	docdbConn                        *docdb.DocDB
-	dynamodbConn                     *dynamodb.DynamoDB
-	dynamodbstreamsConn              *dynamodbstreams.DynamoDBStreams
-	ebsConn                          *ebs.EBS
-	ec2Conn                          *ec2.EC2
-	ec2instanceconnectConn           *ec2instanceconnect.EC2InstanceConnect
-	ecrConn                          *ecr.ECR
-	ecrpublicConn                    *ecrpublic.ECRPublic
-	ecsConn                          *ecs.ECS
-	efsConn                          *efs.EFS
-	eksConn                          *eks.EKS
-	elbConn                          *elb.ELB
-	elbv2Conn                        *elbv2.ELBV2
-	emrConn                          *emr.EMR
-	emrcontainersConn                *emrcontainers.EMRContainers
-	emrserverlessConn                *emrserverless.EMRServerless
-	elasticacheConn                  *elasticache.ElastiCache
-	elasticbeanstalkConn             *elasticbeanstalk.ElasticBeanstalk
-	elasticinferenceConn             *elasticinference.ElasticInference
-	elastictranscoderConn            *elastictranscoder.ElasticTranscoder
-	esConn                           *elasticsearchservice.ElasticsearchService
-	eventsConn                       *eventbridge.EventBridge
-	evidentlyConn                    *cloudwatchevidently.CloudWatchEvidently
-	fisClient                        *fis.Client
-	fmsConn                          *fms.FMS
-	fsxConn                          *fsx.FSx
-	finspaceConn                     *finspace.Finspace
-	finspacedataConn                 *finspacedata.FinSpaceData
-	firehoseConn                     *firehose.Firehose
-	forecastConn                     *forecastservice.ForecastService
-	forecastqueryConn                *forecastqueryservice.ForecastQueryService
-	frauddetectorConn                *frauddetector.FraudDetector
-	gameliftConn                     *gamelift.GameLift
-	glacierConn                      *glacier.Glacier
-	globalacceleratorConn            *globalaccelerator.GlobalAccelerator
-	glueConn                         *glue.Glue
-	grafanaConn                      *managedgrafana.ManagedGrafana
-	greengrassConn                   *greengrass.Greengrass
-	greengrassv2Conn                 *greengrassv2.GreengrassV2
-	groundstationConn                *groundstation.GroundStation
-	guarddutyConn                    *guardduty.GuardDuty
-	healthConn                       *health.Health
-	healthlakeConn                   *healthlake.HealthLake
-	honeycodeConn                    *honeycode.Honeycode
-	iamConn                          *iam.IAM
-	ivsConn                          *ivs.IVS
-	ivschatClient                    *ivschat.Client
-	identitystoreClient              *identitystore.Client
-	imagebuilderConn                 *imagebuilder.Imagebuilder
-	inspectorConn                    *inspector.Inspector
-	inspector2Client                 *inspector2.Client
-	iotConn                          *iot.IoT
-	iot1clickdevicesConn             *iot1clickdevicesservice.IoT1ClickDevicesService
-	iot1clickprojectsConn            *iot1clickprojects.IoT1ClickProjects
-	iotanalyticsConn                 *iotanalytics.IoTAnalytics
-	iotdataConn                      *iotdataplane.IoTDataPlane
-	iotdeviceadvisorConn             *iotdeviceadvisor.IoTDeviceAdvisor
-	ioteventsConn                    *iotevents.IoTEvents
-	ioteventsdataConn                *ioteventsdata.IoTEventsData
-	iotfleethubConn                  *iotfleethub.IoTFleetHub
-	iotjobsdataConn                  *iotjobsdataplane.IoTJobsDataPlane
-	iotsecuretunnelingConn           *iotsecuretunneling.IoTSecureTunneling
-	iotsitewiseConn                  *iotsitewise.IoTSiteWise
-	iotthingsgraphConn               *iotthingsgraph.IoTThingsGraph
-	iottwinmakerConn                 *iottwinmaker.IoTTwinMaker
-	iotwirelessConn                  *iotwireless.IoTWireless
-	kmsConn                          *kms.KMS
-	kafkaConn                        *kafka.Kafka
-	kafkaconnectConn                 *kafkaconnect.KafkaConnect
-	kendraClient                     *kendra.Client
-	keyspacesConn                    *keyspaces.Keyspaces
-	kinesisConn                      *kinesis.Kinesis
-	kinesisanalyticsConn             *kinesisanalytics.KinesisAnalytics
-	kinesisanalyticsv2Conn           *kinesisanalyticsv2.KinesisAnalyticsV2
-	kinesisvideoConn                 *kinesisvideo.KinesisVideo
-	kinesisvideoarchivedmediaConn    *kinesisvideoarchivedmedia.KinesisVideoArchivedMedia
-	kinesisvideomediaConn            *kinesisvideomedia.KinesisVideoMedia
-	kinesisvideosignalingConn        *kinesisvideosignalingchannels.KinesisVideoSignalingChannels
-	lakeformationConn                *lakeformation.LakeFormation
-	lambdaConn                       *lambda.Lambda
-	lexmodelsConn                    *lexmodelbuildingservice.LexModelBuildingService
-	lexmodelsv2Conn                  *lexmodelsv2.LexModelsV2
-	lexruntimeConn                   *lexruntimeservice.LexRuntimeService
-	lexruntimev2Conn                 *lexruntimev2.LexRuntimeV2
-	licensemanagerConn               *licensemanager.LicenseManager
-	lightsailConn                    *lightsail.Lightsail
-	locationConn                     *locationservice.LocationService
-	logsConn                         *cloudwatchlogs.CloudWatchLogs
-	lookoutequipmentConn             *lookoutequipment.LookoutEquipment
-	lookoutmetricsConn               *lookoutmetrics.LookoutMetrics
-	lookoutvisionConn                *lookoutforvision.LookoutForVision
-	mqConn                           *mq.MQ
-	mturkConn                        *mturk.MTurk
-	mwaaConn                         *mwaa.MWAA
-	machinelearningConn              *machinelearning.MachineLearning
-	macieConn                        *macie.Macie
-	macie2Conn                       *macie2.Macie2
-	managedblockchainConn            *managedblockchain.ManagedBlockchain
-	marketplacecatalogConn           *marketplacecatalog.MarketplaceCatalog
-	marketplacecommerceanalyticsConn *marketplacecommerceanalytics.MarketplaceCommerceAnalytics
-	marketplaceentitlementConn       *marketplaceentitlementservice.MarketplaceEntitlementService
-	marketplacemeteringConn          *marketplacemetering.MarketplaceMetering
-	mediaconnectConn                 *mediaconnect.MediaConnect
-	mediaconvertConn                 *mediaconvert.MediaConvert
-	medialiveClient                  *medialive.Client
-	mediapackageConn                 *mediapackage.MediaPackage
-	mediapackagevodConn              *mediapackagevod.MediaPackageVod
-	mediastoreConn                   *mediastore.MediaStore
-	mediastoredataConn               *mediastoredata.MediaStoreData
-	mediatailorConn                  *mediatailor.MediaTailor
-	memorydbConn                     *memorydb.MemoryDB
-	mghConn                          *migrationhub.MigrationHub
-	mgnConn                          *mgn.Mgn
-	migrationhubconfigConn           *migrationhubconfig.MigrationHubConfig
-	migrationhubrefactorspacesConn   *migrationhubrefactorspaces.MigrationHubRefactorSpaces
-	migrationhubstrategyConn         *migrationhubstrategyrecommendations.MigrationHubStrategyRecommendations
-	mobileConn                       *mobile.Mobile
-	neptuneConn                      *neptune.Neptune
-	networkfirewallConn              *networkfirewall.NetworkFirewall
-	networkmanagerConn               *networkmanager.NetworkManager
-	nimbleConn                       *nimblestudio.NimbleStudio
-	oamClient                        *oam.Client
-	opensearchConn                   *opensearchservice.OpenSearchService
-	opensearchserverlessClient       *opensearchserverless.Client
-	opsworksConn                     *opsworks.OpsWorks
-	opsworkscmConn                   *opsworkscm.OpsWorksCM
-	organizationsConn                *organizations.Organizations
-	outpostsConn                     *outposts.Outposts
-	piConn                           *pi.PI
-	panoramaConn                     *panorama.Panorama
-	personalizeConn                  *personalize.Personalize
-	personalizeeventsConn            *personalizeevents.PersonalizeEvents
-	personalizeruntimeConn           *personalizeruntime.PersonalizeRuntime
-	pinpointConn                     *pinpoint.Pinpoint
-	pinpointemailConn                *pinpointemail.PinpointEmail
-	pinpointsmsvoiceConn             *pinpointsmsvoice.PinpointSMSVoice
-	pipesClient                      *pipes.Client
-	pollyConn                        *polly.Polly
-	pricingConn                      *pricing.Pricing
-	protonConn                       *proton.Proton
-	qldbConn                         *qldb.QLDB
-	qldbsessionConn                  *qldbsession.QLDBSession
-	quicksightConn                   *quicksight.QuickSight
-	ramConn                          *ram.RAM
-	rbinConn                         *recyclebin.RecycleBin
-	rdsConn                          *rds.RDS
-	rdsdataConn                      *rdsdataservice.RDSDataService
-	rumConn                          *cloudwatchrum.CloudWatchRUM
-	redshiftConn                     *redshift.Redshift
-	redshiftdataConn                 *redshiftdataapiservice.RedshiftDataAPIService
-	redshiftserverlessConn           *redshiftserverless.RedshiftServerless
-	rekognitionConn                  *rekognition.Rekognition
-	resiliencehubConn                *resiliencehub.ResilienceHub
-	resourceexplorer2Client          *resourceexplorer2.Client
-	resourcegroupsConn               *resourcegroups.ResourceGroups
-	resourcegroupstaggingapiConn     *resourcegroupstaggingapi.ResourceGroupsTaggingAPI
-	robomakerConn                    *robomaker.RoboMaker
-	rolesanywhereClient              *rolesanywhere.Client
-	route53Conn                      *route53.Route53
-	route53domainsClient             *route53domains.Client
-	route53recoveryclusterConn       *route53recoverycluster.Route53RecoveryCluster
-	route53recoverycontrolconfigConn *route53recoverycontrolconfig.Route53RecoveryControlConfig
-	route53recoveryreadinessConn     *route53recoveryreadiness.Route53RecoveryReadiness
-	route53resolverConn              *route53resolver.Route53Resolver
-	s3Conn                           *s3.S3
-	s3controlConn                    *s3control.S3Control
-	s3outpostsConn                   *s3outposts.S3Outposts
-	sesConn                          *ses.SES
-	sesv2Client                      *sesv2.Client
-	sfnConn                          *sfn.SFN
-	smsConn                          *sms.SMS
-	snsConn                          *sns.SNS
-	sqsConn                          *sqs.SQS
-	ssmConn                          *ssm.SSM
-	ssmcontactsConn                  *ssmcontacts.SSMContacts
-	ssmincidentsClient               *ssmincidents.Client
-	ssoConn                          *sso.SSO
-	ssoadminConn                     *ssoadmin.SSOAdmin
-	ssooidcConn                      *ssooidc.SSOOIDC
-	stsConn                          *sts.STS
-	swfConn                          *swf.SWF
-	sagemakerConn                    *sagemaker.SageMaker
-	sagemakera2iruntimeConn          *augmentedairuntime.AugmentedAIRuntime
-	sagemakeredgeConn                *sagemakeredgemanager.SagemakerEdgeManager
-	sagemakerfeaturestoreruntimeConn *sagemakerfeaturestoreruntime.SageMakerFeatureStoreRuntime
-	sagemakerruntimeConn             *sagemakerruntime.SageMakerRuntime
-	savingsplansConn                 *savingsplans.SavingsPlans
-	schedulerClient                  *scheduler.Client
-	schemasConn                      *schemas.Schemas
-	secretsmanagerConn               *secretsmanager.SecretsManager
-	securityhubConn                  *securityhub.SecurityHub
-	serverlessrepoConn               *serverlessapplicationrepository.ServerlessApplicationRepository
-	servicecatalogConn               *servicecatalog.ServiceCatalog
-	servicecatalogappregistryConn    *appregistry.AppRegistry
-	servicediscoveryConn             *servicediscovery.ServiceDiscovery
-	servicequotasConn                *servicequotas.ServiceQuotas
-	shieldConn                       *shield.Shield
-	signerConn                       *signer.Signer
-	sdbConn                          *simpledb.SimpleDB
-	snowdevicemanagementConn         *snowdevicemanagement.SnowDeviceManagement
-	snowballConn                     *snowball.Snowball
-	storagegatewayConn               *storagegateway.StorageGateway
-	supportConn                      *support.Support
-	syntheticsConn                   *synthetics.Synthetics
-	textractConn                     *textract.Textract
-	timestreamqueryConn              *timestreamquery.TimestreamQuery
-	timestreamwriteConn              *timestreamwrite.TimestreamWrite
-	transcribeClient                 *transcribe.Client
-	transcribestreamingConn          *transcribestreamingservice.TranscribeStreamingService
-	transferConn                     *transfer.Transfer
-	translateConn                    *translate.Translate
-	voiceidConn                      *voiceid.VoiceID
-	wafConn                          *waf.WAF
-	wafregionalConn                  *wafregional.WAFRegional
-	wafv2Conn                        *wafv2.WAFV2
-	wellarchitectedConn              *wellarchitected.WellArchitected
-	wisdomConn                       *connectwisdomservice.ConnectWisdomService
-	workdocsConn                     *workdocs.WorkDocs
-	worklinkConn                     *worklink.WorkLink
-	workmailConn                     *workmail.WorkMail
-	workmailmessageflowConn          *workmailmessageflow.WorkMailMessageFlow
-	workspacesConn                   *workspaces.WorkSpaces
-	workspaceswebConn                *workspacesweb.WorkSpacesWeb
-	xrayConn                         *xray.XRay
-
-	s3ConnURICleaningDisabled *s3.S3
+	httpClient      *http.Client
+	regionalClients *regionalClients
+	tracerProvider  trace.TracerProvider
+
+	acmConn                          lazyClient[*acm.ACM]
+	acmpcaConn                       lazyClient[*acmpca.ACMPCA]
+	ampConn                          lazyClient[*prometheusservice.PrometheusService]
+	apigatewayConn                   lazyClient[*apigateway.APIGateway]
+	apigatewaymanagementapiConn      lazyClient[*apigatewaymanagementapi.ApiGatewayManagementApi]
+	apigatewayv2Conn                 lazyClient[*apigatewayv2.ApiGatewayV2]
+	accessanalyzerConn               lazyClient[*accessanalyzer.AccessAnalyzer]
+	accountConn                      lazyClient[*account.Account]
+	alexaforbusinessConn             lazyClient[*alexaforbusiness.AlexaForBusiness]
+	amplifyConn                      lazyClient[*amplify.Amplify]
+	amplifybackendConn               lazyClient[*amplifybackend.AmplifyBackend]
+	amplifyuibuilderConn             lazyClient[*amplifyuibuilder.AmplifyUIBuilder]
+	applicationautoscalingConn       lazyClient[*applicationautoscaling.ApplicationAutoScaling]
+	appconfigConn                    lazyClient[*appconfig.AppConfig]
+	appconfigdataConn                lazyClient[*appconfigdata.AppConfigData]
+	appflowConn                      lazyClient[*appflow.Appflow]
+	appintegrationsConn              lazyClient[*appintegrationsservice.AppIntegrationsService]
+	appmeshConn                      lazyClient[*appmesh.AppMesh]
+	apprunnerConn                    lazyClient[*apprunner.AppRunner]
+	appstreamConn                    lazyClient[*appstream.AppStream]
+	appsyncConn                      lazyClient[*appsync.AppSync]
+	applicationcostprofilerConn      lazyClient[*applicationcostprofiler.ApplicationCostProfiler]
+	applicationinsightsConn          lazyClient[*applicationinsights.ApplicationInsights]
+	athenaConn                       lazyClient[*athena.Athena]
+	auditmanagerClient               lazyClient[*auditmanager.Client]
+	autoscalingConn                  lazyClient[*autoscaling.AutoScaling]
+	autoscalingplansConn             lazyClient[*autoscalingplans.AutoScalingPlans]
+	backupConn                       lazyClient[*backup.Backup]
+	backupgatewayConn                lazyClient[*backupgateway.BackupGateway]
+	batchConn                        lazyClient[*batch.Batch]
+	billingconductorConn             lazyClient[*billingconductor.BillingConductor]
+	braketConn                       lazyClient[*braket.Braket]
+	budgetsConn                      lazyClient[*budgets.Budgets]
+	ceConn                           lazyClient[*costexplorer.CostExplorer]
+	curConn                          lazyClient[*costandusagereportservice.CostandUsageReportService]
+	chimeConn                        lazyClient[*chime.Chime]
+	chimesdkidentityConn             lazyClient[*chimesdkidentity.ChimeSDKIdentity]
+	chimesdkmeetingsConn             lazyClient[*chimesdkmeetings.ChimeSDKMeetings]
+	chimesdkmessagingConn            lazyClient[*chimesdkmessaging.ChimeSDKMessaging]
+	cloud9Conn                       lazyClient[*cloud9.Cloud9]
+	cloudcontrolClient               lazyClient[*cloudcontrol.Client]
+	clouddirectoryConn               lazyClient[*clouddirectory.CloudDirectory]
+	cloudformationConn               lazyClient[*cloudformation.CloudFormation]
+	cloudfrontConn                   lazyClient[*cloudfront.CloudFront]
+	cloudhsmv2Conn                   lazyClient[*cloudhsmv2.CloudHSMV2]
+	cloudsearchConn                  lazyClient[*cloudsearch.CloudSearch]
+	cloudsearchdomainConn            lazyClient[*cloudsearchdomain.CloudSearchDomain]
+	cloudtrailConn                   lazyClient[*cloudtrail.CloudTrail]
+	cloudwatchConn                   lazyClient[*cloudwatch.CloudWatch]
+	codeartifactConn                 lazyClient[*codeartifact.CodeArtifact]
+	codebuildConn                    lazyClient[*codebuild.CodeBuild]
+	codecommitConn                   lazyClient[*codecommit.CodeCommit]
+	codeguruprofilerConn             lazyClient[*codeguruprofiler.CodeGuruProfiler]
+	codegurureviewerConn             lazyClient[*codegurureviewer.CodeGuruReviewer]
+	codepipelineConn                 lazyClient[*codepipeline.CodePipeline]
+	codestarConn                     lazyClient[*codestar.CodeStar]
+	codestarconnectionsConn          lazyClient[*codestarconnections.CodeStarConnections]
+	codestarnotificationsConn        lazyClient[*codestarnotifications.CodeStarNotifications]
+	cognitoidpConn                   lazyClient[*cognitoidentityprovider.CognitoIdentityProvider]
+	cognitoidentityConn              lazyClient[*cognitoidentity.CognitoIdentity]
+	cognitosyncConn                  lazyClient[*cognitosync.CognitoSync]
+	comprehendClient                 lazyClient[*comprehend.Client]
+	comprehendmedicalConn            lazyClient[*comprehendmedical.ComprehendMedical]
+	computeoptimizerClient           lazyClient[*computeoptimizer.Client]
+	configserviceConn                lazyClient[*configservice.ConfigService]
+	connectConn                      lazyClient[*connect.Connect]
+	connectcontactlensConn           lazyClient[*connectcontactlens.ConnectContactLens]
+	connectparticipantConn           lazyClient[*connectparticipant.ConnectParticipant]
+	controltowerConn                 lazyClient[*controltower.ControlTower]
+	customerprofilesConn             lazyClient[*customerprofiles.CustomerProfiles]
+	daxConn                          lazyClient[*dax.DAX]
+	dlmConn                          lazyClient[*dlm.DLM]
+	dmsConn                          lazyClient[*databasemigrationservice.DatabaseMigrationService]
+	drsConn                          lazyClient[*drs.Drs]
+	dsConn                           lazyClient[*directoryservice.DirectoryService]
+	databrewConn                     lazyClient[*gluedatabrew.GlueDataBrew]
+	dataexchangeConn                 lazyClient[*dataexchange.DataExchange]
+	datapipelineConn                 lazyClient[*datapipeline.DataPipeline]
+	datasyncConn                     lazyClient[*datasync.DataSync]
+	deployConn                       lazyClient[*codedeploy.CodeDeploy]
+	detectiveConn                    lazyClient[*detective.Detective]
+	devopsguruConn                   lazyClient[*devopsguru.DevOpsGuru]
+	devicefarmConn                   lazyClient[*devicefarm.DeviceFarm]
+	directconnectConn                lazyClient[*directconnect.DirectConnect]
+	discoveryConn                    lazyClient[*applicationdiscoveryservice.ApplicationDiscoveryService]
+	docdbConn                        lazyClient[*docdb.DocDB]
+	dynamodbConn                     lazyClient[*dynamodb.DynamoDB]
+	dynamodbstreamsConn              lazyClient[*dynamodbstreams.DynamoDBStreams]
+	ebsConn                          lazyClient[*ebs.EBS]
+	ec2Conn                          lazyClient[*ec2.EC2]
+	ec2Client                        lazyClient[*ec2_sdkv2.Client]
+	ec2instanceconnectConn           lazyClient[*ec2instanceconnect.EC2InstanceConnect]
+	ecrConn                          lazyClient[*ecr.ECR]
+	ecrpublicConn                    lazyClient[*ecrpublic.ECRPublic]
+	ecsConn                          lazyClient[*ecs.ECS]
+	efsConn                          lazyClient[*efs.EFS]
+	eksConn                          lazyClient[*eks.EKS]
+	elbConn                          lazyClient[*elb.ELB]
+	elbv2Conn                        lazyClient[*elbv2.ELBV2]
+	emrConn                          lazyClient[*emr.EMR]
+	emrcontainersConn                lazyClient[*emrcontainers.EMRContainers]
+	emrserverlessConn                lazyClient[*emrserverless.EMRServerless]
+	elasticacheConn                  lazyClient[*elasticache.ElastiCache]
+	elasticbeanstalkConn             lazyClient[*elasticbeanstalk.ElasticBeanstalk]
+	elasticinferenceConn             lazyClient[*elasticinference.ElasticInference]
+	elastictranscoderConn            lazyClient[*elastictranscoder.ElasticTranscoder]
+	esConn                           lazyClient[*elasticsearchservice.ElasticsearchService]
+	eventsConn                       lazyClient[*eventbridge.EventBridge]
+	evidentlyConn                    lazyClient[*cloudwatchevidently.CloudWatchEvidently]
+	fisClient                        lazyClient[*fis.Client]
+	fmsConn                          lazyClient[*fms.FMS]
+	fsxConn                          lazyClient[*fsx.FSx]
+	finspaceConn                     lazyClient[*finspace.Finspace]
+	finspacedataConn                 lazyClient[*finspacedata.FinSpaceData]
+	firehoseConn                     lazyClient[*firehose.Firehose]
+	forecastConn                     lazyClient[*forecastservice.ForecastService]
+	forecastqueryConn                lazyClient[*forecastqueryservice.ForecastQueryService]
+	frauddetectorConn                lazyClient[*frauddetector.FraudDetector]
+	gameliftConn                     lazyClient[*gamelift.GameLift]
+	glacierConn                      lazyClient[*glacier.Glacier]
+	globalacceleratorConn            lazyClient[*globalaccelerator.GlobalAccelerator]
+	glueConn                         lazyClient[*glue.Glue]
+	grafanaConn                      lazyClient[*managedgrafana.ManagedGrafana]
+	greengrassConn                   lazyClient[*greengrass.Greengrass]
+	greengrassv2Conn                 lazyClient[*greengrassv2.GreengrassV2]
+	groundstationConn                lazyClient[*groundstation.GroundStation]
+	guarddutyConn                    lazyClient[*guardduty.GuardDuty]
+	healthConn                       lazyClient[*health.Health]
+	healthlakeConn                   lazyClient[*healthlake.HealthLake]
+	honeycodeConn                    lazyClient[*honeycode.Honeycode]
+	iamConn                          lazyClient[*iam.IAM]
+	ivsConn                          lazyClient[*ivs.IVS]
+	ivschatClient                    lazyClient[*ivschat.Client]
+	identitystoreClient              lazyClient[*identitystore.Client]
+	imagebuilderConn                 lazyClient[*imagebuilder.Imagebuilder]
+	inspectorConn                    lazyClient[*inspector.Inspector]
+	inspector2Client                 lazyClient[*inspector2.Client]
+	iotConn                          lazyClient[*iot.IoT]
+	iot1clickdevicesConn             lazyClient[*iot1clickdevicesservice.IoT1ClickDevicesService]
+	iot1clickprojectsConn            lazyClient[*iot1clickprojects.IoT1ClickProjects]
+	iotanalyticsConn                 lazyClient[*iotanalytics.IoTAnalytics]
+	iotdataConn                      lazyClient[*iotdataplane.IoTDataPlane]
+	iotdeviceadvisorConn             lazyClient[*iotdeviceadvisor.IoTDeviceAdvisor]
+	ioteventsConn                    lazyClient[*iotevents.IoTEvents]
+	ioteventsdataConn                lazyClient[*ioteventsdata.IoTEventsData]
+	iotfleethubConn                  lazyClient[*iotfleethub.IoTFleetHub]
+	iotjobsdataConn                  lazyClient[*iotjobsdataplane.IoTJobsDataPlane]
+	iotsecuretunnelingConn           lazyClient[*iotsecuretunneling.IoTSecureTunneling]
+	iotsitewiseConn                  lazyClient[*iotsitewise.IoTSiteWise]
+	iotthingsgraphConn               lazyClient[*iotthingsgraph.IoTThingsGraph]
+	iottwinmakerConn                 lazyClient[*iottwinmaker.IoTTwinMaker]
+	iotwirelessConn                  lazyClient[*iotwireless.IoTWireless]
+	kmsConn                          lazyClient[*kms.KMS]
+	kafkaConn                        lazyClient[*kafka.Kafka]
+	kafkaconnectConn                 lazyClient[*kafkaconnect.KafkaConnect]
+	kendraClient                     lazyClient[*kendra.Client]
+	keyspacesConn                    lazyClient[*keyspaces.Keyspaces]
+	kinesisConn                      lazyClient[*kinesis.Kinesis]
+	kinesisanalyticsConn             lazyClient[*kinesisanalytics.KinesisAnalytics]
+	kinesisanalyticsv2Conn           lazyClient[*kinesisanalyticsv2.KinesisAnalyticsV2]
+	kinesisvideoConn                 lazyClient[*kinesisvideo.KinesisVideo]
+	kinesisvideoarchivedmediaConn    lazyClient[*kinesisvideoarchivedmedia.KinesisVideoArchivedMedia]
+	kinesisvideomediaConn            lazyClient[*kinesisvideomedia.KinesisVideoMedia]
+	kinesisvideosignalingConn        lazyClient[*kinesisvideosignalingchannels.KinesisVideoSignalingChannels]
+	lakeformationConn                lazyClient[*lakeformation.LakeFormation]
+	lambdaConn                       lazyClient[*lambda.Lambda]
+	lexmodelsConn                    lazyClient[*lexmodelbuildingservice.LexModelBuildingService]
+	lexmodelsv2Conn                  lazyClient[*lexmodelsv2.LexModelsV2]
+	lexruntimeConn                   lazyClient[*lexruntimeservice.LexRuntimeService]
+	lexruntimev2Conn                 lazyClient[*lexruntimev2.LexRuntimeV2]
+	licensemanagerConn               lazyClient[*licensemanager.LicenseManager]
+	lightsailConn                    lazyClient[*lightsail.Lightsail]
+	locationConn                     lazyClient[*locationservice.LocationService]
+	logsConn                         lazyClient[*cloudwatchlogs.CloudWatchLogs]
+	logsClient                       lazyClient[*cloudwatchlogs_sdkv2.Client]
+	lookoutequipmentConn             lazyClient[*lookoutequipment.LookoutEquipment]
+	lookoutmetricsConn               lazyClient[*lookoutmetrics.LookoutMetrics]
+	lookoutvisionConn                lazyClient[*lookoutforvision.LookoutForVision]
+	mqConn                           lazyClient[*mq.MQ]
+	mturkConn                        lazyClient[*mturk.MTurk]
+	mwaaConn                         lazyClient[*mwaa.MWAA]
+	machinelearningConn              lazyClient[*machinelearning.MachineLearning]
+	macieConn                        lazyClient[*macie.Macie]
+	macie2Conn                       lazyClient[*macie2.Macie2]
+	managedblockchainConn            lazyClient[*managedblockchain.ManagedBlockchain]
+	marketplacecatalogConn           lazyClient[*marketplacecatalog.MarketplaceCatalog]
+	marketplacecommerceanalyticsConn lazyClient[*marketplacecommerceanalytics.MarketplaceCommerceAnalytics]
+	marketplaceentitlementConn       lazyClient[*marketplaceentitlementservice.MarketplaceEntitlementService]
+	marketplacemeteringConn          lazyClient[*marketplacemetering.MarketplaceMetering]
+	mediaconnectConn                 lazyClient[*mediaconnect.MediaConnect]
+	mediaconvertConn                 lazyClient[*mediaconvert.MediaConvert]
+	medialiveClient                  lazyClient[*medialive.Client]
+	mediapackageConn                 lazyClient[*mediapackage.MediaPackage]
+	mediapackagevodConn              lazyClient[*mediapackagevod.MediaPackageVod]
+	mediastoreConn                   lazyClient[*mediastore.MediaStore]
+	mediastoredataConn               lazyClient[*mediastoredata.MediaStoreData]
+	mediatailorConn                  lazyClient[*mediatailor.MediaTailor]
+	memorydbConn                     lazyClient[*memorydb.MemoryDB]
+	mghConn                          lazyClient[*migrationhub.MigrationHub]
+	mgnConn                          lazyClient[*mgn.Mgn]
+	migrationhubconfigConn           lazyClient[*migrationhubconfig.MigrationHubConfig]
+	migrationhubrefactorspacesConn   lazyClient[*migrationhubrefactorspaces.MigrationHubRefactorSpaces]
+	migrationhubstrategyConn         lazyClient[*migrationhubstrategyrecommendations.MigrationHubStrategyRecommendations]
+	mobileConn                       lazyClient[*mobile.Mobile]
+	neptuneConn                      lazyClient[*neptune.Neptune]
+	networkfirewallConn              lazyClient[*networkfirewall.NetworkFirewall]
+	networkmanagerConn               lazyClient[*networkmanager.NetworkManager]
+	nimbleConn                       lazyClient[*nimblestudio.NimbleStudio]
+	oamClient                        lazyClient[*oam.Client]
+	opensearchConn                   lazyClient[*opensearchservice.OpenSearchService]
+	opensearchserverlessClient       lazyClient[*opensearchserverless.Client]
+	opsworksConn                     lazyClient[*opsworks.OpsWorks]
+	opsworkscmConn                   lazyClient[*opsworkscm.OpsWorksCM]
+	organizationsConn                lazyClient[*organizations.Organizations]
+	outpostsConn                     lazyClient[*outposts.Outposts]
+	piConn                           lazyClient[*pi.PI]
+	panoramaConn                     lazyClient[*panorama.Panorama]
+	personalizeConn                  lazyClient[*personalize.Personalize]
+	personalizeeventsConn            lazyClient[*personalizeevents.PersonalizeEvents]
+	personalizeruntimeConn           lazyClient[*personalizeruntime.PersonalizeRuntime]
+	pinpointConn                     lazyClient[*pinpoint.Pinpoint]
+	pinpointemailConn                lazyClient[*pinpointemail.PinpointEmail]
+	pinpointsmsvoiceConn             lazyClient[*pinpointsmsvoice.PinpointSMSVoice]
+	pipesClient                      lazyClient[*pipes.Client]
+	pollyConn                        lazyClient[*polly.Polly]
+	pricingConn                      lazyClient[*pricing.Pricing]
+	protonConn                       lazyClient[*proton.Proton]
+	qldbConn                         lazyClient[*qldb.QLDB]
+	qldbsessionConn                  lazyClient[*qldbsession.QLDBSession]
+	quicksightConn                   lazyClient[*quicksight.QuickSight]
+	ramConn                          lazyClient[*ram.RAM]
+	rbinConn                         lazyClient[*recyclebin.RecycleBin]
+	rdsConn                          lazyClient[*rds.RDS]
+	rdsClient                        lazyClient[*rds_sdkv2.Client]
+	rdsdataConn                      lazyClient[*rdsdataservice.RDSDataService]
+	rumConn                          lazyClient[*cloudwatchrum.CloudWatchRUM]
+	redshiftConn                     lazyClient[*redshift.Redshift]
+	redshiftdataConn                 lazyClient[*redshiftdataapiservice.RedshiftDataAPIService]
+	redshiftserverlessConn           lazyClient[*redshiftserverless.RedshiftServerless]
+	rekognitionConn                  lazyClient[*rekognition.Rekognition]
+	resiliencehubConn                lazyClient[*resiliencehub.ResilienceHub]
+	resourceexplorer2Client          lazyClient[*resourceexplorer2.Client]
+	resourcegroupsConn               lazyClient[*resourcegroups.ResourceGroups]
+	resourcegroupstaggingapiConn     lazyClient[*resourcegroupstaggingapi.ResourceGroupsTaggingAPI]
+	robomakerConn                    lazyClient[*robomaker.RoboMaker]
+	rolesanywhereClient              lazyClient[*rolesanywhere.Client]
+	route53Conn                      lazyClient[*route53.Route53]
+	route53domainsClient             lazyClient[*route53domains.Client]
+	route53recoveryclusterConn       lazyClient[*route53recoverycluster.Route53RecoveryCluster]
+	route53recoverycontrolconfigConn lazyClient[*route53recoverycontrolconfig.Route53RecoveryControlConfig]
+	route53recoveryreadinessConn     lazyClient[*route53recoveryreadiness.Route53RecoveryReadiness]
+	route53resolverConn              lazyClient[*route53resolver.Route53Resolver]
+	s3Conn                           lazyClient[*s3.S3]
+	s3controlConn                    lazyClient[*s3control.S3Control]
+	s3controlClient                  lazyClient[*s3control_sdkv2.Client]
+	s3outpostsConn                   lazyClient[*s3outposts.S3Outposts]
+	sesConn                          lazyClient[*ses.SES]
+	sesv2Client                      lazyClient[*sesv2.Client]
+	sfnConn                          lazyClient[*sfn.SFN]
+	smsConn                          lazyClient[*sms.SMS]
+	snsConn                          lazyClient[*sns.SNS]
+	sqsConn                          lazyClient[*sqs.SQS]
+	ssmConn                          lazyClient[*ssm.SSM]
+	ssmClient                        lazyClient[*ssm_sdkv2.Client]
+	ssmcontactsConn                  lazyClient[*ssmcontacts.SSMContacts]
+	ssmincidentsClient               lazyClient[*ssmincidents.Client]
+	ssoConn                          lazyClient[*sso.SSO]
+	ssoadminConn                     lazyClient[*ssoadmin.SSOAdmin]
+	ssooidcConn                      lazyClient[*ssooidc.SSOOIDC]
+	stsConn                          lazyClient[*sts.STS]
+	swfConn                          lazyClient[*swf.SWF]
+	sagemakerConn                    lazyClient[*sagemaker.SageMaker]
+	sagemakera2iruntimeConn          lazyClient[*augmentedairuntime.AugmentedAIRuntime]
+	sagemakeredgeConn                lazyClient[*sagemakeredgemanager.SagemakerEdgeManager]
+	sagemakerfeaturestoreruntimeConn lazyClient[*sagemakerfeaturestoreruntime.SageMakerFeatureStoreRuntime]
+	sagemakerruntimeConn             lazyClient[*sagemakerruntime.SageMakerRuntime]
+	savingsplansConn                 lazyClient[*savingsplans.SavingsPlans]
+	schedulerClient                  lazyClient[*scheduler.Client]
+	schemasConn                      lazyClient[*schemas.Schemas]
+	secretsmanagerConn               lazyClient[*secretsmanager.SecretsManager]
+	securityhubConn                  lazyClient[*securityhub.SecurityHub]
+	serverlessrepoConn               lazyClient[*serverlessapplicationrepository.ServerlessApplicationRepository]
+	servicecatalogConn               lazyClient[*servicecatalog.ServiceCatalog]
+	servicecatalogappregistryConn    lazyClient[*appregistry.AppRegistry]
+	servicediscoveryConn             lazyClient[*servicediscovery.ServiceDiscovery]
+	servicequotasConn                lazyClient[*servicequotas.ServiceQuotas]
+	shieldConn                       lazyClient[*shield.Shield]
+	signerConn                       lazyClient[*signer.Signer]
+	sdbConn                          lazyClient[*simpledb.SimpleDB]
+	snowdevicemanagementConn         lazyClient[*snowdevicemanagement.SnowDeviceManagement]
+	snowballConn                     lazyClient[*snowball.Snowball]
+	storagegatewayConn               lazyClient[*storagegateway.StorageGateway]
+	supportConn                      lazyClient[*support.Support]
+	syntheticsConn                   lazyClient[*synthetics.Synthetics]
+	textractConn                     lazyClient[*textract.Textract]
+	timestreamqueryConn              lazyClient[*timestreamquery.TimestreamQuery]
+	timestreamwriteConn              lazyClient[*timestreamwrite.TimestreamWrite]
+	transcribeClient                 lazyClient[*transcribe.Client]
+	transcribestreamingConn          lazyClient[*transcribestreamingservice.TranscribeStreamingService]
+	transferConn                     lazyClient[*transfer.Transfer]
+	translateConn                    lazyClient[*translate.Translate]
+	voiceidConn                      lazyClient[*voiceid.VoiceID]
+	wafConn                          lazyClient[*waf.WAF]
+	wafregionalConn                  lazyClient[*wafregional.WAFRegional]
+	wafv2Conn                        lazyClient[*wafv2.WAFV2]
+	wellarchitectedConn              lazyClient[*wellarchitected.WellArchitected]
+	wisdomConn                       lazyClient[*connectwisdomservice.ConnectWisdomService]
+	workdocsConn                     lazyClient[*workdocs.WorkDocs]
+	worklinkConn                     lazyClient[*worklink.WorkLink]
+	workmailConn                     lazyClient[*workmail.WorkMail]
+	workmailmessageflowConn          lazyClient[*workmailmessageflow.WorkMailMessageFlow]
+	workspacesConn                   lazyClient[*workspaces.WorkSpaces]
+	workspaceswebConn                lazyClient[*workspacesweb.WorkSpacesWeb]
+	xrayConn                         lazyClient[*xray.XRay]
+
+	s3ConnURICleaningDisabled lazyClient[*s3.S3]
 }
 
 func (client *AWSClient) ACMConn() *acm.ACM {
-	return client.acmConn
+	return client.acmConn.Client()
 }
 
 func (client *AWSClient) ACMPCAConn() *acmpca.ACMPCA {
-	return client.acmpcaConn
+	return client.acmpcaConn.Client()
 }
 
 func (client *AWSClient) AMPConn() *prometheusservice.PrometheusService {
-	return client.ampConn
+	return client.ampConn.Client()
 }
 
 func (client *AWSClient) APIGatewayConn() *apigateway.APIGateway {
-	return client.apigatewayConn
+	return client.apigatewayConn.Client()
 }
 
 func (client *AWSClient) APIGatewayManagementAPIConn() *apigatewaymanagementapi.ApiGatewayManagementApi {
-	return client.apigatewaymanagementapiConn
+	return client.apigatewaymanagementapiConn.Client()
 }
 
 func (client *AWSClient) APIGatewayV2Conn() *apigatewayv2.ApiGatewayV2 {
-	return client.apigatewayv2Conn
+	return client.apigatewayv2Conn.Client()
 }
 
 func (client *AWSClient) AccessAnalyzerConn() *accessanalyzer.AccessAnalyzer {
-	return client.accessanalyzerConn
+	return client.accessanalyzerConn.Client()
 }
 
 func (client *AWSClient) AccountConn() *account.Account {
-	return client.accountConn
+	return client.accountConn.Client()
 }
 
 func (client *AWSClient) AlexaForBusinessConn() *alexaforbusiness.AlexaForBusiness {
-	return client.alexaforbusinessConn
+	return client.alexaforbusinessConn.Client()
 }
 
 func (client *AWSClient) AmplifyConn() *amplify.Amplify {
-	return client.amplifyConn
+	return client.amplifyConn.Client()
 }
 
 func (client *AWSClient) AmplifyBackendConn() *amplifybackend.AmplifyBackend {
-	return client.amplifybackendConn
+	return client.amplifybackendConn.Client()
 }
 
 func (client *AWSClient) AmplifyUIBuilderConn() *amplifyuibuilder.AmplifyUIBuilder {
-	return client.amplifyuibuilderConn
+	return client.amplifyuibuilderConn.Client()
 }
 
 func (client *AWSClient) AppAutoScalingConn() *applicationautoscaling.ApplicationAutoScaling {
-	return client.applicationautoscalingConn
+	return client.applicationautoscalingConn.Client()
 }
 
 func (client *AWSClient) AppConfigConn() *appconfig.AppConfig {
-	return client.appconfigConn
+	return client.appconfigConn.Client()
 }
 
 func (client *AWSClient) AppConfigDataConn() *appconfigdata.AppConfigData {
-	return client.appconfigdataConn
+	return client.appconfigdataConn.Client()
 }
 
 func (client *AWSClient) AppFlowConn() *appflow.Appflow {
-	return client.appflowConn
+	return client.appflowConn.Client()
 }
 
 func (client *AWSClient) AppIntegrationsConn() *appintegrationsservice.AppIntegrationsService {
-	return client.appintegrationsConn
+	return client.appintegrationsConn.Client()
 }
 
 func (client *AWSClient) AppMeshConn() *appmesh.AppMesh {
-	return client.appmeshConn
+	return client.appmeshConn.Client()
 }
 
 func (client *AWSClient) AppRunnerConn() *apprunner.AppRunner {
-	return client.apprunnerConn
+	return client.apprunnerConn.Client()
 }
 
 func (client *AWSClient) AppStreamConn() *appstream.AppStream {
-	return client.appstreamConn
+	return client.appstreamConn.Client()
 }
 
 func (client *AWSClient) AppSyncConn() *appsync.AppSync {
-	return client.appsyncConn
+	return client.appsyncConn.Client()
 }
 
 func (client *AWSClient) ApplicationCostProfilerConn() *applicationcostprofiler.ApplicationCostProfiler {
-	return client.applicationcostprofilerConn
+	return client.applicationcostprofilerConn.Client()
 }
 
 func (client *AWSClient) ApplicationInsightsConn() *applicationinsights.ApplicationInsights {
-	return client.applicationinsightsConn
+	return client.applicationinsightsConn.Client()
 }
 
 func (client *AWSClient) AthenaConn() *athena.Athena {
-	return client.athenaConn
+	return client.athenaConn.Client()
 }
 
 func (client *AWSClient) AuditManagerClient() *auditmanager.Client {
-	return client.auditmanagerClient
+	return client.auditmanagerClient.Client()
 }
 
 func (client *AWSClient) AutoScalingConn() *autoscaling.AutoScaling {
-	return client.autoscalingConn
+	return client.autoscalingConn.Client()
 }
 
 func (client *AWSClient) AutoScalingPlansConn() *autoscalingplans.AutoScalingPlans {
-	return client.autoscalingplansConn
+	return client.autoscalingplansConn.Client()
 }
 
 func (client *AWSClient) BackupConn() *backup.Backup {
-	return client.backupConn
+	return client.backupConn.Client()
 }
 
 func (client *AWSClient) BackupGatewayConn() *backupgateway.BackupGateway {
-	return client.backupgatewayConn
+	return client.backupgatewayConn.Client()
 }
 
 func (client *AWSClient) BatchConn() *batch.Batch {
-	return client.batchConn
+	return client.batchConn.Client()
 }
 
 func (client *AWSClient) BillingConductorConn() *billingconductor.BillingConductor {
-	return client.billingconductorConn
+	return client.billingconductorConn.Client()
 }
 
 func (client *AWSClient) BraketConn() *braket.Braket {
-	return client.braketConn
+	return client.braketConn.Client()
 }
 
 func (client *AWSClient) BudgetsConn() *budgets.Budgets {
-	return client.budgetsConn
+	return client.budgetsConn.Client()
 }
 
 func (client *AWSClient) CEConn() *costexplorer.CostExplorer {
-	return client.ceConn
+	return client.ceConn.Client()
 }
 
 func (client *AWSClient) CURConn() *costandusagereportservice.CostandUsageReportService {
-	return client.curConn
+	return client.curConn.Client()
 }
 
 func (client *AWSClient) ChimeConn() *chime.Chime {
-	return client.chimeConn
+	return client.chimeConn.Client()
 }
 
 func (client *AWSClient) ChimeSDKIdentityConn() *chimesdkidentity.ChimeSDKIdentity {
-	return client.chimesdkidentityConn
+	return client.chimesdkidentityConn.Client()
 }
 
 func (client *AWSClient) ChimeSDKMeetingsConn() *chimesdkmeetings.ChimeSDKMeetings {
-	return client.chimesdkmeetingsConn
+	return client.chimesdkmeetingsConn.Client()
 }
 
 func (client *AWSClient) ChimeSDKMessagingConn() *chimesdkmessaging.ChimeSDKMessaging {
-	return client.chimesdkmessagingConn
+	return client.chimesdkmessagingConn.Client()
 }
 
 func (client *AWSClient) Cloud9Conn() *cloud9.Cloud9 {
-	return client.cloud9Conn
+	return client.cloud9Conn.Client()
 }
 
 func (client *AWSClient) CloudControlClient() *cloudcontrol.Client {
-	return client.cloudcontrolClient
+	return client.cloudcontrolClient.Client()
 }
 
 func (client *AWSClient) CloudDirectoryConn() *clouddirectory.CloudDirectory {
-	return client.clouddirectoryConn
+	return client.clouddirectoryConn.Client()
 }
 
 func (client *AWSClient) CloudFormationConn() *cloudformation.CloudFormation {
-	return client.cloudformationConn
+	return client.cloudformationConn.Client()
 }
 
 func (client *AWSClient) CloudFrontConn() *cloudfront.CloudFront {
-	return client.cloudfrontConn
+	return client.cloudfrontConn.Client()
 }
 
 func (client *AWSClient) CloudHSMV2Conn() *cloudhsmv2.CloudHSMV2 {
-	return client.cloudhsmv2Conn
+	return client.cloudhsmv2Conn.Client()
 }
 
 func (client *AWSClient) CloudSearchConn() *cloudsearch.CloudSearch {
-	return client.cloudsearchConn
+	return client.cloudsearchConn.Client()
 }
 
 func (client *AWSClient) CloudSearchDomainConn() *cloudsearchdomain.CloudSearchDomain {
-	return client.cloudsearchdomainConn
+	return client.cloudsearchdomainConn.Client()
 }
 
 func (client *AWSClient) CloudTrailConn() *cloudtrail.CloudTrail {
-	return client.cloudtrailConn
+	return client.cloudtrailConn.Client()
 }
 
 func (client *AWSClient) CloudWatchConn() *cloudwatch.CloudWatch {
-	return client.cloudwatchConn
+	return client.cloudwatchConn.Client()
 }
 
 func (client *AWSClient) CodeArtifactConn() *codeartifact.CodeArtifact {
-	return client.codeartifactConn
+	return client.codeartifactConn.Client()
 }
 
 func (client *AWSClient) CodeBuildConn() *codebuild.CodeBuild {
-	return client.codebuildConn
+	return client.codebuildConn.Client()
 }
 
 func (client *AWSClient) CodeCommitConn() *codecommit.CodeCommit {
-	return client.codecommitConn
+	return client.codecommitConn.Client()
 }
 
 func (client *AWSClient) CodeGuruProfilerConn() *codeguruprofiler.CodeGuruProfiler {
-	return client.codeguruprofilerConn
+	return client.codeguruprofilerConn.Client()
 }
 
 func (client *AWSClient) CodeGuruReviewerConn() *codegurureviewer.CodeGuruReviewer {
-	return client.codegurureviewerConn
+	return client.codegurureviewerConn.Client()
 }
 
 func (client *AWSClient) CodePipelineConn() *codepipeline.CodePipeline {
-	return client.codepipelineConn
+	return client.codepipelineConn.Client()
 }
 
 func (client *AWSClient) CodeStarConn() *codestar.CodeStar {
-	return client.codestarConn
+	return client.codestarConn.Client()
 }
 
 func (client *AWSClient) CodeStarConnectionsConn() *codestarconnections.CodeStarConnections {
-	return client.codestarconnectionsConn
+	return client.codestarconnectionsConn.Client()
 }
 
 func (client *AWSClient) CodeStarNotificationsConn() *codestarnotifications.CodeStarNotifications {
-	return client.codestarnotificationsConn
+	return client.codestarnotificationsConn.Client()
 }
 
 func (client *AWSClient) CognitoIDPConn() *cognitoidentityprovider.CognitoIdentityProvider {
-	return client.cognitoidpConn
+	return client.cognitoidpConn.Client()
 }
 
 func (client *AWSClient) CognitoIdentityConn() *cognitoidentity.CognitoIdentity {
-	return client.cognitoidentityConn
+	return client.cognitoidentityConn.Client()
 }
 
 func (client *AWSClient) CognitoSyncConn() *cognitosync.CognitoSync {
-	return client.cognitosyncConn
+	return client.cognitosyncConn.Client()
 }
 
 func (client *AWSClient) ComprehendClient() *comprehend.Client {
-	return client.comprehendClient
+	return client.comprehendClient.Client()
 }
 
 func (client *AWSClient) ComprehendMedicalConn() *comprehendmedical.ComprehendMedical {
-	return client.comprehendmedicalConn
+	return client.comprehendmedicalConn.Client()
 }
 
 func (client *AWSClient) ComputeOptimizerClient() *computeoptimizer.Client {
-	return client.computeoptimizerClient
+	return client.computeoptimizerClient.Client()
 }
 
 func (client *AWSClient) ConfigServiceConn() *configservice.ConfigService {
-	return client.configserviceConn
+	return client.configserviceConn.Client()
 }
 
 func (client *AWSClient) ConnectConn() *connect.Connect {
-	return client.connectConn
+	return client.connectConn.Client()
 }
 
 func (client *AWSClient) ConnectContactLensConn() *connectcontactlens.ConnectContactLens {
-	return client.connectcontactlensConn
+	return client.connectcontactlensConn.Client()
 }
 
 func (client *AWSClient) ConnectParticipantConn() *connectparticipant.ConnectParticipant {
-	return client.connectparticipantConn
+	return client.connectparticipantConn.Client()
 }
 
 func (client *AWSClient) ControlTowerConn() *controltower.ControlTower {
-	return client.controltowerConn
+	return client.controltowerConn.Client()
 }
 
 func (client *AWSClient) CustomerProfilesConn() *customerprofiles.CustomerProfiles {
-	return client.customerprofilesConn
+	return client.customerprofilesConn.Client()
 }
 
 func (client *AWSClient) DAXConn() *dax.DAX {
-	return client.daxConn
+	return client.daxConn.Client()
 }
 
 func (client *AWSClient) DLMConn() *dlm.DLM {
-	return client.dlmConn
+	return client.dlmConn.Client()
 }
 
 func (client *AWSClient) DMSConn() *databasemigrationservice.DatabaseMigrationService {
-	return client.dmsConn
+	return client.dmsConn.Client()
 }
 
 func (client *AWSClient) DRSConn() *drs.Drs {
-	return client.drsConn
+	return client.drsConn.Client()
 }
 
 func (client *AWSClient) DSConn() *directoryservice.DirectoryService {
-	return client.dsConn
+	return client.dsConn.Client()
 }
 
 func (client *AWSClient) DataBrewConn() *gluedatabrew.GlueDataBrew {
-	return client.databrewConn
+	return client.databrewConn.Client()
 }
 
 func (client *AWSClient) DataExchangeConn() *dataexchange.DataExchange {
-	return client.dataexchangeConn
+	return client.dataexchangeConn.Client()
 }
 
 func (client *AWSClient) DataPipelineConn() *datapipeline.DataPipeline {
-	return client.datapipelineConn
+	return client.datapipelineConn.Client()
 }
 
 func (client *AWSClient) DataSyncConn() *datasync.DataSync {
-	return client.datasyncConn
+	return client.datasyncConn.Client()
 }
 
 func (client *AWSClient) DeployConn() *codedeploy.CodeDeploy {
-	return client.deployConn
+	return client.deployConn.Client()
 }
 
 func (client *AWSClient) DetectiveConn() *detective.Detective {
-	return client.detectiveConn
+	return client.detectiveConn.Client()
 }
 
 func (client *AWSClient) DevOpsGuruConn() *devopsguru.DevOpsGuru {
-	return client.devopsguruConn
+	return client.devopsguruConn.Client()
 }
 
 func (client *AWSClient) DeviceFarmConn() *devicefarm.DeviceFarm {
-	return client.devicefarmConn
+	return client.devicefarmConn.Client()
 }
 
 func (client *AWSClient) DirectConnectConn() *directconnect.DirectConnect {
-	return client.directconnectConn
+	return client.directconnectConn.Client()
 }
 
 func (client *AWSClient) DiscoveryConn() *applicationdiscoveryservice.ApplicationDiscoveryService {
-	return client.discoveryConn
+	return client.discoveryConn.Client()
 }
 
 func (client *AWSClient) DocDBConn() *docdb.DocDB {
-	return client.docdbConn
+	return client.docdbConn.Client()
 }
 
 func (client *AWSClient) DynamoDBConn() *dynamodb.DynamoDB {
-	return client.dynamodbConn
+	return client.dynamodbConn.Client()
 }
 
 func (client *AWSClient) DynamoDBStreamsConn() *dynamodbstreams.DynamoDBStreams {
-	return client.dynamodbstreamsConn
+	return client.dynamodbstreamsConn.Client()
 }
 
 func (client *AWSClient) EBSConn() *ebs.EBS {
-	return client.ebsConn
+	return client.ebsConn.Client()
 }
 
 func (client *AWSClient) EC2Conn() *ec2.EC2 {
-	return client.ec2Conn
+	return client.ec2Conn.Client()
 }
 
 func (client *AWSClient) EC2Client() *ec2_sdkv2.Client {
@@ -1022,335 +1024,335 @@ func (client *AWSClient) EC2Client() *ec2_sdkv2.Client {
 }
 
 func (client *AWSClient) EC2InstanceConnectConn() *ec2instanceconnect.EC2InstanceConnect {
-	return client.ec2instanceconnectConn
+	return client.ec2instanceconnectConn.Client()
 }
 
 func (client *AWSClient) ECRConn() *ecr.ECR {
-	return client.ecrConn
+	return client.ecrConn.Client()
 }
 
 func (client *AWSClient) ECRPublicConn() *ecrpublic.ECRPublic {
-	return client.ecrpublicConn
+	return client.ecrpublicConn.Client()
 }
 
 func (client *AWSClient) ECSConn() *ecs.ECS {
-	return client.ecsConn
+	return client.ecsConn.Client()
 }
 
 func (client *AWSClient) EFSConn() *efs.EFS {
-	return client.efsConn
+	return client.efsConn.Client()
 }
 
 func (client *AWSClient) EKSConn() *eks.EKS {
-	return client.eksConn
+	return client.eksConn.Client()
 }
 
 func (client *AWSClient) ELBConn() *elb.ELB {
-	return client.elbConn
+	return client.elbConn.Client()
 }
 
 func (client *AWSClient) ELBV2Conn() *elbv2.ELBV2 {
-	return client.elbv2Conn
+	return client.elbv2Conn.Client()
 }
 
 func (client *AWSClient) EMRConn() *emr.EMR {
-	return client.emrConn
+	return client.emrConn.Client()
 }
 
 func (client *AWSClient) EMRContainersConn() *emrcontainers.EMRContainers {
-	return client.emrcontainersConn
+	return client.emrcontainersConn.Client()
 }
 
 func (client *AWSClient) EMRServerlessConn() *emrserverless.EMRServerless {
-	return client.emrserverlessConn
+	return client.emrserverlessConn.Client()
 }
 
 func (client *AWSClient) ElastiCacheConn() *elasticache.ElastiCache {
-	return client.elasticacheConn
+	return client.elasticacheConn.Client()
 }
 
 func (client *AWSClient) ElasticBeanstalkConn() *elasticbeanstalk.ElasticBeanstalk {
-	return client.elasticbeanstalkConn
+	return client.elasticbeanstalkConn.Client()
 }
 
 func (client *AWSClient) ElasticInferenceConn() *elasticinference.ElasticInference {
-	return client.elasticinferenceConn
+	return client.elasticinferenceConn.Client()
 }
 
 func (client *AWSClient) ElasticTranscoderConn() *elastictranscoder.ElasticTranscoder {
-	return client.elastictranscoderConn
+	return client.elastictranscoderConn.Client()
 }
 
 func (client *AWSClient) ElasticsearchConn() *elasticsearchservice.ElasticsearchService {
-	return client.esConn
+	return client.esConn.Client()
 }
 
 func (client *AWSClient) EventsConn() *eventbridge.EventBridge {
-	return client.eventsConn
+	return client.eventsConn.Client()
 }
 
 func (client *AWSClient) EvidentlyConn() *cloudwatchevidently.CloudWatchEvidently {
-	return client.evidentlyConn
+	return client.evidentlyConn.Client()
 }
 
 func (client *AWSClient) FISClient() *fis.Client {
-	return client.fisClient
+	return client.fisClient.Client()
 }
 
 func (client *AWSClient) FMSConn() *fms.FMS {
-	return client.fmsConn
+	return client.fmsConn.Client()
 }
 
 func (client *AWSClient) FSxConn() *fsx.FSx {
-	return client.fsxConn
+	return client.fsxConn.Client()
 }
 
 func (client *AWSClient) FinSpaceConn() *finspace.Finspace {
-	return client.finspaceConn
+	return client.finspaceConn.Client()
 }
 
 func (client *AWSClient) FinSpaceDataConn() *finspacedata.FinSpaceData {
-	return client.finspacedataConn
+	return client.finspacedataConn.Client()
 }
 
 func (client *AWSClient) FirehoseConn() *firehose.Firehose {
-	return client.firehoseConn
+	return client.firehoseConn.Client()
 }
 
 func (client *AWSClient) ForecastConn() *forecastservice.ForecastService {
-	return client.forecastConn
+	return client.forecastConn.Client()
 }
 
 func (client *AWSClient) ForecastQueryConn() *forecastqueryservice.ForecastQueryService {
-	return client.forecastqueryConn
+	return client.forecastqueryConn.Client()
 }
 
 func (client *AWSClient) FraudDetectorConn() *frauddetector.FraudDetector {
-	return client.frauddetectorConn
+	return client.frauddetectorConn.Client()
 }
 
 func (client *AWSClient) GameLiftConn() *gamelift.GameLift {
-	return client.gameliftConn
+	return client.gameliftConn.Client()
 }
 
 func (client *AWSClient) GlacierConn() *glacier.Glacier {
-	return client.glacierConn
+	return client.glacierConn.Client()
 }
 
 func (client *AWSClient) GlobalAcceleratorConn() *globalaccelerator.GlobalAccelerator {
-	return client.globalacceleratorConn
+	return client.globalacceleratorConn.Client()
 }
 
 func (client *AWSClient) GlueConn() *glue.Glue {
-	return client.glueConn
+	return client.glueConn.Client()
 }
 
 func (client *AWSClient) GrafanaConn() *managedgrafana.ManagedGrafana {
-	return client.grafanaConn
+	return client.grafanaConn.Client()
 }
 
 func (client *AWSClient) GreengrassConn() *greengrass.Greengrass {
-	return client.greengrassConn
+	return client.greengrassConn.Client()
 }
 
 func (client *AWSClient) GreengrassV2Conn() *greengrassv2.GreengrassV2 {
-	return client.greengrassv2Conn
+	return client.greengrassv2Conn.Client()
 }
 
 func (client *AWSClient) GroundStationConn() *groundstation.GroundStation {
-	return client.groundstationConn
+	return client.groundstationConn.Client()
 }
 
 func (client *AWSClient) GuardDutyConn() *guardduty.GuardDuty {
-	return client.guarddutyConn
+	return client.guarddutyConn.Client()
 }
 
 func (client *AWSClient) HealthConn() *health.Health {
-	return client.healthConn
+	return client.healthConn.Client()
 }
 
 func (client *AWSClient) HealthLakeConn() *healthlake.HealthLake {
-	return client.healthlakeConn
+	return client.healthlakeConn.Client()
 }
 
 func (client *AWSClient) HoneycodeConn() *honeycode.Honeycode {
-	return client.honeycodeConn
+	return client.honeycodeConn.Client()
 }
 
 func (client *AWSClient) IAMConn() *iam.IAM {
-	return client.iamConn
+	return client.iamConn.Client()
 }
 
 func (client *AWSClient) IVSConn() *ivs.IVS {
-	return client.ivsConn
+	return client.ivsConn.Client()
 }
 
 func (client *AWSClient) IVSChatClient() *ivschat.Client {
-	return client.ivschatClient
+	return client.ivschatClient.Client()
 }
 
 func (client *AWSClient) IdentityStoreClient() *identitystore.Client {
-	return client.identitystoreClient
+	return client.identitystoreClient.Client()
 }
 
 func (client *AWSClient) ImageBuilderConn() *imagebuilder.Imagebuilder {
-	return client.imagebuilderConn
+	return client.imagebuilderConn.Client()
 }
 
 func (client *AWSClient) InspectorConn() *inspector.Inspector {
-	return client.inspectorConn
+	return client.inspectorConn.Client()
 }
 
 func (client *AWSClient) Inspector2Client() *inspector2.Client {
-	return client.inspector2Client
+	return client.inspector2Client.Client()
 }
 
 func (client *AWSClient) IoTConn() *iot.IoT {
-	return client.iotConn
+	return client.iotConn.Client()
 }
 
 func (client *AWSClient) IoT1ClickDevicesConn() *iot1clickdevicesservice.IoT1ClickDevicesService {
-	return client.iot1clickdevicesConn
+	return client.iot1clickdevicesConn.Client()
 }
 
 func (client *AWSClient) IoT1ClickProjectsConn() *iot1clickprojects.IoT1ClickProjects {
-	return client.iot1clickprojectsConn
+	return client.iot1clickprojectsConn.Client()
 }
 
 func (client *AWSClient) IoTAnalyticsConn() *iotanalytics.IoTAnalytics {
-	return client.iotanalyticsConn
+	return client.iotanalyticsConn.Client()
 }
 
 func (client *AWSClient) IoTDataConn() *iotdataplane.IoTDataPlane {
-	return client.iotdataConn
+	return client.iotdataConn.Client()
 }
 
 func (client *AWSClient) IoTDeviceAdvisorConn() *iotdeviceadvisor.IoTDeviceAdvisor {
-	return client.iotdeviceadvisorConn
+	return client.iotdeviceadvisorConn.Client()
 }
 
 func (client *AWSClient) IoTEventsConn() *iotevents.IoTEvents {
-	return client.ioteventsConn
+	return client.ioteventsConn.Client()
 }
 
 func (client *AWSClient) IoTEventsDataConn() *ioteventsdata.IoTEventsData {
-	return client.ioteventsdataConn
+	return client.ioteventsdataConn.Client()
 }
 
 func (client *AWSClient) IoTFleetHubConn() *iotfleethub.IoTFleetHub {
-	return client.iotfleethubConn
+	return client.iotfleethubConn.Client()
 }
 
 func (client *AWSClient) IoTJobsDataConn() *iotjobsdataplane.IoTJobsDataPlane {
-	return client.iotjobsdataConn
+	return client.iotjobsdataConn.Client()
 }
 
 func (client *AWSClient) IoTSecureTunnelingConn() *iotsecuretunneling.IoTSecureTunneling {
-	return client.iotsecuretunnelingConn
+	return client.iotsecuretunnelingConn.Client()
 }
 
 func (client *AWSClient) IoTSiteWiseConn() *iotsitewise.IoTSiteWise {
-	return client.iotsitewiseConn
+	return client.iotsitewiseConn.Client()
 }
 
 func (client *AWSClient) IoTThingsGraphConn() *iotthingsgraph.IoTThingsGraph {
-	return client.iotthingsgraphConn
+	return client.iotthingsgraphConn.Client()
 }
 
 func (client *AWSClient) IoTTwinMakerConn() *iottwinmaker.IoTTwinMaker {
-	return client.iottwinmakerConn
+	return client.iottwinmakerConn.Client()
 }
 
 func (client *AWSClient) IoTWirelessConn() *iotwireless.IoTWireless {
-	return client.iotwirelessConn
+	return client.iotwirelessConn.Client()
 }
 
 func (client *AWSClient) KMSConn() *kms.KMS {
-	return client.kmsConn
+	return client.kmsConn.Client()
 }
 
 func (client *AWSClient) KafkaConn() *kafka.Kafka {
-	return client.kafkaConn
+	return client.kafkaConn.Client()
 }
 
 func (client *AWSClient) KafkaConnectConn() *kafkaconnect.KafkaConnect {
-	return client.kafkaconnectConn
+	return client.kafkaconnectConn.Client()
 }
 
 func (client *AWSClient) KendraClient() *kendra.Client {
-	return client.kendraClient
+	return client.kendraClient.Client()
 }
 
 func (client *AWSClient) KeyspacesConn() *keyspaces.Keyspaces {
-	return client.keyspacesConn
+	return client.keyspacesConn.Client()
 }
 
 func (client *AWSClient) KinesisConn() *kinesis.Kinesis {
-	return client.kinesisConn
+	return client.kinesisConn.Client()
 }
 
 func (client *AWSClient) KinesisAnalyticsConn() *kinesisanalytics.KinesisAnalytics {
-	return client.kinesisanalyticsConn
+	return client.kinesisanalyticsConn.Client()
 }
 
 func (client *AWSClient) KinesisAnalyticsV2Conn() *kinesisanalyticsv2.KinesisAnalyticsV2 {
-	return client.kinesisanalyticsv2Conn
+	return client.kinesisanalyticsv2Conn.Client()
 }
 
 func (client *AWSClient) KinesisVideoConn() *kinesisvideo.KinesisVideo {
-	return client.kinesisvideoConn
+	return client.kinesisvideoConn.Client()
 }
 
 func (client *AWSClient) KinesisVideoArchivedMediaConn() *kinesisvideoarchivedmedia.KinesisVideoArchivedMedia {
-	return client.kinesisvideoarchivedmediaConn
+	return client.kinesisvideoarchivedmediaConn.Client()
 }
 
 func (client *AWSClient) KinesisVideoMediaConn() *kinesisvideomedia.KinesisVideoMedia {
-	return client.kinesisvideomediaConn
+	return client.kinesisvideomediaConn.Client()
 }
 
 func (client *AWSClient) KinesisVideoSignalingConn() *kinesisvideosignalingchannels.KinesisVideoSignalingChannels {
-	return client.kinesisvideosignalingConn
+	return client.kinesisvideosignalingConn.Client()
 }
 
 func (client *AWSClient) LakeFormationConn() *lakeformation.LakeFormation {
-	return client.lakeformationConn
+	return client.lakeformationConn.Client()
 }
 
 func (client *AWSClient) LambdaConn() *lambda.Lambda {
-	return client.lambdaConn
+	return client.lambdaConn.Client()
 }
 
 func (client *AWSClient) LexModelsConn() *lexmodelbuildingservice.LexModelBuildingService {
-	return client.lexmodelsConn
+	return client.lexmodelsConn.Client()
 }
 
 func (client *AWSClient) LexModelsV2Conn() *lexmodelsv2.LexModelsV2 {
-	return client.lexmodelsv2Conn
+	return client.lexmodelsv2Conn.Client()
 }
 
 func (client *AWSClient) LexRuntimeConn() *lexruntimeservice.LexRuntimeService {
-	return client.lexruntimeConn
+	return client.lexruntimeConn.Client()
 }
 
 func (client *AWSClient) LexRuntimeV2Conn() *lexruntimev2.LexRuntimeV2 {
-	return client.lexruntimev2Conn
+	return client.lexruntimev2Conn.Client()
 }
 
 func (client *AWSClient) LicenseManagerConn() *licensemanager.LicenseManager {
-	return client.licensemanagerConn
+	return client.licensemanagerConn.Client()
 }
 
 func (client *AWSClient) LightsailConn() *lightsail.Lightsail {
-	return client.lightsailConn
+	return client.lightsailConn.Client()
 }
 
 func (client *AWSClient) LocationConn() *locationservice.LocationService {
-	return client.locationConn
+	return client.locationConn.Client()
 }
 
 func (client *AWSClient) LogsConn() *cloudwatchlogs.CloudWatchLogs {
-	return client.logsConn
+	return client.logsConn.Client()
 }
 
 func (client *AWSClient) LogsClient() *cloudwatchlogs_sdkv2.Client {
@@ -1358,235 +1360,235 @@ func (client *AWSClient) LogsClient() *cloudwatchlogs_sdkv2.Client {
 }
 
 func (client *AWSClient) LookoutEquipmentConn() *lookoutequipment.LookoutEquipment {
-	return client.lookoutequipmentConn
+	return client.lookoutequipmentConn.Client()
 }
 
 func (client *AWSClient) LookoutMetricsConn() *lookoutmetrics.LookoutMetrics {
-	return client.lookoutmetricsConn
+	return client.lookoutmetricsConn.Client()
 }
 
 func (client *AWSClient) LookoutVisionConn() *lookoutforvision.LookoutForVision {
-	return client.lookoutvisionConn
+	return client.lookoutvisionConn.Client()
 }
 
 func (client *AWSClient) MQConn() *mq.MQ {
-	return client.mqConn
+	return client.mqConn.Client()
 }
 
 func (client *AWSClient) MTurkConn() *mturk.MTurk {
-	return client.mturkConn
+	return client.mturkConn.Client()
 }
 
 func (client *AWSClient) MWAAConn() *mwaa.MWAA {
-	return client.mwaaConn
+	return client.mwaaConn.Client()
 }
 
 func (client *AWSClient) MachineLearningConn() *machinelearning.MachineLearning {
-	return client.machinelearningConn
+	return client.machinelearningConn.Client()
 }
 
 func (client *AWSClient) MacieConn() *macie.Macie {
-	return client.macieConn
+	return client.macieConn.Client()
 }
 
 func (client *AWSClient) Macie2Conn() *macie2.Macie2 {
-	return client.macie2Conn
+	return client.macie2Conn.Client()
 }
 
 func (client *AWSClient) ManagedBlockchainConn() *managedblockchain.ManagedBlockchain {
-	return client.managedblockchainConn
+	return client.managedblockchainConn.Client()
 }
 
 func (client *AWSClient) MarketplaceCatalogConn() *marketplacecatalog.MarketplaceCatalog {
-	return client.marketplacecatalogConn
+	return client.marketplacecatalogConn.Client()
 }
 
 func (client *AWSClient) MarketplaceCommerceAnalyticsConn() *marketplacecommerceanalytics.MarketplaceCommerceAnalytics {
-	return client.marketplacecommerceanalyticsConn
+	return client.marketplacecommerceanalyticsConn.Client()
 }
 
 func (client *AWSClient) MarketplaceEntitlementConn() *marketplaceentitlementservice.MarketplaceEntitlementService {
-	return client.marketplaceentitlementConn
+	return client.marketplaceentitlementConn.Client()
 }
 
 func (client *AWSClient) MarketplaceMeteringConn() *marketplacemetering.MarketplaceMetering {
-	return client.marketplacemeteringConn
+	return client.marketplacemeteringConn.Client()
 }
 
 func (client *AWSClient) MediaConnectConn() *mediaconnect.MediaConnect {
-	return client.mediaconnectConn
+	return client.mediaconnectConn.Client()
 }
 
 func (client *AWSClient) MediaConvertConn() *mediaconvert.MediaConvert {
-	return client.mediaconvertConn
+	return client.mediaconvertConn.Client()
 }
 
 func (client *AWSClient) MediaLiveClient() *medialive.Client {
-	return client.medialiveClient
+	return client.medialiveClient.Client()
 }
 
 func (client *AWSClient) MediaPackageConn() *mediapackage.MediaPackage {
-	return client.mediapackageConn
+	return client.mediapackageConn.Client()
 }
 
 func (client *AWSClient) MediaPackageVODConn() *mediapackagevod.MediaPackageVod {
-	return client.mediapackagevodConn
+	return client.mediapackagevodConn.Client()
 }
 
 func (client *AWSClient) MediaStoreConn() *mediastore.MediaStore {
-	return client.mediastoreConn
+	return client.mediastoreConn.Client()
 }
 
 func (client *AWSClient) MediaStoreDataConn() *mediastoredata.MediaStoreData {
-	return client.mediastoredataConn
+	return client.mediastoredataConn.Client()
 }
 
 func (client *AWSClient) MediaTailorConn() *mediatailor.MediaTailor {
-	return client.mediatailorConn
+	return client.mediatailorConn.Client()
 }
 
 func (client *AWSClient) MemoryDBConn() *memorydb.MemoryDB {
-	return client.memorydbConn
+	return client.memorydbConn.Client()
 }
 
 func (client *AWSClient) MgHConn() *migrationhub.MigrationHub {
-	return client.mghConn
+	return client.mghConn.Client()
 }
 
 func (client *AWSClient) MgnConn() *mgn.Mgn {
-	return client.mgnConn
+	return client.mgnConn.Client()
 }
 
 func (client *AWSClient) MigrationHubConfigConn() *migrationhubconfig.MigrationHubConfig {
-	return client.migrationhubconfigConn
+	return client.migrationhubconfigConn.Client()
 }
 
 func (client *AWSClient) MigrationHubRefactorSpacesConn() *migrationhubrefactorspaces.MigrationHubRefactorSpaces {
-	return client.migrationhubrefactorspacesConn
+	return client.migrationhubrefactorspacesConn.Client()
 }
 
 func (client *AWSClient) MigrationHubStrategyConn() *migrationhubstrategyrecommendations.MigrationHubStrategyRecommendations {
-	return client.migrationhubstrategyConn
+	return client.migrationhubstrategyConn.Client()
 }
 
 func (client *AWSClient) MobileConn() *mobile.Mobile {
-	return client.mobileConn
+	return client.mobileConn.Client()
 }
 
 func (client *AWSClient) NeptuneConn() *neptune.Neptune {
-	return client.neptuneConn
+	return client.neptuneConn.Client()
 }
 
 func (client *AWSClient) NetworkFirewallConn() *networkfirewall.NetworkFirewall {
-	return client.networkfirewallConn
+	return client.networkfirewallConn.Client()
 }
 
 func (client *AWSClient) NetworkManagerConn() *networkmanager.NetworkManager {
-	return client.networkmanagerConn
+	return client.networkmanagerConn.Client()
 }
 
 func (client *AWSClient) NimbleConn() *nimblestudio.NimbleStudio {
-	return client.nimbleConn
+	return client.nimbleConn.Client()
 }
 
 func (client *AWSClient) ObservabilityAccessManagerClient() *oam.Client {
-	return client.oamClient
+	return client.oamClient.Client()
 }
 
 func (client *AWSClient) OpenSearchConn() *opensearchservice.OpenSearchService {
-	return client.opensearchConn
+	return client.opensearchConn.Client()
 }
 
 func (client *AWSClient) OpenSearchServerlessClient() *opensearchserverless.Client {
-	return client.opensearchserverlessClient
+	return client.opensearchserverlessClient.Client()
 }
 
 func (client *AWSClient) OpsWorksConn() *opsworks.OpsWorks {
-	return client.opsworksConn
+	return client.opsworksConn.Client()
 }
 
 func (client *AWSClient) OpsWorksCMConn() *opsworkscm.OpsWorksCM {
-	return client.opsworkscmConn
+	return client.opsworkscmConn.Client()
 }
 
 func (client *AWSClient) OrganizationsConn() *organizations.Organizations {
-	return client.organizationsConn
+	return client.organizationsConn.Client()
 }
 
 func (client *AWSClient) OutpostsConn() *outposts.Outposts {
-	return client.outpostsConn
+	return client.outpostsConn.Client()
 }
 
 func (client *AWSClient) PIConn() *pi.PI {
-	return client.piConn
+	return client.piConn.Client()
 }
 
 func (client *AWSClient) PanoramaConn() *panorama.Panorama {
-	return client.panoramaConn
+	return client.panoramaConn.Client()
 }
 
 func (client *AWSClient) PersonalizeConn() *personalize.Personalize {
-	return client.personalizeConn
+	return client.personalizeConn.Client()
 }
 
 func (client *AWSClient) PersonalizeEventsConn() *personalizeevents.PersonalizeEvents {
-	return client.personalizeeventsConn
+	return client.personalizeeventsConn.Client()
 }
 
 func (client *AWSClient) PersonalizeRuntimeConn() *personalizeruntime.PersonalizeRuntime {
-	return client.personalizeruntimeConn
+	return client.personalizeruntimeConn.Client()
 }
 
 func (client *AWSClient) PinpointConn() *pinpoint.Pinpoint {
-	return client.pinpointConn
+	return client.pinpointConn.Client()
 }
 
 func (client *AWSClient) PinpointEmailConn() *pinpointemail.PinpointEmail {
-	return client.pinpointemailConn
+	return client.pinpointemailConn.Client()
 }
 
 func (client *AWSClient) PinpointSMSVoiceConn() *pinpointsmsvoice.PinpointSMSVoice {
-	return client.pinpointsmsvoiceConn
+	return client.pinpointsmsvoiceConn.Client()
 }
 
 func (client *AWSClient) PipesClient() *pipes.Client {
-	return client.pipesClient
+	return client.pipesClient.Client()
 }
 
 func (client *AWSClient) PollyConn() *polly.Polly {
-	return client.pollyConn
+	return client.pollyConn.Client()
 }
 
 func (client *AWSClient) PricingConn() *pricing.Pricing {
-	return client.pricingConn
+	return client.pricingConn.Client()
 }
 
 func (client *AWSClient) ProtonConn() *proton.Proton {
-	return client.protonConn
+	return client.protonConn.Client()
 }
 
 func (client *AWSClient) QLDBConn() *qldb.QLDB {
-	return client.qldbConn
+	return client.qldbConn.Client()
 }
 
 func (client *AWSClient) QLDBSessionConn() *qldbsession.QLDBSession {
-	return client.qldbsessionConn
+	return client.qldbsessionConn.Client()
 }
 
 func (client *AWSClient) QuickSightConn() *quicksight.QuickSight {
-	return client.quicksightConn
+	return client.quicksightConn.Client()
 }
 
 func (client *AWSClient) RAMConn() *ram.RAM {
-	return client.ramConn
+	return client.ramConn.Client()
 }
 
 func (client *AWSClient) RBinConn() *recyclebin.RecycleBin {
-	return client.rbinConn
+	return client.rbinConn.Client()
 }
 
 func (client *AWSClient) RDSConn() *rds.RDS {
-	return client.rdsConn
+	return client.rdsConn.Client()
 }
 
 func (client *AWSClient) RDSClient() *rds_sdkv2.Client {
@@ -1594,83 +1596,83 @@ func (client *AWSClient) RDSClient() *rds_sdkv2.Client {
 }
 
 func (client *AWSClient) RDSDataConn() *rdsdataservice.RDSDataService {
-	return client.rdsdataConn
+	return client.rdsdataConn.Client()
 }
 
 func (client *AWSClient) RUMConn() *cloudwatchrum.CloudWatchRUM {
-	return client.rumConn
+	return client.rumConn.Client()
 }
 
 func (client *AWSClient) RedshiftConn() *redshift.Redshift {
-	return client.redshiftConn
+	return client.redshiftConn.Client()
 }
 
 func (client *AWSClient) RedshiftDataConn() *redshiftdataapiservice.RedshiftDataAPIService {
-	return client.redshiftdataConn
+	return client.redshiftdataConn.Client()
 }
 
 func (client *AWSClient) RedshiftServerlessConn() *redshiftserverless.RedshiftServerless {
-	return client.redshiftserverlessConn
+	return client.redshiftserverlessConn.Client()
 }
 
 func (client *AWSClient) RekognitionConn() *rekognition.Rekognition {
-	return client.rekognitionConn
+	return client.rekognitionConn.Client()
 }
 
 func (client *AWSClient) ResilienceHubConn() *resiliencehub.ResilienceHub {
-	return client.resiliencehubConn
+	return client.resiliencehubConn.Client()
 }
 
 func (client *AWSClient) ResourceExplorer2Client() *resourceexplorer2.Client {
-	return client.resourceexplorer2Client
+	return client.resourceexplorer2Client.Client()
 }
 
 func (client *AWSClient) ResourceGroupsConn() *resourcegroups.ResourceGroups {
-	return client.resourcegroupsConn
+	return client.resourcegroupsConn.Client()
 }
 
 func (client *AWSClient) ResourceGroupsTaggingAPIConn() *resourcegroupstaggingapi.ResourceGroupsTaggingAPI {
-	return client.resourcegroupstaggingapiConn
+	return client.resourcegroupstaggingapiConn.Client()
 }
 
 func (client *AWSClient) RoboMakerConn() *robomaker.RoboMaker {
-	return client.robomakerConn
+	return client.robomakerConn.Client()
 }
 
 func (client *AWSClient) RolesAnywhereClient() *rolesanywhere.Client {
-	return client.rolesanywhereClient
+	return client.rolesanywhereClient.Client()
 }
 
 func (client *AWSClient) Route53Conn() *route53.Route53 {
-	return client.route53Conn
+	return client.route53Conn.Client()
 }
 
 func (client *AWSClient) Route53DomainsClient() *route53domains.Client {
-	return client.route53domainsClient
+	return client.route53domainsClient.Client()
 }
 
 func (client *AWSClient) Route53RecoveryClusterConn() *route53recoverycluster.Route53RecoveryCluster {
-	return client.route53recoveryclusterConn
+	return client.route53recoveryclusterConn.Client()
 }
 
 func (client *AWSClient) Route53RecoveryControlConfigConn() *route53recoverycontrolconfig.Route53RecoveryControlConfig {
-	return client.route53recoverycontrolconfigConn
+	return client.route53recoverycontrolconfigConn.Client()
 }
 
 func (client *AWSClient) Route53RecoveryReadinessConn() *route53recoveryreadiness.Route53RecoveryReadiness {
-	return client.route53recoveryreadinessConn
+	return client.route53recoveryreadinessConn.Client()
 }
 
 func (client *AWSClient) Route53ResolverConn() *route53resolver.Route53Resolver {
-	return client.route53resolverConn
+	return client.route53resolverConn.Client()
 }
 
 func (client *AWSClient) S3Conn() *s3.S3 {
-	return client.s3Conn
+	return client.s3Conn.Client()
 }
 
 func (client *AWSClient) S3ControlConn() *s3control.S3Control {
-	return client.s3controlConn
+	return client.s3controlConn.Client()
 }
 
 func (client *AWSClient) S3ControlClient() *s3control_sdkv2.Client {
@@ -1678,35 +1680,35 @@ func (client *AWSClient) S3ControlClient() *s3control_sdkv2.Client {
 }
 
 func (client *AWSClient) S3OutpostsConn() *s3outposts.S3Outposts {
-	return client.s3outpostsConn
+	return client.s3outpostsConn.Client()
 }
 
 func (client *AWSClient) SESConn() *ses.SES {
-	return client.sesConn
+	return client.sesConn.Client()
 }
 
 func (client *AWSClient) SESV2Client() *sesv2.Client {
-	return client.sesv2Client
+	return client.sesv2Client.Client()
 }
 
 func (client *AWSClient) SFNConn() *sfn.SFN {
-	return client.sfnConn
+	return client.sfnConn.Client()
 }
 
 func (client *AWSClient) SMSConn() *sms.SMS {
-	return client.smsConn
+	return client.smsConn.Client()
 }
 
 func (client *AWSClient) SNSConn() *sns.SNS {
-	return client.snsConn
+	return client.snsConn.Client()
 }
 
 func (client *AWSClient) SQSConn() *sqs.SQS {
-	return client.sqsConn
+	return client.sqsConn.Client()
 }
 
 func (client *AWSClient) SSMConn() *ssm.SSM {
-	return client.ssmConn
+	return client.ssmConn.Client()
 }
 
 func (client *AWSClient) SSMClient() *ssm_sdkv2.Client {
@@ -1714,201 +1716,201 @@ func (client *AWSClient) SSMClient() *ssm_sdkv2.Client {
 }
 
 func (client *AWSClient) SSMContactsConn() *ssmcontacts.SSMContacts {
-	return client.ssmcontactsConn
+	return client.ssmcontactsConn.Client()
 }
 
 func (client *AWSClient) SSMIncidentsClient() *ssmincidents.Client {
-	return client.ssmincidentsClient
+	return client.ssmincidentsClient.Client()
 }
 
 func (client *AWSClient) SSOConn() *sso.SSO {
-	return client.ssoConn
+	return client.ssoConn.Client()
 }
 
 func (client *AWSClient) SSOAdminConn() *ssoadmin.SSOAdmin {
-	return client.ssoadminConn
+	return client.ssoadminConn.Client()
 }
 
 func (client *AWSClient) SSOOIDCConn() *ssooidc.SSOOIDC {
-	return client.ssooidcConn
+	return client.ssooidcConn.Client()
 }
 
 func (client *AWSClient) STSConn() *sts.STS {
-	return client.stsConn
+	return client.stsConn.Client()
 }
 
 func (client *AWSClient) SWFConn() *swf.SWF {
-	return client.swfConn
+	return client.swfConn.Client()
 }
 
 func (client *AWSClient) SageMakerConn() *sagemaker.SageMaker {
-	return client.sagemakerConn
+	return client.sagemakerConn.Client()
 }
 
 func (client *AWSClient) SageMakerA2IRuntimeConn() *augmentedairuntime.AugmentedAIRuntime {
-	return client.sagemakera2iruntimeConn
+	return client.sagemakera2iruntimeConn.Client()
 }
 
 func (client *AWSClient) SageMakerEdgeConn() *sagemakeredgemanager.SagemakerEdgeManager {
-	return client.sagemakeredgeConn
+	return client.sagemakeredgeConn.Client()
 }
 
 func (client *AWSClient) SageMakerFeatureStoreRuntimeConn() *sagemakerfeaturestoreruntime.SageMakerFeatureStoreRuntime {
-	return client.sagemakerfeaturestoreruntimeConn
+	return client.sagemakerfeaturestoreruntimeConn.Client()
 }
 
 func (client *AWSClient) SageMakerRuntimeConn() *sagemakerruntime.SageMakerRuntime {
-	return client.sagemakerruntimeConn
+	return client.sagemakerruntimeConn.Client()
 }
 
 func (client *AWSClient) SavingsPlansConn() *savingsplans.SavingsPlans {
-	return client.savingsplansConn
+	return client.savingsplansConn.Client()
 }
 
 func (client *AWSClient) SchedulerClient() *scheduler.Client {
-	return client.schedulerClient
+	return client.schedulerClient.Client()
 }
 
 func (client *AWSClient) SchemasConn() *schemas.Schemas {
-	return client.schemasConn
+	return client.schemasConn.Client()
 }
 
 func (client *AWSClient) SecretsManagerConn() *secretsmanager.SecretsManager {
-	return client.secretsmanagerConn
+	return client.secretsmanagerConn.Client()
 }
 
 func (client *AWSClient) SecurityHubConn() *securityhub.SecurityHub {
-	return client.securityhubConn
+	return client.securityhubConn.Client()
 }
 
 func (client *AWSClient) ServerlessRepoConn() *serverlessapplicationrepository.ServerlessApplicationRepository {
-	return client.serverlessrepoConn
+	return client.serverlessrepoConn.Client()
 }
 
 func (client *AWSClient) ServiceCatalogConn() *servicecatalog.ServiceCatalog {
-	return client.servicecatalogConn
+	return client.servicecatalogConn.Client()
 }
 
 func (client *AWSClient) ServiceCatalogAppRegistryConn() *appregistry.AppRegistry {
-	return client.servicecatalogappregistryConn
+	return client.servicecatalogappregistryConn.Client()
 }
 
 func (client *AWSClient) ServiceDiscoveryConn() *servicediscovery.ServiceDiscovery {
-	return client.servicediscoveryConn
+	return client.servicediscoveryConn.Client()
 }
 
 func (client *AWSClient) ServiceQuotasConn() *servicequotas.ServiceQuotas {
-	return client.servicequotasConn
+	return client.servicequotasConn.Client()
 }
 
 func (client *AWSClient) ShieldConn() *shield.Shield {
-	return client.shieldConn
+	return client.shieldConn.Client()
 }
 
 func (client *AWSClient) SignerConn() *signer.Signer {
-	return client.signerConn
+	return client.signerConn.Client()
 }
 
 func (client *AWSClient) SimpleDBConn() *simpledb.SimpleDB {
-	return client.sdbConn
+	return client.sdbConn.Client()
 }
 
 func (client *AWSClient) SnowDeviceManagementConn() *snowdevicemanagement.SnowDeviceManagement {
-	return client.snowdevicemanagementConn
+	return client.snowdevicemanagementConn.Client()
 }
 
 func (client *AWSClient) SnowballConn() *snowball.Snowball {
-	return client.snowballConn
+	return client.snowballConn.Client()
 }
 
 func (client *AWSClient) StorageGatewayConn() *storagegateway.StorageGateway {
-	return client.storagegatewayConn
+	return client.storagegatewayConn.Client()
 }
 
 func (client *AWSClient) SupportConn() *support.Support {
-	return client.supportConn
+	return client.supportConn.Client()
 }
 
 func (client *AWSClient) SyntheticsConn() *synthetics.Synthetics {
-	return client.syntheticsConn
+	return client.syntheticsConn.Client()
 }
 
 func (client *AWSClient) TextractConn() *textract.Textract {
-	return client.textractConn
+	return client.textractConn.Client()
 }
 
 func (client *AWSClient) TimestreamQueryConn() *timestreamquery.TimestreamQuery {
-	return client.timestreamqueryConn
+	return client.timestreamqueryConn.Client()
 }
 
 func (client *AWSClient) TimestreamWriteConn() *timestreamwrite.TimestreamWrite {
-	return client.timestreamwriteConn
+	return client.timestreamwriteConn.Client()
 }
 
 func (client *AWSClient) TranscribeClient() *transcribe.Client {
-	return client.transcribeClient
+	return client.transcribeClient.Client()
 }
 
 func (client *AWSClient) TranscribeStreamingConn() *transcribestreamingservice.TranscribeStreamingService {
-	return client.transcribestreamingConn
+	return client.transcribestreamingConn.Client()
 }
 
 func (client *AWSClient) TransferConn() *transfer.Transfer {
-	return client.transferConn
+	return client.transferConn.Client()
 }
 
 func (client *AWSClient) TranslateConn() *translate.Translate {
-	return client.translateConn
+	return client.translateConn.Client()
 }
 
 func (client *AWSClient) VoiceIDConn() *voiceid.VoiceID {
-	return client.voiceidConn
+	return client.voiceidConn.Client()
 }
 
 func (client *AWSClient) WAFConn() *waf.WAF {
-	return client.wafConn
+	return client.wafConn.Client()
 }
 
 func (client *AWSClient) WAFRegionalConn() *wafregional.WAFRegional {
-	return client.wafregionalConn
+	return client.wafregionalConn.Client()
 }
 
 func (client *AWSClient) WAFV2Conn() *wafv2.WAFV2 {
-	return client.wafv2Conn
+	return client.wafv2Conn.Client()
 }
 
 func (client *AWSClient) WellArchitectedConn() *wellarchitected.WellArchitected {
-	return client.wellarchitectedConn
+	return client.wellarchitectedConn.Client()
 }
 
 func (client *AWSClient) WisdomConn() *connectwisdomservice.ConnectWisdomService {
-	return client.wisdomConn
+	return client.wisdomConn.Client()
 }
 
 func (client *AWSClient) WorkDocsConn() *workdocs.WorkDocs {
-	return client.workdocsConn
+	return client.workdocsConn.Client()
 }
 
 func (client *AWSClient) WorkLinkConn() *worklink.WorkLink {
-	return client.worklinkConn
+	return client.worklinkConn.Client()
 }
 
 func (client *AWSClient) WorkMailConn() *workmail.WorkMail {
-	return client.workmailConn
+	return client.workmailConn.Client()
 }
 
 func (client *AWSClient) WorkMailMessageFlowConn() *workmailmessageflow.WorkMailMessageFlow {
-	return client.workmailmessageflowConn
+	return client.workmailmessageflowConn.Client()
 }
 
 func (client *AWSClient) WorkSpacesConn() *workspaces.WorkSpaces {
-	return client.workspacesConn
+	return client.workspacesConn.Client()
 }
 
 func (client *AWSClient) WorkSpacesWebConn() *workspacesweb.WorkSpacesWeb {
-	return client.workspaceswebConn
+	return client.workspaceswebConn.Client()
 }
 
 func (client *AWSClient) XRayConn() *xray.XRay {
-	return client.xrayConn
+	return client.xrayConn.Client()
 }
@@ -3,64 +3,57 @@ package conns
 import (
 	"context"
 	"log"
-	"strings"
+	"net/http"
+	"time"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
 	"github.com/aws/aws-sdk-go-v2/service/route53domains"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
-	"github.com/aws/aws-sdk-go/aws/request"
-	"github.com/aws/aws-sdk-go/service/apigateway"
-	"github.com/aws/aws-sdk-go/service/appconfig"
-	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
-	"github.com/aws/aws-sdk-go/service/appsync"
-	"github.com/aws/aws-sdk-go/service/chime"
-	"github.com/aws/aws-sdk-go/service/cloudformation"
-	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
-	"github.com/aws/aws-sdk-go/service/configservice"
-	"github.com/aws/aws-sdk-go/service/dynamodb"
-	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/globalaccelerator"
-	"github.com/aws/aws-sdk-go/service/kafka"
-	"github.com/aws/aws-sdk-go/service/kinesis"
-	"github.com/aws/aws-sdk-go/service/lightsail"
-	"github.com/aws/aws-sdk-go/service/organizations"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
 	"github.com/aws/aws-sdk-go/service/route53recoveryreadiness"
 	"github.com/aws/aws-sdk-go/service/s3"
-	"github.com/aws/aws-sdk-go/service/securityhub"
 	"github.com/aws/aws-sdk-go/service/shield"
-	"github.com/aws/aws-sdk-go/service/ssoadmin"
-	"github.com/aws/aws-sdk-go/service/storagegateway"
 	"github.com/aws/aws-sdk-go/service/sts"
-	"github.com/aws/aws-sdk-go/service/wafv2"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	awsbasev1 "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
-	AssumeRole                     *awsbase.AssumeRole
+	AssumeRole                     []*awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
+	AuditLog                       *AuditLogConfig
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
 	EC2MetadataServiceEnableState  imds.ClientEnableState
 	EC2MetadataServiceEndpoint     string
 	EC2MetadataServiceEndpointMode string
+	Emulator                       *EmulatorConfig
 	Endpoints                      map[string]string
+	ExplicitSettings               map[string]bool
 	ForbiddenAccountIds            []string
+	Guardrails                     *GuardrailsConfig
 	HTTPProxy                      string
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
+	MaxBackoff                     time.Duration
 	MaxRetries                     int
 	Profile                        string
+	RateLimits                     []RateLimitConfig
 	Region                         string
+	RetryMode                      aws_sdkv2.RetryMode
+	RetryOverrides                 map[string]RetryConfig
+	RetryRules                     []RetryRule
 	S3UsePathStyle                 bool
 	SecretKey                      string
 	SharedConfigFiles              []string
@@ -73,12 +66,15 @@ type Config struct {
 	SuppressDebugLog               bool
 	TerraformVersion               string
 	Token                          string
+	Tracing                        *TracingConfig
 	UseDualStackEndpoint           bool
 	UseFIPSEndpoint                bool
 }
 
 // ConfigureProvider configures the provided provider Meta (instance data).
 func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWSClient, diag.Diagnostics) {
+	c.applyEmulator()
+
 	awsbaseConfig := awsbase.Config{
 		AccessKey:                     c.AccessKey,
 		APNInfo:                       StdUserAgentProducts(c.TerraformVersion),
@@ -103,8 +99,8 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		UseFIPSEndpoint:               c.UseFIPSEndpoint,
 	}
 
-	if c.AssumeRole != nil && c.AssumeRole.RoleARN != "" {
-		awsbaseConfig.AssumeRole = c.AssumeRole
+	if len(c.AssumeRole) > 0 && c.AssumeRole[0] != nil && c.AssumeRole[0].RoleARN != "" {
+		awsbaseConfig.AssumeRole = c.AssumeRole[0]
 	}
 
 	if c.CustomCABundle != "" {
@@ -128,11 +124,54 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		awsbaseConfig.StsRegion = c.STSRegion
 	}
 
+	// Reuse the credentials and caller identity of an earlier provider configuration with the same
+	// credential source, IAM Role chain and partition.
+	// The AWS SDK configuration is loaded using the current value of the cached credentials, so that no IAM Role
+	// is assumed and the credentials are not revalidated, and the cached (refreshing) credentials are then substituted.
+	cacheKey, cacheable := c.credentialsCacheKey()
+	var cached *credentialsCacheEntry
+	if cacheable {
+		if entry, creds, ok := processCredentialsCache.get(ctx, cacheKey); ok {
+			cached = entry
+
+			awsbaseConfig.AccessKey = creds.AccessKeyID
+			awsbaseConfig.AssumeRole = nil
+			awsbaseConfig.AssumeRoleWithWebIdentity = nil
+			awsbaseConfig.SecretKey = creds.SecretAccessKey
+			awsbaseConfig.SkipCredsValidation = true
+			awsbaseConfig.Token = creds.SessionToken
+		}
+	}
+
 	ctx, cfg, err := awsbase.GetAwsConfig(ctx, &awsbaseConfig)
 	if err != nil {
+		if len(c.AssumeRole) > 1 && awsbase.IsCannotAssumeRoleError(err) {
+			err = assumeRoleHopError(1, len(c.AssumeRole), awsbaseConfig.AssumeRole.RoleARN, err)
+		}
 		return nil, diag.Errorf("configuring Terraform AWS Provider: %s", err)
 	}
 
+	if cached != nil {
+		cfg.Credentials = cached.Credentials
+	}
+
+	c.resolveRetryConfig(cfg)
+	c.logRetryConfig()
+
+	rc := c.retryConfig("")
+	if retryer := cfg.Retryer; retryer != nil {
+		cfg.Retryer = func() aws_sdkv2.Retryer {
+			return newSDKv2Retryer(retryer(), rc)
+		}
+	}
+
+	if awsbaseConfig.AssumeRole != nil {
+		cfg, err = c.assumeRoleChain(ctx, cfg)
+		if err != nil {
+			return nil, diag.Errorf("configuring Terraform AWS Provider: %s", err)
+		}
+	}
+
 	if !c.SkipRegionValidation {
 		if err := awsbase.ValidateRegion(cfg.Region); err != nil {
 			return nil, diag.FromErr(err)
@@ -145,9 +184,60 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diag.Errorf("creating AWS SDK v1 session: %s", err)
 	}
 
-	accountID, partition, err := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
-	if err != nil {
-		return nil, diag.Errorf("retrieving AWS account details: %s", err)
+	sess = sess.Copy(sdkv1RetryConfig(rc))
+	configureSDKv1RetryMode(sess, rc.Mode)
+
+	if c.AuditLog != nil && c.AuditLog.File != "" {
+		logger, err := newAuditLogger(c.AuditLog)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		configureAuditLog(logger, sess, &cfg)
+	}
+
+	var tracerProvider trace.TracerProvider
+	if c.Tracing.enabled() {
+		tp, err := newTracerProvider(ctx, c.Tracing)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		configureTracing(tp, sess, &cfg)
+		tracerProvider = tp
+	}
+
+	configureThrottling(sess, &cfg)
+
+	if len(c.RateLimits) > 0 {
+		// The limiters are shared by AWS SDK for Go v1 and v2 API clients.
+		limiters := newServiceRateLimiters(c.RateLimits)
+
+		wrapTransport(sess, &cfg, func(next http.RoundTripper) http.RoundTripper {
+			return &rateLimitTransport{limiters: limiters, next: next}
+		})
+	}
+
+	var accountID, partition string
+	if cached != nil {
+		accountID, partition = cached.AccountID, cached.Partition
+	} else {
+		accountID, partition, err = awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
+		if err != nil {
+			return nil, diag.Errorf("retrieving AWS account details: %s", err)
+		}
+
+		if cacheable {
+			processCredentialsCache.put(cacheKey, &credentialsCacheEntry{
+				AccountID:   accountID,
+				Credentials: cfg.Credentials,
+				Partition:   partition,
+			})
+		}
+	}
+
+	if c.Emulator != nil && c.Emulator.Endpoint != "" {
+		accountID = c.Emulator.AccountID
 	}
 
 	if accountID == "" {
@@ -155,7 +245,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 
 	if len(c.ForbiddenAccountIds) > 0 {
-		for _, forbiddenAccountID := range c.AllowedAccountIds {
+		for _, forbiddenAccountID := range c.ForbiddenAccountIds {
 			if accountID == forbiddenAccountID {
 				return nil, diag.Errorf("AWS account ID not allowed: %s", accountID)
 			}
@@ -189,11 +279,27 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.SetHTTPClient(sess.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.Session = sess
 	client.TerraformVersion = c.TerraformVersion
+	client.tracerProvider = tracerProvider
+
+	client.regionalClients = newRegionalClients(c, client, cfg)
 
+	c.configureClients(client, sess, cfg)
+
+	if c.Guardrails.enabled() {
+		if err := c.Guardrails.check(ctx, client, accountID); err != nil {
+			return nil, diag.FromErr(err)
+		}
+	}
+
+	return client, nil
+}
+
+// configureClients initializes the AWS API clients for the specified AWS SDK for Go v1 session and v2 configuration.
+// Each client is built on first use.
+func (c *Config) configureClients(client *AWSClient, sess *session.Session, cfg aws_sdkv2.Config) {
 	// API clients (generated).
 	c.sdkv1Conns(client, sess)
 	c.sdkv2Conns(client, cfg)
-	c.sdkv2LazyConns(client, cfg)
 
 	// AWS SDK for Go v1 custom API clients.
 
@@ -204,17 +310,23 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	if c.STSRegion != "" {
 		stsConfig.Region = aws.String(c.STSRegion)
 	}
-	client.stsConn = sts.New(sess.Copy(stsConfig))
+	client.stsConn.init(func() *sts.STS {
+		return sts.New(c.sdkv1Session(sess, names.STS, stsConfig))
+	})
 
 	// Services that require multiple client configurations.
 	s3Config := &aws.Config{
 		Endpoint:         aws.String(c.Endpoints[names.S3]),
 		S3ForcePathStyle: aws.Bool(c.S3UsePathStyle),
 	}
-	client.s3Conn = s3.New(sess.Copy(s3Config))
+	client.s3Conn.init(func() *s3.S3 {
+		return s3.New(c.sdkv1Session(sess, names.S3, s3Config))
+	})
 
-	s3Config.DisableRestProtocolURICleaning = aws.Bool(true)
-	client.s3ConnURICleaningDisabled = s3.New(sess.Copy(s3Config))
+	s3ConfigURICleaningDisabled := s3Config.Copy().WithDisableRestProtocolURICleaning(true)
+	client.s3ConnURICleaningDisabled.init(func() *s3.S3 {
+		return s3.New(c.sdkv1Session(sess, names.S3, s3ConfigURICleaningDisabled))
+	})
 
 	// "Global" services that require customizations.
 	globalAcceleratorConfig := &aws.Config{
@@ -234,7 +346,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 
 	// Force "global" services to correct Regions.
-	switch partition {
+	switch client.Partition {
 	case endpoints.AwsPartitionID:
 		globalAcceleratorConfig.Region = aws.String(endpoints.UsWest2RegionID)
 		route53Config.Region = aws.String(endpoints.UsEast1RegionID)
@@ -252,279 +364,34 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		route53Config.Region = aws.String(endpoints.UsGovWest1RegionID)
 	}
 
-	client.globalacceleratorConn = globalaccelerator.New(sess.Copy(globalAcceleratorConfig))
-	client.route53Conn = route53.New(sess.Copy(route53Config))
-	client.route53recoverycontrolconfigConn = route53recoverycontrolconfig.New(sess.Copy(route53RecoveryControlConfigConfig))
-	client.route53recoveryreadinessConn = route53recoveryreadiness.New(sess.Copy(route53RecoveryReadinessConfig))
-	client.shieldConn = shield.New(sess.Copy(shieldConfig))
-
-	client.apigatewayConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// Many operations can return an error such as:
-		//   ConflictException: Unable to complete operation due to concurrent modification. Please try again later.
-		// Handle them all globally for the service client.
-		if tfawserr.ErrMessageContains(r.Error, apigateway.ErrCodeConflictException, "try again later") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
-
-	// Workaround for https://github.com/aws/aws-sdk-go/issues/1472
-	client.applicationautoscalingConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if !strings.HasPrefix(r.Operation.Name, "Describe") && !strings.HasPrefix(r.Operation.Name, "List") {
-			return
-		}
-		if tfawserr.ErrCodeEquals(r.Error, applicationautoscaling.ErrCodeFailedResourceAccessException) {
-			r.Retryable = aws.Bool(true)
-		}
-	})
-
-	// StartDeployment operations can return a ConflictException
-	// if ongoing deployments are in-progress, thus we handle them
-	// here for the service client.
-	client.appconfigConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name == "StartDeployment" {
-			if tfawserr.ErrCodeEquals(r.Error, appconfig.ErrCodeConflictException) {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-	})
-
-	client.appsyncConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name == "CreateGraphqlApi" {
-			if tfawserr.ErrMessageContains(r.Error, appsync.ErrCodeConcurrentModificationException, "a GraphQL API creation is already in progress") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-	})
-
-	client.chimeConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// When calling CreateVoiceConnector across multiple resources,
-		// the API can randomly return a BadRequestException without explanation
-		if r.Operation.Name == "CreateVoiceConnector" {
-			if tfawserr.ErrMessageContains(r.Error, chime.ErrCodeBadRequestException, "Service received a bad request") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-	})
-
-	client.cloudhsmv2Conn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if tfawserr.ErrMessageContains(r.Error, cloudhsmv2.ErrCodeCloudHsmInternalFailureException, "request was rejected because of an AWS CloudHSM internal failure") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
-
-	client.configserviceConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// When calling Config Organization Rules API actions immediately
-		// after Organization creation, the API can randomly return the
-		// OrganizationAccessDeniedException error for a few minutes, even
-		// after succeeding a few requests.
-		switch r.Operation.Name {
-		case "DeleteOrganizationConfigRule", "DescribeOrganizationConfigRules", "DescribeOrganizationConfigRuleStatuses", "PutOrganizationConfigRule":
-			if !tfawserr.ErrMessageContains(r.Error, configservice.ErrCodeOrganizationAccessDeniedException, "This action can be only made by AWS Organization's master account.") {
-				return
-			}
-
-			// We only want to retry briefly as the default max retry count would
-			// excessively retry when the error could be legitimate.
-			// We currently depend on the DefaultRetryer exponential backoff here.
-			// ~10 retries gives a fair backoff of a few seconds.
-			if r.RetryCount < 9 {
-				r.Retryable = aws.Bool(true)
-			} else {
-				r.Retryable = aws.Bool(false)
-			}
-		case "DeleteOrganizationConformancePack", "DescribeOrganizationConformancePacks", "DescribeOrganizationConformancePackStatuses", "PutOrganizationConformancePack":
-			if !tfawserr.ErrCodeEquals(r.Error, configservice.ErrCodeOrganizationAccessDeniedException) {
-				if r.Operation.Name == "DeleteOrganizationConformancePack" && tfawserr.ErrCodeEquals(err, configservice.ErrCodeResourceInUseException) {
-					r.Retryable = aws.Bool(true)
-				}
-				return
-			}
-
-			// We only want to retry briefly as the default max retry count would
-			// excessively retry when the error could be legitimate.
-			// We currently depend on the DefaultRetryer exponential backoff here.
-			// ~10 retries gives a fair backoff of a few seconds.
-			if r.RetryCount < 9 {
-				r.Retryable = aws.Bool(true)
-			} else {
-				r.Retryable = aws.Bool(false)
-			}
-		}
-	})
-
-	client.cloudformationConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if tfawserr.ErrMessageContains(r.Error, cloudformation.ErrCodeOperationInProgressException, "Another Operation on StackSet") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
-
-	// See https://github.com/aws/aws-sdk-go/pull/1276
-	client.dynamodbConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name != "PutItem" && r.Operation.Name != "UpdateItem" && r.Operation.Name != "DeleteItem" {
-			return
-		}
-		if tfawserr.ErrMessageContains(r.Error, dynamodb.ErrCodeLimitExceededException, "Subscriber limit exceeded:") {
-			r.Retryable = aws.Bool(true)
-		}
+	client.globalacceleratorConn.init(func() *globalaccelerator.GlobalAccelerator {
+		return globalaccelerator.New(c.sdkv1Session(sess, names.GlobalAccelerator, globalAcceleratorConfig))
 	})
-
-	client.ec2Conn.Handlers.Retry.PushBack(func(r *request.Request) {
-		switch err := r.Error; r.Operation.Name {
-		case "AttachVpnGateway", "DetachVpnGateway":
-			if tfawserr.ErrMessageContains(err, "InvalidParameterValue", "This call cannot be completed because there are pending VPNs or Virtual Interfaces") {
-				r.Retryable = aws.Bool(true)
-			}
-
-		case "CreateClientVpnEndpoint":
-			if tfawserr.ErrMessageContains(err, "OperationNotPermitted", "Endpoint cannot be created while another endpoint is being created") {
-				r.Retryable = aws.Bool(true)
-			}
-
-		case "CreateClientVpnRoute", "DeleteClientVpnRoute":
-			if tfawserr.ErrMessageContains(err, "ConcurrentMutationLimitExceeded", "Cannot initiate another change for this endpoint at this time") {
-				r.Retryable = aws.Bool(true)
-			}
-
-		case "CreateVpnConnection":
-			if tfawserr.ErrMessageContains(err, "VpnConnectionLimitExceeded", "maximum number of mutating objects has been reached") {
-				r.Retryable = aws.Bool(true)
-			}
-
-		case "CreateVpnGateway":
-			if tfawserr.ErrMessageContains(err, "VpnGatewayLimitExceeded", "maximum number of mutating objects has been reached") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
+	client.route53Conn.init(func() *route53.Route53 {
+		return route53.New(c.sdkv1Session(sess, names.Route53, route53Config))
 	})
-
-	client.fmsConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// Acceptance testing creates and deletes resources in quick succession.
-		// The FMS onboarding process into Organizations is opaque to consumers.
-		// Since we cannot reasonably check this status before receiving the error,
-		// set the operation as retryable.
-		switch r.Operation.Name {
-		case "AssociateAdminAccount":
-			if tfawserr.ErrMessageContains(r.Error, fms.ErrCodeInvalidOperationException, "Your AWS Organization is currently offboarding with AWS Firewall Manager. Please submit onboard request after offboarded.") {
-				r.Retryable = aws.Bool(true)
-			}
-		case "DisassociateAdminAccount":
-			if tfawserr.ErrMessageContains(r.Error, fms.ErrCodeInvalidOperationException, "Your AWS Organization is currently onboarding with AWS Firewall Manager and cannot be offboarded.") {
-				r.Retryable = aws.Bool(true)
-			}
-		// System problems can arise during FMS policy updates (maybe also creation),
-		// so we set the following operation as retryable.
-		// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/23946
-		case "PutPolicy":
-			if tfawserr.ErrCodeEquals(r.Error, fms.ErrCodeInternalErrorException) {
-				r.Retryable = aws.Bool(true)
-			}
-		}
+	client.route53recoverycontrolconfigConn.init(func() *route53recoverycontrolconfig.Route53RecoveryControlConfig {
+		return route53recoverycontrolconfig.New(c.sdkv1Session(sess, names.Route53RecoveryControlConfig, route53RecoveryControlConfigConfig))
 	})
-
-	client.kafkaConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if tfawserr.ErrMessageContains(r.Error, kafka.ErrCodeTooManyRequestsException, "Too Many Requests") {
-			r.Retryable = aws.Bool(true)
-		}
+	client.route53recoveryreadinessConn.init(func() *route53recoveryreadiness.Route53RecoveryReadiness {
+		return route53recoveryreadiness.New(c.sdkv1Session(sess, names.Route53RecoveryReadiness, route53RecoveryReadinessConfig))
 	})
-
-	client.kinesisConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name == "CreateStream" {
-			if tfawserr.ErrMessageContains(r.Error, kinesis.ErrCodeLimitExceededException, "simultaneously be in CREATING or DELETING") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-		if r.Operation.Name == "CreateStream" || r.Operation.Name == "DeleteStream" {
-			if tfawserr.ErrMessageContains(r.Error, kinesis.ErrCodeLimitExceededException, "Rate exceeded for stream") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-	})
-
-	client.lightsailConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		switch r.Operation.Name {
-		case "CreateContainerService", "UpdateContainerService", "CreateContainerServiceDeployment":
-			if tfawserr.ErrMessageContains(r.Error, lightsail.ErrCodeInvalidInputException, "Please try again in a few minutes") {
-				r.Retryable = aws.Bool(true)
-			}
-		case "DeleteContainerService":
-			if tfawserr.ErrMessageContains(r.Error, lightsail.ErrCodeInvalidInputException, "Please try again in a few minutes") ||
-				tfawserr.ErrMessageContains(r.Error, lightsail.ErrCodeInvalidInputException, "Please wait for it to complete before trying again") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-	})
-
-	client.organizationsConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// Retry on the following error:
-		// ConcurrentModificationException: AWS Organizations can't complete your request because it conflicts with another attempt to modify the same entity. Try again later.
-		if tfawserr.ErrMessageContains(r.Error, organizations.ErrCodeConcurrentModificationException, "Try again later") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
-
-	client.s3Conn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if tfawserr.ErrMessageContains(r.Error, "OperationAborted", "A conflicting conditional operation is currently in progress against this resource. Please try again.") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
-
-	// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/17996
-	client.securityhubConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		switch r.Operation.Name {
-		case "EnableOrganizationAdminAccount":
-			if tfawserr.ErrCodeEquals(r.Error, securityhub.ErrCodeResourceConflictException) {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-	})
-
-	// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/19215
-	client.ssoadminConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name == "AttachManagedPolicyToPermissionSet" || r.Operation.Name == "DetachManagedPolicyFromPermissionSet" {
-			if tfawserr.ErrCodeEquals(r.Error, ssoadmin.ErrCodeConflictException) {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-	})
-
-	client.storagegatewayConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// InvalidGatewayRequestException: The specified gateway proxy network connection is busy.
-		if tfawserr.ErrMessageContains(r.Error, storagegateway.ErrCodeInvalidGatewayRequestException, "The specified gateway proxy network connection is busy") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
-
-	client.wafv2Conn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFInternalErrorException, "Retry your request") {
-			r.Retryable = aws.Bool(true)
-		}
-
-		if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFServiceLinkedRoleErrorException, "Retry") {
-			r.Retryable = aws.Bool(true)
-		}
-
-		if r.Operation.Name == "CreateIPSet" || r.Operation.Name == "CreateRegexPatternSet" ||
-			r.Operation.Name == "CreateRuleGroup" || r.Operation.Name == "CreateWebACL" {
-			// WAFv2 supports tag on create which can result in the below error codes according to the documentation
-			if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFTagOperationException, "Retry your request") {
-				r.Retryable = aws.Bool(true)
-			}
-			if tfawserr.ErrMessageContains(err, wafv2.ErrCodeWAFTagOperationInternalErrorException, "Retry your request") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
+	client.shieldConn.init(func() *shield.Shield {
+		return shield.New(c.sdkv1Session(sess, names.Shield, shieldConfig))
 	})
 
 	// AWS SDK for Go v2 custom API clients.
 
-	client.route53domainsClient = route53domains.NewFromConfig(cfg, func(o *route53domains.Options) {
-		if endpoint := c.Endpoints[names.Route53Domains]; endpoint != "" {
-			o.EndpointResolver = route53domains.EndpointResolverFromURL(endpoint)
-		} else if partition == endpoints.AwsPartitionID {
-			// Route 53 Domains is only available in AWS Commercial us-east-1 Region.
-			o.Region = endpoints.UsEast1RegionID
-		}
+	client.route53domainsClient.init(func() *route53domains.Client {
+		return route53domains.NewFromConfig(cfg, func(o *route53domains.Options) {
+			o.APIOptions = c.sdkv2APIOptions(names.Route53Domains, o.APIOptions)
+			o.Retryer = c.sdkv2Retryer(names.Route53Domains, o.Retryer)
+			if endpoint := c.Endpoints[names.Route53Domains]; endpoint != "" {
+				o.EndpointResolver = route53domains.EndpointResolverFromURL(endpoint)
+			} else if client.Partition == endpoints.AwsPartitionID {
+				// Route 53 Domains is only available in AWS Commercial us-east-1 Region.
+				o.Region = endpoints.UsEast1RegionID
+			}
+		})
 	})
-
-	return client, nil
 }
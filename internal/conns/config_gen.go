@@ -27,7 +27,6 @@ import (
 	ssm_sdkv2 "github.com/aws/aws-sdk-go-v2/service/ssm"
 	"github.com/aws/aws-sdk-go-v2/service/ssmincidents"
 	"github.com/aws/aws-sdk-go-v2/service/transcribe"
-	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/accessanalyzer"
 	"github.com/aws/aws-sdk-go/service/account"
@@ -315,425 +314,1073 @@ import (
 )
 
 // sdkv1Conns initializes AWS SDK for Go v1 clients.
+// Each client is built on first use.
 func (c *Config) sdkv1Conns(client *AWSClient, sess *session.Session) {
-	client.acmConn = acm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ACM])}))
-	client.acmpcaConn = acmpca.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ACMPCA])}))
-	client.ampConn = prometheusservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AMP])}))
-	client.apigatewayConn = apigateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.APIGateway])}))
-	client.apigatewaymanagementapiConn = apigatewaymanagementapi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.APIGatewayManagementAPI])}))
-	client.apigatewayv2Conn = apigatewayv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.APIGatewayV2])}))
-	client.accessanalyzerConn = accessanalyzer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AccessAnalyzer])}))
-	client.accountConn = account.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Account])}))
-	client.alexaforbusinessConn = alexaforbusiness.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AlexaForBusiness])}))
-	client.amplifyConn = amplify.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Amplify])}))
-	client.amplifybackendConn = amplifybackend.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AmplifyBackend])}))
-	client.amplifyuibuilderConn = amplifyuibuilder.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AmplifyUIBuilder])}))
-	client.applicationautoscalingConn = applicationautoscaling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppAutoScaling])}))
-	client.appconfigConn = appconfig.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppConfig])}))
-	client.appconfigdataConn = appconfigdata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppConfigData])}))
-	client.appflowConn = appflow.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppFlow])}))
-	client.appintegrationsConn = appintegrationsservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppIntegrations])}))
-	client.appmeshConn = appmesh.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppMesh])}))
-	client.apprunnerConn = apprunner.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppRunner])}))
-	client.appstreamConn = appstream.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppStream])}))
-	client.appsyncConn = appsync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppSync])}))
-	client.applicationcostprofilerConn = applicationcostprofiler.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ApplicationCostProfiler])}))
-	client.applicationinsightsConn = applicationinsights.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ApplicationInsights])}))
-	client.athenaConn = athena.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Athena])}))
-	client.autoscalingConn = autoscaling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AutoScaling])}))
-	client.autoscalingplansConn = autoscalingplans.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AutoScalingPlans])}))
-	client.backupConn = backup.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Backup])}))
-	client.backupgatewayConn = backupgateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.BackupGateway])}))
-	client.batchConn = batch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Batch])}))
-	client.billingconductorConn = billingconductor.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.BillingConductor])}))
-	client.braketConn = braket.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Braket])}))
-	client.budgetsConn = budgets.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Budgets])}))
-	client.ceConn = costexplorer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CE])}))
-	client.curConn = costandusagereportservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CUR])}))
-	client.chimeConn = chime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Chime])}))
-	client.chimesdkidentityConn = chimesdkidentity.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ChimeSDKIdentity])}))
-	client.chimesdkmeetingsConn = chimesdkmeetings.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ChimeSDKMeetings])}))
-	client.chimesdkmessagingConn = chimesdkmessaging.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ChimeSDKMessaging])}))
-	client.cloud9Conn = cloud9.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Cloud9])}))
-	client.clouddirectoryConn = clouddirectory.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudDirectory])}))
-	client.cloudformationConn = cloudformation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudFormation])}))
-	client.cloudfrontConn = cloudfront.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudFront])}))
-	client.cloudhsmv2Conn = cloudhsmv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudHSMV2])}))
-	client.cloudsearchConn = cloudsearch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudSearch])}))
-	client.cloudsearchdomainConn = cloudsearchdomain.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudSearchDomain])}))
-	client.cloudtrailConn = cloudtrail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudTrail])}))
-	client.cloudwatchConn = cloudwatch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudWatch])}))
-	client.codeartifactConn = codeartifact.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeArtifact])}))
-	client.codebuildConn = codebuild.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeBuild])}))
-	client.codecommitConn = codecommit.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeCommit])}))
-	client.codeguruprofilerConn = codeguruprofiler.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeGuruProfiler])}))
-	client.codegurureviewerConn = codegurureviewer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeGuruReviewer])}))
-	client.codepipelineConn = codepipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodePipeline])}))
-	client.codestarConn = codestar.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeStar])}))
-	client.codestarconnectionsConn = codestarconnections.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeStarConnections])}))
-	client.codestarnotificationsConn = codestarnotifications.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeStarNotifications])}))
-	client.cognitoidpConn = cognitoidentityprovider.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CognitoIDP])}))
-	client.cognitoidentityConn = cognitoidentity.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CognitoIdentity])}))
-	client.cognitosyncConn = cognitosync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CognitoSync])}))
-	client.comprehendmedicalConn = comprehendmedical.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ComprehendMedical])}))
-	client.configserviceConn = configservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ConfigService])}))
-	client.connectConn = connect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Connect])}))
-	client.connectcontactlensConn = connectcontactlens.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ConnectContactLens])}))
-	client.connectparticipantConn = connectparticipant.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ConnectParticipant])}))
-	client.controltowerConn = controltower.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ControlTower])}))
-	client.customerprofilesConn = customerprofiles.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CustomerProfiles])}))
-	client.daxConn = dax.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DAX])}))
-	client.dlmConn = dlm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DLM])}))
-	client.dmsConn = databasemigrationservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DMS])}))
-	client.drsConn = drs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DRS])}))
-	client.dsConn = directoryservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DS])}))
-	client.databrewConn = gluedatabrew.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DataBrew])}))
-	client.dataexchangeConn = dataexchange.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DataExchange])}))
-	client.datapipelineConn = datapipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DataPipeline])}))
-	client.datasyncConn = datasync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DataSync])}))
-	client.deployConn = codedeploy.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Deploy])}))
-	client.detectiveConn = detective.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Detective])}))
-	client.devopsguruConn = devopsguru.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DevOpsGuru])}))
-	client.devicefarmConn = devicefarm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DeviceFarm])}))
-	client.directconnectConn = directconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DirectConnect])}))
-	client.discoveryConn = applicationdiscoveryservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Discovery])}))
-	client.docdbConn = docdb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DocDB])}))
-	client.dynamodbConn = dynamodb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DynamoDB])}))
-	client.dynamodbstreamsConn = dynamodbstreams.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DynamoDBStreams])}))
-	client.ebsConn = ebs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EBS])}))
-	client.ec2Conn = ec2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EC2])}))
-	client.ec2instanceconnectConn = ec2instanceconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EC2InstanceConnect])}))
-	client.ecrConn = ecr.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ECR])}))
-	client.ecrpublicConn = ecrpublic.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ECRPublic])}))
-	client.ecsConn = ecs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ECS])}))
-	client.efsConn = efs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EFS])}))
-	client.eksConn = eks.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EKS])}))
-	client.elbConn = elb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ELB])}))
-	client.elbv2Conn = elbv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ELBV2])}))
-	client.emrConn = emr.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EMR])}))
-	client.emrcontainersConn = emrcontainers.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EMRContainers])}))
-	client.emrserverlessConn = emrserverless.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EMRServerless])}))
-	client.elasticacheConn = elasticache.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ElastiCache])}))
-	client.elasticbeanstalkConn = elasticbeanstalk.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ElasticBeanstalk])}))
-	client.elasticinferenceConn = elasticinference.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ElasticInference])}))
-	client.elastictranscoderConn = elastictranscoder.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ElasticTranscoder])}))
-	client.esConn = elasticsearchservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Elasticsearch])}))
-	client.eventsConn = eventbridge.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Events])}))
-	client.evidentlyConn = cloudwatchevidently.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Evidently])}))
-	client.fmsConn = fms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FMS])}))
-	client.fsxConn = fsx.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FSx])}))
-	client.finspaceConn = finspace.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FinSpace])}))
-	client.finspacedataConn = finspacedata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FinSpaceData])}))
-	client.firehoseConn = firehose.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Firehose])}))
-	client.forecastConn = forecastservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Forecast])}))
-	client.forecastqueryConn = forecastqueryservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ForecastQuery])}))
-	client.frauddetectorConn = frauddetector.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FraudDetector])}))
-	client.gameliftConn = gamelift.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.GameLift])}))
-	client.glacierConn = glacier.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Glacier])}))
-	client.glueConn = glue.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Glue])}))
-	client.grafanaConn = managedgrafana.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Grafana])}))
-	client.greengrassConn = greengrass.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Greengrass])}))
-	client.greengrassv2Conn = greengrassv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.GreengrassV2])}))
-	client.groundstationConn = groundstation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.GroundStation])}))
-	client.guarddutyConn = guardduty.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.GuardDuty])}))
-	client.healthConn = health.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Health])}))
-	client.healthlakeConn = healthlake.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.HealthLake])}))
-	client.honeycodeConn = honeycode.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Honeycode])}))
-	client.iamConn = iam.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IAM])}))
-	client.ivsConn = ivs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IVS])}))
-	client.imagebuilderConn = imagebuilder.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ImageBuilder])}))
-	client.inspectorConn = inspector.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Inspector])}))
-	client.iotConn = iot.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoT])}))
-	client.iot1clickdevicesConn = iot1clickdevicesservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoT1ClickDevices])}))
-	client.iot1clickprojectsConn = iot1clickprojects.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoT1ClickProjects])}))
-	client.iotanalyticsConn = iotanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTAnalytics])}))
-	client.iotdataConn = iotdataplane.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTData])}))
-	client.iotdeviceadvisorConn = iotdeviceadvisor.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTDeviceAdvisor])}))
-	client.ioteventsConn = iotevents.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTEvents])}))
-	client.ioteventsdataConn = ioteventsdata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTEventsData])}))
-	client.iotfleethubConn = iotfleethub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTFleetHub])}))
-	client.iotjobsdataConn = iotjobsdataplane.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTJobsData])}))
-	client.iotsecuretunnelingConn = iotsecuretunneling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTSecureTunneling])}))
-	client.iotsitewiseConn = iotsitewise.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTSiteWise])}))
-	client.iotthingsgraphConn = iotthingsgraph.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTThingsGraph])}))
-	client.iottwinmakerConn = iottwinmaker.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTTwinMaker])}))
-	client.iotwirelessConn = iotwireless.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTWireless])}))
-	client.kmsConn = kms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KMS])}))
-	client.kafkaConn = kafka.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Kafka])}))
-	client.kafkaconnectConn = kafkaconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KafkaConnect])}))
-	client.keyspacesConn = keyspaces.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Keyspaces])}))
-	client.kinesisConn = kinesis.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Kinesis])}))
-	client.kinesisanalyticsConn = kinesisanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisAnalytics])}))
-	client.kinesisanalyticsv2Conn = kinesisanalyticsv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisAnalyticsV2])}))
-	client.kinesisvideoConn = kinesisvideo.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisVideo])}))
-	client.kinesisvideoarchivedmediaConn = kinesisvideoarchivedmedia.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisVideoArchivedMedia])}))
-	client.kinesisvideomediaConn = kinesisvideomedia.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisVideoMedia])}))
-	client.kinesisvideosignalingConn = kinesisvideosignalingchannels.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisVideoSignaling])}))
-	client.lakeformationConn = lakeformation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LakeFormation])}))
-	client.lambdaConn = lambda.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Lambda])}))
-	client.lexmodelsConn = lexmodelbuildingservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LexModels])}))
-	client.lexmodelsv2Conn = lexmodelsv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LexModelsV2])}))
-	client.lexruntimeConn = lexruntimeservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LexRuntime])}))
-	client.lexruntimev2Conn = lexruntimev2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LexRuntimeV2])}))
-	client.licensemanagerConn = licensemanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LicenseManager])}))
-	client.lightsailConn = lightsail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Lightsail])}))
-	client.locationConn = locationservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Location])}))
-	client.logsConn = cloudwatchlogs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Logs])}))
-	client.lookoutequipmentConn = lookoutequipment.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LookoutEquipment])}))
-	client.lookoutmetricsConn = lookoutmetrics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LookoutMetrics])}))
-	client.lookoutvisionConn = lookoutforvision.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LookoutVision])}))
-	client.mqConn = mq.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MQ])}))
-	client.mturkConn = mturk.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MTurk])}))
-	client.mwaaConn = mwaa.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MWAA])}))
-	client.machinelearningConn = machinelearning.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MachineLearning])}))
-	client.macieConn = macie.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Macie])}))
-	client.macie2Conn = macie2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Macie2])}))
-	client.managedblockchainConn = managedblockchain.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ManagedBlockchain])}))
-	client.marketplacecatalogConn = marketplacecatalog.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MarketplaceCatalog])}))
-	client.marketplacecommerceanalyticsConn = marketplacecommerceanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MarketplaceCommerceAnalytics])}))
-	client.marketplaceentitlementConn = marketplaceentitlementservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MarketplaceEntitlement])}))
-	client.marketplacemeteringConn = marketplacemetering.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MarketplaceMetering])}))
-	client.mediaconnectConn = mediaconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaConnect])}))
-	client.mediaconvertConn = mediaconvert.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaConvert])}))
-	client.mediapackageConn = mediapackage.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaPackage])}))
-	client.mediapackagevodConn = mediapackagevod.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaPackageVOD])}))
-	client.mediastoreConn = mediastore.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaStore])}))
-	client.mediastoredataConn = mediastoredata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaStoreData])}))
-	client.mediatailorConn = mediatailor.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaTailor])}))
-	client.memorydbConn = memorydb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MemoryDB])}))
-	client.mghConn = migrationhub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MgH])}))
-	client.mgnConn = mgn.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Mgn])}))
-	client.migrationhubconfigConn = migrationhubconfig.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MigrationHubConfig])}))
-	client.migrationhubrefactorspacesConn = migrationhubrefactorspaces.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MigrationHubRefactorSpaces])}))
-	client.migrationhubstrategyConn = migrationhubstrategyrecommendations.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MigrationHubStrategy])}))
-	client.mobileConn = mobile.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Mobile])}))
-	client.neptuneConn = neptune.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Neptune])}))
-	client.networkfirewallConn = networkfirewall.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.NetworkFirewall])}))
-	client.networkmanagerConn = networkmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.NetworkManager])}))
-	client.nimbleConn = nimblestudio.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Nimble])}))
-	client.opensearchConn = opensearchservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.OpenSearch])}))
-	client.opsworksConn = opsworks.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.OpsWorks])}))
-	client.opsworkscmConn = opsworkscm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.OpsWorksCM])}))
-	client.organizationsConn = organizations.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Organizations])}))
-	client.outpostsConn = outposts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Outposts])}))
-	client.piConn = pi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PI])}))
-	client.panoramaConn = panorama.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Panorama])}))
-	client.personalizeConn = personalize.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Personalize])}))
-	client.personalizeeventsConn = personalizeevents.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PersonalizeEvents])}))
-	client.personalizeruntimeConn = personalizeruntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PersonalizeRuntime])}))
-	client.pinpointConn = pinpoint.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Pinpoint])}))
-	client.pinpointemailConn = pinpointemail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PinpointEmail])}))
-	client.pinpointsmsvoiceConn = pinpointsmsvoice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PinpointSMSVoice])}))
-	client.pollyConn = polly.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Polly])}))
-	client.pricingConn = pricing.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Pricing])}))
-	client.protonConn = proton.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Proton])}))
-	client.qldbConn = qldb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.QLDB])}))
-	client.qldbsessionConn = qldbsession.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.QLDBSession])}))
-	client.quicksightConn = quicksight.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.QuickSight])}))
-	client.ramConn = ram.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RAM])}))
-	client.rbinConn = recyclebin.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RBin])}))
-	client.rdsConn = rds.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RDS])}))
-	client.rdsdataConn = rdsdataservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RDSData])}))
-	client.rumConn = cloudwatchrum.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RUM])}))
-	client.redshiftConn = redshift.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Redshift])}))
-	client.redshiftdataConn = redshiftdataapiservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RedshiftData])}))
-	client.redshiftserverlessConn = redshiftserverless.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RedshiftServerless])}))
-	client.rekognitionConn = rekognition.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Rekognition])}))
-	client.resiliencehubConn = resiliencehub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ResilienceHub])}))
-	client.resourcegroupsConn = resourcegroups.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ResourceGroups])}))
-	client.resourcegroupstaggingapiConn = resourcegroupstaggingapi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ResourceGroupsTaggingAPI])}))
-	client.robomakerConn = robomaker.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RoboMaker])}))
-	client.route53recoveryclusterConn = route53recoverycluster.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Route53RecoveryCluster])}))
-	client.route53resolverConn = route53resolver.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Route53Resolver])}))
-	client.s3controlConn = s3control.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.S3Control])}))
-	client.s3outpostsConn = s3outposts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.S3Outposts])}))
-	client.sesConn = ses.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SES])}))
-	client.sfnConn = sfn.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SFN])}))
-	client.smsConn = sms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SMS])}))
-	client.snsConn = sns.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SNS])}))
-	client.sqsConn = sqs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SQS])}))
-	client.ssmConn = ssm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SSM])}))
-	client.ssmcontactsConn = ssmcontacts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SSMContacts])}))
-	client.ssoConn = sso.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SSO])}))
-	client.ssoadminConn = ssoadmin.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SSOAdmin])}))
-	client.ssooidcConn = ssooidc.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SSOOIDC])}))
-	client.swfConn = swf.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SWF])}))
-	client.sagemakerConn = sagemaker.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMaker])}))
-	client.sagemakera2iruntimeConn = augmentedairuntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMakerA2IRuntime])}))
-	client.sagemakeredgeConn = sagemakeredgemanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMakerEdge])}))
-	client.sagemakerfeaturestoreruntimeConn = sagemakerfeaturestoreruntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMakerFeatureStoreRuntime])}))
-	client.sagemakerruntimeConn = sagemakerruntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMakerRuntime])}))
-	client.savingsplansConn = savingsplans.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SavingsPlans])}))
-	client.schemasConn = schemas.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Schemas])}))
-	client.secretsmanagerConn = secretsmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SecretsManager])}))
-	client.securityhubConn = securityhub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SecurityHub])}))
-	client.serverlessrepoConn = serverlessapplicationrepository.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServerlessRepo])}))
-	client.servicecatalogConn = servicecatalog.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServiceCatalog])}))
-	client.servicecatalogappregistryConn = appregistry.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServiceCatalogAppRegistry])}))
-	client.servicediscoveryConn = servicediscovery.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServiceDiscovery])}))
-	client.servicequotasConn = servicequotas.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServiceQuotas])}))
-	client.signerConn = signer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Signer])}))
-	client.sdbConn = simpledb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SimpleDB])}))
-	client.snowdevicemanagementConn = snowdevicemanagement.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SnowDeviceManagement])}))
-	client.snowballConn = snowball.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Snowball])}))
-	client.storagegatewayConn = storagegateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.StorageGateway])}))
-	client.supportConn = support.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Support])}))
-	client.syntheticsConn = synthetics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Synthetics])}))
-	client.textractConn = textract.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Textract])}))
-	client.timestreamqueryConn = timestreamquery.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.TimestreamQuery])}))
-	client.timestreamwriteConn = timestreamwrite.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.TimestreamWrite])}))
-	client.transcribestreamingConn = transcribestreamingservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.TranscribeStreaming])}))
-	client.transferConn = transfer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Transfer])}))
-	client.translateConn = translate.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Translate])}))
-	client.voiceidConn = voiceid.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.VoiceID])}))
-	client.wafConn = waf.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WAF])}))
-	client.wafregionalConn = wafregional.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WAFRegional])}))
-	client.wafv2Conn = wafv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WAFV2])}))
-	client.wellarchitectedConn = wellarchitected.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WellArchitected])}))
-	client.wisdomConn = connectwisdomservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Wisdom])}))
-	client.workdocsConn = workdocs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkDocs])}))
-	client.worklinkConn = worklink.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkLink])}))
-	client.workmailConn = workmail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkMail])}))
-	client.workmailmessageflowConn = workmailmessageflow.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkMailMessageFlow])}))
-	client.workspacesConn = workspaces.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkSpaces])}))
-	client.workspaceswebConn = workspacesweb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkSpacesWeb])}))
-	client.xrayConn = xray.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.XRay])}))
+	client.acmConn.init(func() *acm.ACM {
+		return acm.New(c.sdkv1Session(sess, names.ACM))
+	})
+	client.acmpcaConn.init(func() *acmpca.ACMPCA {
+		return acmpca.New(c.sdkv1Session(sess, names.ACMPCA))
+	})
+	client.ampConn.init(func() *prometheusservice.PrometheusService {
+		return prometheusservice.New(c.sdkv1Session(sess, names.AMP))
+	})
+	client.apigatewayConn.init(func() *apigateway.APIGateway {
+		return apigateway.New(c.sdkv1Session(sess, names.APIGateway))
+	})
+	client.apigatewaymanagementapiConn.init(func() *apigatewaymanagementapi.ApiGatewayManagementApi {
+		return apigatewaymanagementapi.New(c.sdkv1Session(sess, names.APIGatewayManagementAPI))
+	})
+	client.apigatewayv2Conn.init(func() *apigatewayv2.ApiGatewayV2 {
+		return apigatewayv2.New(c.sdkv1Session(sess, names.APIGatewayV2))
+	})
+	client.accessanalyzerConn.init(func() *accessanalyzer.AccessAnalyzer {
+		return accessanalyzer.New(c.sdkv1Session(sess, names.AccessAnalyzer))
+	})
+	client.accountConn.init(func() *account.Account {
+		return account.New(c.sdkv1Session(sess, names.Account))
+	})
+	client.alexaforbusinessConn.init(func() *alexaforbusiness.AlexaForBusiness {
+		return alexaforbusiness.New(c.sdkv1Session(sess, names.AlexaForBusiness))
+	})
+	client.amplifyConn.init(func() *amplify.Amplify {
+		return amplify.New(c.sdkv1Session(sess, names.Amplify))
+	})
+	client.amplifybackendConn.init(func() *amplifybackend.AmplifyBackend {
+		return amplifybackend.New(c.sdkv1Session(sess, names.AmplifyBackend))
+	})
+	client.amplifyuibuilderConn.init(func() *amplifyuibuilder.AmplifyUIBuilder {
+		return amplifyuibuilder.New(c.sdkv1Session(sess, names.AmplifyUIBuilder))
+	})
+	client.applicationautoscalingConn.init(func() *applicationautoscaling.ApplicationAutoScaling {
+		return applicationautoscaling.New(c.sdkv1Session(sess, names.AppAutoScaling))
+	})
+	client.appconfigConn.init(func() *appconfig.AppConfig {
+		return appconfig.New(c.sdkv1Session(sess, names.AppConfig))
+	})
+	client.appconfigdataConn.init(func() *appconfigdata.AppConfigData {
+		return appconfigdata.New(c.sdkv1Session(sess, names.AppConfigData))
+	})
+	client.appflowConn.init(func() *appflow.Appflow {
+		return appflow.New(c.sdkv1Session(sess, names.AppFlow))
+	})
+	client.appintegrationsConn.init(func() *appintegrationsservice.AppIntegrationsService {
+		return appintegrationsservice.New(c.sdkv1Session(sess, names.AppIntegrations))
+	})
+	client.appmeshConn.init(func() *appmesh.AppMesh {
+		return appmesh.New(c.sdkv1Session(sess, names.AppMesh))
+	})
+	client.apprunnerConn.init(func() *apprunner.AppRunner {
+		return apprunner.New(c.sdkv1Session(sess, names.AppRunner))
+	})
+	client.appstreamConn.init(func() *appstream.AppStream {
+		return appstream.New(c.sdkv1Session(sess, names.AppStream))
+	})
+	client.appsyncConn.init(func() *appsync.AppSync {
+		return appsync.New(c.sdkv1Session(sess, names.AppSync))
+	})
+	client.applicationcostprofilerConn.init(func() *applicationcostprofiler.ApplicationCostProfiler {
+		return applicationcostprofiler.New(c.sdkv1Session(sess, names.ApplicationCostProfiler))
+	})
+	client.applicationinsightsConn.init(func() *applicationinsights.ApplicationInsights {
+		return applicationinsights.New(c.sdkv1Session(sess, names.ApplicationInsights))
+	})
+	client.athenaConn.init(func() *athena.Athena {
+		return athena.New(c.sdkv1Session(sess, names.Athena))
+	})
+	client.autoscalingConn.init(func() *autoscaling.AutoScaling {
+		return autoscaling.New(c.sdkv1Session(sess, names.AutoScaling))
+	})
+	client.autoscalingplansConn.init(func() *autoscalingplans.AutoScalingPlans {
+		return autoscalingplans.New(c.sdkv1Session(sess, names.AutoScalingPlans))
+	})
+	client.backupConn.init(func() *backup.Backup {
+		return backup.New(c.sdkv1Session(sess, names.Backup))
+	})
+	client.backupgatewayConn.init(func() *backupgateway.BackupGateway {
+		return backupgateway.New(c.sdkv1Session(sess, names.BackupGateway))
+	})
+	client.batchConn.init(func() *batch.Batch {
+		return batch.New(c.sdkv1Session(sess, names.Batch))
+	})
+	client.billingconductorConn.init(func() *billingconductor.BillingConductor {
+		return billingconductor.New(c.sdkv1Session(sess, names.BillingConductor))
+	})
+	client.braketConn.init(func() *braket.Braket {
+		return braket.New(c.sdkv1Session(sess, names.Braket))
+	})
+	client.budgetsConn.init(func() *budgets.Budgets {
+		return budgets.New(c.sdkv1Session(sess, names.Budgets))
+	})
+	client.ceConn.init(func() *costexplorer.CostExplorer {
+		return costexplorer.New(c.sdkv1Session(sess, names.CE))
+	})
+	client.curConn.init(func() *costandusagereportservice.CostandUsageReportService {
+		return costandusagereportservice.New(c.sdkv1Session(sess, names.CUR))
+	})
+	client.chimeConn.init(func() *chime.Chime {
+		return chime.New(c.sdkv1Session(sess, names.Chime))
+	})
+	client.chimesdkidentityConn.init(func() *chimesdkidentity.ChimeSDKIdentity {
+		return chimesdkidentity.New(c.sdkv1Session(sess, names.ChimeSDKIdentity))
+	})
+	client.chimesdkmeetingsConn.init(func() *chimesdkmeetings.ChimeSDKMeetings {
+		return chimesdkmeetings.New(c.sdkv1Session(sess, names.ChimeSDKMeetings))
+	})
+	client.chimesdkmessagingConn.init(func() *chimesdkmessaging.ChimeSDKMessaging {
+		return chimesdkmessaging.New(c.sdkv1Session(sess, names.ChimeSDKMessaging))
+	})
+	client.cloud9Conn.init(func() *cloud9.Cloud9 {
+		return cloud9.New(c.sdkv1Session(sess, names.Cloud9))
+	})
+	client.clouddirectoryConn.init(func() *clouddirectory.CloudDirectory {
+		return clouddirectory.New(c.sdkv1Session(sess, names.CloudDirectory))
+	})
+	client.cloudformationConn.init(func() *cloudformation.CloudFormation {
+		return cloudformation.New(c.sdkv1Session(sess, names.CloudFormation))
+	})
+	client.cloudfrontConn.init(func() *cloudfront.CloudFront {
+		return cloudfront.New(c.sdkv1Session(sess, names.CloudFront))
+	})
+	client.cloudhsmv2Conn.init(func() *cloudhsmv2.CloudHSMV2 {
+		return cloudhsmv2.New(c.sdkv1Session(sess, names.CloudHSMV2))
+	})
+	client.cloudsearchConn.init(func() *cloudsearch.CloudSearch {
+		return cloudsearch.New(c.sdkv1Session(sess, names.CloudSearch))
+	})
+	client.cloudsearchdomainConn.init(func() *cloudsearchdomain.CloudSearchDomain {
+		return cloudsearchdomain.New(c.sdkv1Session(sess, names.CloudSearchDomain))
+	})
+	client.cloudtrailConn.init(func() *cloudtrail.CloudTrail {
+		return cloudtrail.New(c.sdkv1Session(sess, names.CloudTrail))
+	})
+	client.cloudwatchConn.init(func() *cloudwatch.CloudWatch {
+		return cloudwatch.New(c.sdkv1Session(sess, names.CloudWatch))
+	})
+	client.codeartifactConn.init(func() *codeartifact.CodeArtifact {
+		return codeartifact.New(c.sdkv1Session(sess, names.CodeArtifact))
+	})
+	client.codebuildConn.init(func() *codebuild.CodeBuild {
+		return codebuild.New(c.sdkv1Session(sess, names.CodeBuild))
+	})
+	client.codecommitConn.init(func() *codecommit.CodeCommit {
+		return codecommit.New(c.sdkv1Session(sess, names.CodeCommit))
+	})
+	client.codeguruprofilerConn.init(func() *codeguruprofiler.CodeGuruProfiler {
+		return codeguruprofiler.New(c.sdkv1Session(sess, names.CodeGuruProfiler))
+	})
+	client.codegurureviewerConn.init(func() *codegurureviewer.CodeGuruReviewer {
+		return codegurureviewer.New(c.sdkv1Session(sess, names.CodeGuruReviewer))
+	})
+	client.codepipelineConn.init(func() *codepipeline.CodePipeline {
+		return codepipeline.New(c.sdkv1Session(sess, names.CodePipeline))
+	})
+	client.codestarConn.init(func() *codestar.CodeStar {
+		return codestar.New(c.sdkv1Session(sess, names.CodeStar))
+	})
+	client.codestarconnectionsConn.init(func() *codestarconnections.CodeStarConnections {
+		return codestarconnections.New(c.sdkv1Session(sess, names.CodeStarConnections))
+	})
+	client.codestarnotificationsConn.init(func() *codestarnotifications.CodeStarNotifications {
+		return codestarnotifications.New(c.sdkv1Session(sess, names.CodeStarNotifications))
+	})
+	client.cognitoidpConn.init(func() *cognitoidentityprovider.CognitoIdentityProvider {
+		return cognitoidentityprovider.New(c.sdkv1Session(sess, names.CognitoIDP))
+	})
+	client.cognitoidentityConn.init(func() *cognitoidentity.CognitoIdentity {
+		return cognitoidentity.New(c.sdkv1Session(sess, names.CognitoIdentity))
+	})
+	client.cognitosyncConn.init(func() *cognitosync.CognitoSync {
+		return cognitosync.New(c.sdkv1Session(sess, names.CognitoSync))
+	})
+	client.comprehendmedicalConn.init(func() *comprehendmedical.ComprehendMedical {
+		return comprehendmedical.New(c.sdkv1Session(sess, names.ComprehendMedical))
+	})
+	client.configserviceConn.init(func() *configservice.ConfigService {
+		return configservice.New(c.sdkv1Session(sess, names.ConfigService))
+	})
+	client.connectConn.init(func() *connect.Connect {
+		return connect.New(c.sdkv1Session(sess, names.Connect))
+	})
+	client.connectcontactlensConn.init(func() *connectcontactlens.ConnectContactLens {
+		return connectcontactlens.New(c.sdkv1Session(sess, names.ConnectContactLens))
+	})
+	client.connectparticipantConn.init(func() *connectparticipant.ConnectParticipant {
+		return connectparticipant.New(c.sdkv1Session(sess, names.ConnectParticipant))
+	})
+	client.controltowerConn.init(func() *controltower.ControlTower {
+		return controltower.New(c.sdkv1Session(sess, names.ControlTower))
+	})
+	client.customerprofilesConn.init(func() *customerprofiles.CustomerProfiles {
+		return customerprofiles.New(c.sdkv1Session(sess, names.CustomerProfiles))
+	})
+	client.daxConn.init(func() *dax.DAX {
+		return dax.New(c.sdkv1Session(sess, names.DAX))
+	})
+	client.dlmConn.init(func() *dlm.DLM {
+		return dlm.New(c.sdkv1Session(sess, names.DLM))
+	})
+	client.dmsConn.init(func() *databasemigrationservice.DatabaseMigrationService {
+		return databasemigrationservice.New(c.sdkv1Session(sess, names.DMS))
+	})
+	client.drsConn.init(func() *drs.Drs {
+		return drs.New(c.sdkv1Session(sess, names.DRS))
+	})
+	client.dsConn.init(func() *directoryservice.DirectoryService {
+		return directoryservice.New(c.sdkv1Session(sess, names.DS))
+	})
+	client.databrewConn.init(func() *gluedatabrew.GlueDataBrew {
+		return gluedatabrew.New(c.sdkv1Session(sess, names.DataBrew))
+	})
+	client.dataexchangeConn.init(func() *dataexchange.DataExchange {
+		return dataexchange.New(c.sdkv1Session(sess, names.DataExchange))
+	})
+	client.datapipelineConn.init(func() *datapipeline.DataPipeline {
+		return datapipeline.New(c.sdkv1Session(sess, names.DataPipeline))
+	})
+	client.datasyncConn.init(func() *datasync.DataSync {
+		return datasync.New(c.sdkv1Session(sess, names.DataSync))
+	})
+	client.deployConn.init(func() *codedeploy.CodeDeploy {
+		return codedeploy.New(c.sdkv1Session(sess, names.Deploy))
+	})
+	client.detectiveConn.init(func() *detective.Detective {
+		return detective.New(c.sdkv1Session(sess, names.Detective))
+	})
+	client.devopsguruConn.init(func() *devopsguru.DevOpsGuru {
+		return devopsguru.New(c.sdkv1Session(sess, names.DevOpsGuru))
+	})
+	client.devicefarmConn.init(func() *devicefarm.DeviceFarm {
+		return devicefarm.New(c.sdkv1Session(sess, names.DeviceFarm))
+	})
+	client.directconnectConn.init(func() *directconnect.DirectConnect {
+		return directconnect.New(c.sdkv1Session(sess, names.DirectConnect))
+	})
+	client.discoveryConn.init(func() *applicationdiscoveryservice.ApplicationDiscoveryService {
+		return applicationdiscoveryservice.New(c.sdkv1Session(sess, names.Discovery))
+	})
+	client.docdbConn.init(func() *docdb.DocDB {
+		return docdb.New(c.sdkv1Session(sess, names.DocDB))
+	})
+	client.dynamodbConn.init(func() *dynamodb.DynamoDB {
+		return dynamodb.New(c.sdkv1Session(sess, names.DynamoDB))
+	})
+	client.dynamodbstreamsConn.init(func() *dynamodbstreams.DynamoDBStreams {
+		return dynamodbstreams.New(c.sdkv1Session(sess, names.DynamoDBStreams))
+	})
+	client.ebsConn.init(func() *ebs.EBS {
+		return ebs.New(c.sdkv1Session(sess, names.EBS))
+	})
+	client.ec2Conn.init(func() *ec2.EC2 {
+		return ec2.New(c.sdkv1Session(sess, names.EC2))
+	})
+	client.ec2instanceconnectConn.init(func() *ec2instanceconnect.EC2InstanceConnect {
+		return ec2instanceconnect.New(c.sdkv1Session(sess, names.EC2InstanceConnect))
+	})
+	client.ecrConn.init(func() *ecr.ECR {
+		return ecr.New(c.sdkv1Session(sess, names.ECR))
+	})
+	client.ecrpublicConn.init(func() *ecrpublic.ECRPublic {
+		return ecrpublic.New(c.sdkv1Session(sess, names.ECRPublic))
+	})
+	client.ecsConn.init(func() *ecs.ECS {
+		return ecs.New(c.sdkv1Session(sess, names.ECS))
+	})
+	client.efsConn.init(func() *efs.EFS {
+		return efs.New(c.sdkv1Session(sess, names.EFS))
+	})
+	client.eksConn.init(func() *eks.EKS {
+		return eks.New(c.sdkv1Session(sess, names.EKS))
+	})
+	client.elbConn.init(func() *elb.ELB {
+		return elb.New(c.sdkv1Session(sess, names.ELB))
+	})
+	client.elbv2Conn.init(func() *elbv2.ELBV2 {
+		return elbv2.New(c.sdkv1Session(sess, names.ELBV2))
+	})
+	client.emrConn.init(func() *emr.EMR {
+		return emr.New(c.sdkv1Session(sess, names.EMR))
+	})
+	client.emrcontainersConn.init(func() *emrcontainers.EMRContainers {
+		return emrcontainers.New(c.sdkv1Session(sess, names.EMRContainers))
+	})
+	client.emrserverlessConn.init(func() *emrserverless.EMRServerless {
+		return emrserverless.New(c.sdkv1Session(sess, names.EMRServerless))
+	})
+	client.elasticacheConn.init(func() *elasticache.ElastiCache {
+		return elasticache.New(c.sdkv1Session(sess, names.ElastiCache))
+	})
+	client.elasticbeanstalkConn.init(func() *elasticbeanstalk.ElasticBeanstalk {
+		return elasticbeanstalk.New(c.sdkv1Session(sess, names.ElasticBeanstalk))
+	})
+	client.elasticinferenceConn.init(func() *elasticinference.ElasticInference {
+		return elasticinference.New(c.sdkv1Session(sess, names.ElasticInference))
+	})
+	client.elastictranscoderConn.init(func() *elastictranscoder.ElasticTranscoder {
+		return elastictranscoder.New(c.sdkv1Session(sess, names.ElasticTranscoder))
+	})
+	client.esConn.init(func() *elasticsearchservice.ElasticsearchService {
+		return elasticsearchservice.New(c.sdkv1Session(sess, names.Elasticsearch))
+	})
+	client.eventsConn.init(func() *eventbridge.EventBridge {
+		return eventbridge.New(c.sdkv1Session(sess, names.Events))
+	})
+	client.evidentlyConn.init(func() *cloudwatchevidently.CloudWatchEvidently {
+		return cloudwatchevidently.New(c.sdkv1Session(sess, names.Evidently))
+	})
+	client.fmsConn.init(func() *fms.FMS {
+		return fms.New(c.sdkv1Session(sess, names.FMS))
+	})
+	client.fsxConn.init(func() *fsx.FSx {
+		return fsx.New(c.sdkv1Session(sess, names.FSx))
+	})
+	client.finspaceConn.init(func() *finspace.Finspace {
+		return finspace.New(c.sdkv1Session(sess, names.FinSpace))
+	})
+	client.finspacedataConn.init(func() *finspacedata.FinSpaceData {
+		return finspacedata.New(c.sdkv1Session(sess, names.FinSpaceData))
+	})
+	client.firehoseConn.init(func() *firehose.Firehose {
+		return firehose.New(c.sdkv1Session(sess, names.Firehose))
+	})
+	client.forecastConn.init(func() *forecastservice.ForecastService {
+		return forecastservice.New(c.sdkv1Session(sess, names.Forecast))
+	})
+	client.forecastqueryConn.init(func() *forecastqueryservice.ForecastQueryService {
+		return forecastqueryservice.New(c.sdkv1Session(sess, names.ForecastQuery))
+	})
+	client.frauddetectorConn.init(func() *frauddetector.FraudDetector {
+		return frauddetector.New(c.sdkv1Session(sess, names.FraudDetector))
+	})
+	client.gameliftConn.init(func() *gamelift.GameLift {
+		return gamelift.New(c.sdkv1Session(sess, names.GameLift))
+	})
+	client.glacierConn.init(func() *glacier.Glacier {
+		return glacier.New(c.sdkv1Session(sess, names.Glacier))
+	})
+	client.glueConn.init(func() *glue.Glue {
+		return glue.New(c.sdkv1Session(sess, names.Glue))
+	})
+	client.grafanaConn.init(func() *managedgrafana.ManagedGrafana {
+		return managedgrafana.New(c.sdkv1Session(sess, names.Grafana))
+	})
+	client.greengrassConn.init(func() *greengrass.Greengrass {
+		return greengrass.New(c.sdkv1Session(sess, names.Greengrass))
+	})
+	client.greengrassv2Conn.init(func() *greengrassv2.GreengrassV2 {
+		return greengrassv2.New(c.sdkv1Session(sess, names.GreengrassV2))
+	})
+	client.groundstationConn.init(func() *groundstation.GroundStation {
+		return groundstation.New(c.sdkv1Session(sess, names.GroundStation))
+	})
+	client.guarddutyConn.init(func() *guardduty.GuardDuty {
+		return guardduty.New(c.sdkv1Session(sess, names.GuardDuty))
+	})
+	client.healthConn.init(func() *health.Health {
+		return health.New(c.sdkv1Session(sess, names.Health))
+	})
+	client.healthlakeConn.init(func() *healthlake.HealthLake {
+		return healthlake.New(c.sdkv1Session(sess, names.HealthLake))
+	})
+	client.honeycodeConn.init(func() *honeycode.Honeycode {
+		return honeycode.New(c.sdkv1Session(sess, names.Honeycode))
+	})
+	client.iamConn.init(func() *iam.IAM {
+		return iam.New(c.sdkv1Session(sess, names.IAM))
+	})
+	client.ivsConn.init(func() *ivs.IVS {
+		return ivs.New(c.sdkv1Session(sess, names.IVS))
+	})
+	client.imagebuilderConn.init(func() *imagebuilder.Imagebuilder {
+		return imagebuilder.New(c.sdkv1Session(sess, names.ImageBuilder))
+	})
+	client.inspectorConn.init(func() *inspector.Inspector {
+		return inspector.New(c.sdkv1Session(sess, names.Inspector))
+	})
+	client.iotConn.init(func() *iot.IoT {
+		return iot.New(c.sdkv1Session(sess, names.IoT))
+	})
+	client.iot1clickdevicesConn.init(func() *iot1clickdevicesservice.IoT1ClickDevicesService {
+		return iot1clickdevicesservice.New(c.sdkv1Session(sess, names.IoT1ClickDevices))
+	})
+	client.iot1clickprojectsConn.init(func() *iot1clickprojects.IoT1ClickProjects {
+		return iot1clickprojects.New(c.sdkv1Session(sess, names.IoT1ClickProjects))
+	})
+	client.iotanalyticsConn.init(func() *iotanalytics.IoTAnalytics {
+		return iotanalytics.New(c.sdkv1Session(sess, names.IoTAnalytics))
+	})
+	client.iotdataConn.init(func() *iotdataplane.IoTDataPlane {
+		return iotdataplane.New(c.sdkv1Session(sess, names.IoTData))
+	})
+	client.iotdeviceadvisorConn.init(func() *iotdeviceadvisor.IoTDeviceAdvisor {
+		return iotdeviceadvisor.New(c.sdkv1Session(sess, names.IoTDeviceAdvisor))
+	})
+	client.ioteventsConn.init(func() *iotevents.IoTEvents {
+		return iotevents.New(c.sdkv1Session(sess, names.IoTEvents))
+	})
+	client.ioteventsdataConn.init(func() *ioteventsdata.IoTEventsData {
+		return ioteventsdata.New(c.sdkv1Session(sess, names.IoTEventsData))
+	})
+	client.iotfleethubConn.init(func() *iotfleethub.IoTFleetHub {
+		return iotfleethub.New(c.sdkv1Session(sess, names.IoTFleetHub))
+	})
+	client.iotjobsdataConn.init(func() *iotjobsdataplane.IoTJobsDataPlane {
+		return iotjobsdataplane.New(c.sdkv1Session(sess, names.IoTJobsData))
+	})
+	client.iotsecuretunnelingConn.init(func() *iotsecuretunneling.IoTSecureTunneling {
+		return iotsecuretunneling.New(c.sdkv1Session(sess, names.IoTSecureTunneling))
+	})
+	client.iotsitewiseConn.init(func() *iotsitewise.IoTSiteWise {
+		return iotsitewise.New(c.sdkv1Session(sess, names.IoTSiteWise))
+	})
+	client.iotthingsgraphConn.init(func() *iotthingsgraph.IoTThingsGraph {
+		return iotthingsgraph.New(c.sdkv1Session(sess, names.IoTThingsGraph))
+	})
+	client.iottwinmakerConn.init(func() *iottwinmaker.IoTTwinMaker {
+		return iottwinmaker.New(c.sdkv1Session(sess, names.IoTTwinMaker))
+	})
+	client.iotwirelessConn.init(func() *iotwireless.IoTWireless {
+		return iotwireless.New(c.sdkv1Session(sess, names.IoTWireless))
+	})
+	client.kmsConn.init(func() *kms.KMS {
+		return kms.New(c.sdkv1Session(sess, names.KMS))
+	})
+	client.kafkaConn.init(func() *kafka.Kafka {
+		return kafka.New(c.sdkv1Session(sess, names.Kafka))
+	})
+	client.kafkaconnectConn.init(func() *kafkaconnect.KafkaConnect {
+		return kafkaconnect.New(c.sdkv1Session(sess, names.KafkaConnect))
+	})
+	client.keyspacesConn.init(func() *keyspaces.Keyspaces {
+		return keyspaces.New(c.sdkv1Session(sess, names.Keyspaces))
+	})
+	client.kinesisConn.init(func() *kinesis.Kinesis {
+		return kinesis.New(c.sdkv1Session(sess, names.Kinesis))
+	})
+	client.kinesisanalyticsConn.init(func() *kinesisanalytics.KinesisAnalytics {
+		return kinesisanalytics.New(c.sdkv1Session(sess, names.KinesisAnalytics))
+	})
+	client.kinesisanalyticsv2Conn.init(func() *kinesisanalyticsv2.KinesisAnalyticsV2 {
+		return kinesisanalyticsv2.New(c.sdkv1Session(sess, names.KinesisAnalyticsV2))
+	})
+	client.kinesisvideoConn.init(func() *kinesisvideo.KinesisVideo {
+		return kinesisvideo.New(c.sdkv1Session(sess, names.KinesisVideo))
+	})
+	client.kinesisvideoarchivedmediaConn.init(func() *kinesisvideoarchivedmedia.KinesisVideoArchivedMedia {
+		return kinesisvideoarchivedmedia.New(c.sdkv1Session(sess, names.KinesisVideoArchivedMedia))
+	})
+	client.kinesisvideomediaConn.init(func() *kinesisvideomedia.KinesisVideoMedia {
+		return kinesisvideomedia.New(c.sdkv1Session(sess, names.KinesisVideoMedia))
+	})
+	client.kinesisvideosignalingConn.init(func() *kinesisvideosignalingchannels.KinesisVideoSignalingChannels {
+		return kinesisvideosignalingchannels.New(c.sdkv1Session(sess, names.KinesisVideoSignaling))
+	})
+	client.lakeformationConn.init(func() *lakeformation.LakeFormation {
+		return lakeformation.New(c.sdkv1Session(sess, names.LakeFormation))
+	})
+	client.lambdaConn.init(func() *lambda.Lambda {
+		return lambda.New(c.sdkv1Session(sess, names.Lambda))
+	})
+	client.lexmodelsConn.init(func() *lexmodelbuildingservice.LexModelBuildingService {
+		return lexmodelbuildingservice.New(c.sdkv1Session(sess, names.LexModels))
+	})
+	client.lexmodelsv2Conn.init(func() *lexmodelsv2.LexModelsV2 {
+		return lexmodelsv2.New(c.sdkv1Session(sess, names.LexModelsV2))
+	})
+	client.lexruntimeConn.init(func() *lexruntimeservice.LexRuntimeService {
+		return lexruntimeservice.New(c.sdkv1Session(sess, names.LexRuntime))
+	})
+	client.lexruntimev2Conn.init(func() *lexruntimev2.LexRuntimeV2 {
+		return lexruntimev2.New(c.sdkv1Session(sess, names.LexRuntimeV2))
+	})
+	client.licensemanagerConn.init(func() *licensemanager.LicenseManager {
+		return licensemanager.New(c.sdkv1Session(sess, names.LicenseManager))
+	})
+	client.lightsailConn.init(func() *lightsail.Lightsail {
+		return lightsail.New(c.sdkv1Session(sess, names.Lightsail))
+	})
+	client.locationConn.init(func() *locationservice.LocationService {
+		return locationservice.New(c.sdkv1Session(sess, names.Location))
+	})
+	client.logsConn.init(func() *cloudwatchlogs.CloudWatchLogs {
+		return cloudwatchlogs.New(c.sdkv1Session(sess, names.Logs))
+	})
+	client.lookoutequipmentConn.init(func() *lookoutequipment.LookoutEquipment {
+		return lookoutequipment.New(c.sdkv1Session(sess, names.LookoutEquipment))
+	})
+	client.lookoutmetricsConn.init(func() *lookoutmetrics.LookoutMetrics {
+		return lookoutmetrics.New(c.sdkv1Session(sess, names.LookoutMetrics))
+	})
+	client.lookoutvisionConn.init(func() *lookoutforvision.LookoutForVision {
+		return lookoutforvision.New(c.sdkv1Session(sess, names.LookoutVision))
+	})
+	client.mqConn.init(func() *mq.MQ {
+		return mq.New(c.sdkv1Session(sess, names.MQ))
+	})
+	client.mturkConn.init(func() *mturk.MTurk {
+		return mturk.New(c.sdkv1Session(sess, names.MTurk))
+	})
+	client.mwaaConn.init(func() *mwaa.MWAA {
+		return mwaa.New(c.sdkv1Session(sess, names.MWAA))
+	})
+	client.machinelearningConn.init(func() *machinelearning.MachineLearning {
+		return machinelearning.New(c.sdkv1Session(sess, names.MachineLearning))
+	})
+	client.macieConn.init(func() *macie.Macie {
+		return macie.New(c.sdkv1Session(sess, names.Macie))
+	})
+	client.macie2Conn.init(func() *macie2.Macie2 {
+		return macie2.New(c.sdkv1Session(sess, names.Macie2))
+	})
+	client.managedblockchainConn.init(func() *managedblockchain.ManagedBlockchain {
+		return managedblockchain.New(c.sdkv1Session(sess, names.ManagedBlockchain))
+	})
+	client.marketplacecatalogConn.init(func() *marketplacecatalog.MarketplaceCatalog {
+		return marketplacecatalog.New(c.sdkv1Session(sess, names.MarketplaceCatalog))
+	})
+	client.marketplacecommerceanalyticsConn.init(func() *marketplacecommerceanalytics.MarketplaceCommerceAnalytics {
+		return marketplacecommerceanalytics.New(c.sdkv1Session(sess, names.MarketplaceCommerceAnalytics))
+	})
+	client.marketplaceentitlementConn.init(func() *marketplaceentitlementservice.MarketplaceEntitlementService {
+		return marketplaceentitlementservice.New(c.sdkv1Session(sess, names.MarketplaceEntitlement))
+	})
+	client.marketplacemeteringConn.init(func() *marketplacemetering.MarketplaceMetering {
+		return marketplacemetering.New(c.sdkv1Session(sess, names.MarketplaceMetering))
+	})
+	client.mediaconnectConn.init(func() *mediaconnect.MediaConnect {
+		return mediaconnect.New(c.sdkv1Session(sess, names.MediaConnect))
+	})
+	client.mediaconvertConn.init(func() *mediaconvert.MediaConvert {
+		return mediaconvert.New(c.sdkv1Session(sess, names.MediaConvert))
+	})
+	client.mediapackageConn.init(func() *mediapackage.MediaPackage {
+		return mediapackage.New(c.sdkv1Session(sess, names.MediaPackage))
+	})
+	client.mediapackagevodConn.init(func() *mediapackagevod.MediaPackageVod {
+		return mediapackagevod.New(c.sdkv1Session(sess, names.MediaPackageVOD))
+	})
+	client.mediastoreConn.init(func() *mediastore.MediaStore {
+		return mediastore.New(c.sdkv1Session(sess, names.MediaStore))
+	})
+	client.mediastoredataConn.init(func() *mediastoredata.MediaStoreData {
+		return mediastoredata.New(c.sdkv1Session(sess, names.MediaStoreData))
+	})
+	client.mediatailorConn.init(func() *mediatailor.MediaTailor {
+		return mediatailor.New(c.sdkv1Session(sess, names.MediaTailor))
+	})
+	client.memorydbConn.init(func() *memorydb.MemoryDB {
+		return memorydb.New(c.sdkv1Session(sess, names.MemoryDB))
+	})
+	client.mghConn.init(func() *migrationhub.MigrationHub {
+		return migrationhub.New(c.sdkv1Session(sess, names.MgH))
+	})
+	client.mgnConn.init(func() *mgn.Mgn {
+		return mgn.New(c.sdkv1Session(sess, names.Mgn))
+	})
+	client.migrationhubconfigConn.init(func() *migrationhubconfig.MigrationHubConfig {
+		return migrationhubconfig.New(c.sdkv1Session(sess, names.MigrationHubConfig))
+	})
+	client.migrationhubrefactorspacesConn.init(func() *migrationhubrefactorspaces.MigrationHubRefactorSpaces {
+		return migrationhubrefactorspaces.New(c.sdkv1Session(sess, names.MigrationHubRefactorSpaces))
+	})
+	client.migrationhubstrategyConn.init(func() *migrationhubstrategyrecommendations.MigrationHubStrategyRecommendations {
+		return migrationhubstrategyrecommendations.New(c.sdkv1Session(sess, names.MigrationHubStrategy))
+	})
+	client.mobileConn.init(func() *mobile.Mobile {
+		return mobile.New(c.sdkv1Session(sess, names.Mobile))
+	})
+	client.neptuneConn.init(func() *neptune.Neptune {
+		return neptune.New(c.sdkv1Session(sess, names.Neptune))
+	})
+	client.networkfirewallConn.init(func() *networkfirewall.NetworkFirewall {
+		return networkfirewall.New(c.sdkv1Session(sess, names.NetworkFirewall))
+	})
+	client.networkmanagerConn.init(func() *networkmanager.NetworkManager {
+		return networkmanager.New(c.sdkv1Session(sess, names.NetworkManager))
+	})
+	client.nimbleConn.init(func() *nimblestudio.NimbleStudio {
+		return nimblestudio.New(c.sdkv1Session(sess, names.Nimble))
+	})
+	client.opensearchConn.init(func() *opensearchservice.OpenSearchService {
+		return opensearchservice.New(c.sdkv1Session(sess, names.OpenSearch))
+	})
+	client.opsworksConn.init(func() *opsworks.OpsWorks {
+		return opsworks.New(c.sdkv1Session(sess, names.OpsWorks))
+	})
+	client.opsworkscmConn.init(func() *opsworkscm.OpsWorksCM {
+		return opsworkscm.New(c.sdkv1Session(sess, names.OpsWorksCM))
+	})
+	client.organizationsConn.init(func() *organizations.Organizations {
+		return organizations.New(c.sdkv1Session(sess, names.Organizations))
+	})
+	client.outpostsConn.init(func() *outposts.Outposts {
+		return outposts.New(c.sdkv1Session(sess, names.Outposts))
+	})
+	client.piConn.init(func() *pi.PI {
+		return pi.New(c.sdkv1Session(sess, names.PI))
+	})
+	client.panoramaConn.init(func() *panorama.Panorama {
+		return panorama.New(c.sdkv1Session(sess, names.Panorama))
+	})
+	client.personalizeConn.init(func() *personalize.Personalize {
+		return personalize.New(c.sdkv1Session(sess, names.Personalize))
+	})
+	client.personalizeeventsConn.init(func() *personalizeevents.PersonalizeEvents {
+		return personalizeevents.New(c.sdkv1Session(sess, names.PersonalizeEvents))
+	})
+	client.personalizeruntimeConn.init(func() *personalizeruntime.PersonalizeRuntime {
+		return personalizeruntime.New(c.sdkv1Session(sess, names.PersonalizeRuntime))
+	})
+	client.pinpointConn.init(func() *pinpoint.Pinpoint {
+		return pinpoint.New(c.sdkv1Session(sess, names.Pinpoint))
+	})
+	client.pinpointemailConn.init(func() *pinpointemail.PinpointEmail {
+		return pinpointemail.New(c.sdkv1Session(sess, names.PinpointEmail))
+	})
+	client.pinpointsmsvoiceConn.init(func() *pinpointsmsvoice.PinpointSMSVoice {
+		return pinpointsmsvoice.New(c.sdkv1Session(sess, names.PinpointSMSVoice))
+	})
+	client.pollyConn.init(func() *polly.Polly {
+		return polly.New(c.sdkv1Session(sess, names.Polly))
+	})
+	client.pricingConn.init(func() *pricing.Pricing {
+		return pricing.New(c.sdkv1Session(sess, names.Pricing))
+	})
+	client.protonConn.init(func() *proton.Proton {
+		return proton.New(c.sdkv1Session(sess, names.Proton))
+	})
+	client.qldbConn.init(func() *qldb.QLDB {
+		return qldb.New(c.sdkv1Session(sess, names.QLDB))
+	})
+	client.qldbsessionConn.init(func() *qldbsession.QLDBSession {
+		return qldbsession.New(c.sdkv1Session(sess, names.QLDBSession))
+	})
+	client.quicksightConn.init(func() *quicksight.QuickSight {
+		return quicksight.New(c.sdkv1Session(sess, names.QuickSight))
+	})
+	client.ramConn.init(func() *ram.RAM {
+		return ram.New(c.sdkv1Session(sess, names.RAM))
+	})
+	client.rbinConn.init(func() *recyclebin.RecycleBin {
+		return recyclebin.New(c.sdkv1Session(sess, names.RBin))
+	})
+	client.rdsConn.init(func() *rds.RDS {
+		return rds.New(c.sdkv1Session(sess, names.RDS))
+	})
+	client.rdsdataConn.init(func() *rdsdataservice.RDSDataService {
+		return rdsdataservice.New(c.sdkv1Session(sess, names.RDSData))
+	})
+	client.rumConn.init(func() *cloudwatchrum.CloudWatchRUM {
+		return cloudwatchrum.New(c.sdkv1Session(sess, names.RUM))
+	})
+	client.redshiftConn.init(func() *redshift.Redshift {
+		return redshift.New(c.sdkv1Session(sess, names.Redshift))
+	})
+	client.redshiftdataConn.init(func() *redshiftdataapiservice.RedshiftDataAPIService {
+		return redshiftdataapiservice.New(c.sdkv1Session(sess, names.RedshiftData))
+	})
+	client.redshiftserverlessConn.init(func() *redshiftserverless.RedshiftServerless {
+		return redshiftserverless.New(c.sdkv1Session(sess, names.RedshiftServerless))
+	})
+	client.rekognitionConn.init(func() *rekognition.Rekognition {
+		return rekognition.New(c.sdkv1Session(sess, names.Rekognition))
+	})
+	client.resiliencehubConn.init(func() *resiliencehub.ResilienceHub {
+		return resiliencehub.New(c.sdkv1Session(sess, names.ResilienceHub))
+	})
+	client.resourcegroupsConn.init(func() *resourcegroups.ResourceGroups {
+		return resourcegroups.New(c.sdkv1Session(sess, names.ResourceGroups))
+	})
+	client.resourcegroupstaggingapiConn.init(func() *resourcegroupstaggingapi.ResourceGroupsTaggingAPI {
+		return resourcegroupstaggingapi.New(c.sdkv1Session(sess, names.ResourceGroupsTaggingAPI))
+	})
+	client.robomakerConn.init(func() *robomaker.RoboMaker {
+		return robomaker.New(c.sdkv1Session(sess, names.RoboMaker))
+	})
+	client.route53recoveryclusterConn.init(func() *route53recoverycluster.Route53RecoveryCluster {
+		return route53recoverycluster.New(c.sdkv1Session(sess, names.Route53RecoveryCluster))
+	})
+	client.route53resolverConn.init(func() *route53resolver.Route53Resolver {
+		return route53resolver.New(c.sdkv1Session(sess, names.Route53Resolver))
+	})
+	client.s3controlConn.init(func() *s3control.S3Control {
+		return s3control.New(c.sdkv1Session(sess, names.S3Control))
+	})
+	client.s3outpostsConn.init(func() *s3outposts.S3Outposts {
+		return s3outposts.New(c.sdkv1Session(sess, names.S3Outposts))
+	})
+	client.sesConn.init(func() *ses.SES {
+		return ses.New(c.sdkv1Session(sess, names.SES))
+	})
+	client.sfnConn.init(func() *sfn.SFN {
+		return sfn.New(c.sdkv1Session(sess, names.SFN))
+	})
+	client.smsConn.init(func() *sms.SMS {
+		return sms.New(c.sdkv1Session(sess, names.SMS))
+	})
+	client.snsConn.init(func() *sns.SNS {
+		return sns.New(c.sdkv1Session(sess, names.SNS))
+	})
+	client.sqsConn.init(func() *sqs.SQS {
+		return sqs.New(c.sdkv1Session(sess, names.SQS))
+	})
+	client.ssmConn.init(func() *ssm.SSM {
+		return ssm.New(c.sdkv1Session(sess, names.SSM))
+	})
+	client.ssmcontactsConn.init(func() *ssmcontacts.SSMContacts {
+		return ssmcontacts.New(c.sdkv1Session(sess, names.SSMContacts))
+	})
+	client.ssoConn.init(func() *sso.SSO {
+		return sso.New(c.sdkv1Session(sess, names.SSO))
+	})
+	client.ssoadminConn.init(func() *ssoadmin.SSOAdmin {
+		return ssoadmin.New(c.sdkv1Session(sess, names.SSOAdmin))
+	})
+	client.ssooidcConn.init(func() *ssooidc.SSOOIDC {
+		return ssooidc.New(c.sdkv1Session(sess, names.SSOOIDC))
+	})
+	client.swfConn.init(func() *swf.SWF {
+		return swf.New(c.sdkv1Session(sess, names.SWF))
+	})
+	client.sagemakerConn.init(func() *sagemaker.SageMaker {
+		return sagemaker.New(c.sdkv1Session(sess, names.SageMaker))
+	})
+	client.sagemakera2iruntimeConn.init(func() *augmentedairuntime.AugmentedAIRuntime {
+		return augmentedairuntime.New(c.sdkv1Session(sess, names.SageMakerA2IRuntime))
+	})
+	client.sagemakeredgeConn.init(func() *sagemakeredgemanager.SagemakerEdgeManager {
+		return sagemakeredgemanager.New(c.sdkv1Session(sess, names.SageMakerEdge))
+	})
+	client.sagemakerfeaturestoreruntimeConn.init(func() *sagemakerfeaturestoreruntime.SageMakerFeatureStoreRuntime {
+		return sagemakerfeaturestoreruntime.New(c.sdkv1Session(sess, names.SageMakerFeatureStoreRuntime))
+	})
+	client.sagemakerruntimeConn.init(func() *sagemakerruntime.SageMakerRuntime {
+		return sagemakerruntime.New(c.sdkv1Session(sess, names.SageMakerRuntime))
+	})
+	client.savingsplansConn.init(func() *savingsplans.SavingsPlans {
+		return savingsplans.New(c.sdkv1Session(sess, names.SavingsPlans))
+	})
+	client.schemasConn.init(func() *schemas.Schemas {
+		return schemas.New(c.sdkv1Session(sess, names.Schemas))
+	})
+	client.secretsmanagerConn.init(func() *secretsmanager.SecretsManager {
+		return secretsmanager.New(c.sdkv1Session(sess, names.SecretsManager))
+	})
+	client.securityhubConn.init(func() *securityhub.SecurityHub {
+		return securityhub.New(c.sdkv1Session(sess, names.SecurityHub))
+	})
+	client.serverlessrepoConn.init(func() *serverlessapplicationrepository.ServerlessApplicationRepository {
+		return serverlessapplicationrepository.New(c.sdkv1Session(sess, names.ServerlessRepo))
+	})
+	client.servicecatalogConn.init(func() *servicecatalog.ServiceCatalog {
+		return servicecatalog.New(c.sdkv1Session(sess, names.ServiceCatalog))
+	})
+	client.servicecatalogappregistryConn.init(func() *appregistry.AppRegistry {
+		return appregistry.New(c.sdkv1Session(sess, names.ServiceCatalogAppRegistry))
+	})
+	client.servicediscoveryConn.init(func() *servicediscovery.ServiceDiscovery {
+		return servicediscovery.New(c.sdkv1Session(sess, names.ServiceDiscovery))
+	})
+	client.servicequotasConn.init(func() *servicequotas.ServiceQuotas {
+		return servicequotas.New(c.sdkv1Session(sess, names.ServiceQuotas))
+	})
+	client.signerConn.init(func() *signer.Signer {
+		return signer.New(c.sdkv1Session(sess, names.Signer))
+	})
+	client.sdbConn.init(func() *simpledb.SimpleDB {
+		return simpledb.New(c.sdkv1Session(sess, names.SimpleDB))
+	})
+	client.snowdevicemanagementConn.init(func() *snowdevicemanagement.SnowDeviceManagement {
+		return snowdevicemanagement.New(c.sdkv1Session(sess, names.SnowDeviceManagement))
+	})
+	client.snowballConn.init(func() *snowball.Snowball {
+		return snowball.New(c.sdkv1Session(sess, names.Snowball))
+	})
+	client.storagegatewayConn.init(func() *storagegateway.StorageGateway {
+		return storagegateway.New(c.sdkv1Session(sess, names.StorageGateway))
+	})
+	client.supportConn.init(func() *support.Support {
+		return support.New(c.sdkv1Session(sess, names.Support))
+	})
+	client.syntheticsConn.init(func() *synthetics.Synthetics {
+		return synthetics.New(c.sdkv1Session(sess, names.Synthetics))
+	})
+	client.textractConn.init(func() *textract.Textract {
+		return textract.New(c.sdkv1Session(sess, names.Textract))
+	})
+	client.timestreamqueryConn.init(func() *timestreamquery.TimestreamQuery {
+		return timestreamquery.New(c.sdkv1Session(sess, names.TimestreamQuery))
+	})
+	client.timestreamwriteConn.init(func() *timestreamwrite.TimestreamWrite {
+		return timestreamwrite.New(c.sdkv1Session(sess, names.TimestreamWrite))
+	})
+	client.transcribestreamingConn.init(func() *transcribestreamingservice.TranscribeStreamingService {
+		return transcribestreamingservice.New(c.sdkv1Session(sess, names.TranscribeStreaming))
+	})
+	client.transferConn.init(func() *transfer.Transfer {
+		return transfer.New(c.sdkv1Session(sess, names.Transfer))
+	})
+	client.translateConn.init(func() *translate.Translate {
+		return translate.New(c.sdkv1Session(sess, names.Translate))
+	})
+	client.voiceidConn.init(func() *voiceid.VoiceID {
+		return voiceid.New(c.sdkv1Session(sess, names.VoiceID))
+	})
+	client.wafConn.init(func() *waf.WAF {
+		return waf.New(c.sdkv1Session(sess, names.WAF))
+	})
+	client.wafregionalConn.init(func() *wafregional.WAFRegional {
+		return wafregional.New(c.sdkv1Session(sess, names.WAFRegional))
+	})
+	client.wafv2Conn.init(func() *wafv2.WAFV2 {
+		return wafv2.New(c.sdkv1Session(sess, names.WAFV2))
+	})
+	client.wellarchitectedConn.init(func() *wellarchitected.WellArchitected {
+		return wellarchitected.New(c.sdkv1Session(sess, names.WellArchitected))
+	})
+	client.wisdomConn.init(func() *connectwisdomservice.ConnectWisdomService {
+		return connectwisdomservice.New(c.sdkv1Session(sess, names.Wisdom))
+	})
+	client.workdocsConn.init(func() *workdocs.WorkDocs {
+		return workdocs.New(c.sdkv1Session(sess, names.WorkDocs))
+	})
+	client.worklinkConn.init(func() *worklink.WorkLink {
+		return worklink.New(c.sdkv1Session(sess, names.WorkLink))
+	})
+	client.workmailConn.init(func() *workmail.WorkMail {
+		return workmail.New(c.sdkv1Session(sess, names.WorkMail))
+	})
+	client.workmailmessageflowConn.init(func() *workmailmessageflow.WorkMailMessageFlow {
+		return workmailmessageflow.New(c.sdkv1Session(sess, names.WorkMailMessageFlow))
+	})
+	client.workspacesConn.init(func() *workspaces.WorkSpaces {
+		return workspaces.New(c.sdkv1Session(sess, names.WorkSpaces))
+	})
+	client.workspaceswebConn.init(func() *workspacesweb.WorkSpacesWeb {
+		return workspacesweb.New(c.sdkv1Session(sess, names.WorkSpacesWeb))
+	})
+	client.xrayConn.init(func() *xray.XRay {
+		return xray.New(c.sdkv1Session(sess, names.XRay))
+	})
 }
 
 // sdkv2Conns initializes AWS SDK for Go v2 clients.
+// Each client is built on first use.
 func (c *Config) sdkv2Conns(client *AWSClient, cfg aws_sdkv2.Config) {
-	client.auditmanagerClient = auditmanager.NewFromConfig(cfg, func(o *auditmanager.Options) {
-		if endpoint := c.Endpoints[names.AuditManager]; endpoint != "" {
-			o.EndpointResolver = auditmanager.EndpointResolverFromURL(endpoint)
-		}
-	})
-	client.cloudcontrolClient = cloudcontrol.NewFromConfig(cfg, func(o *cloudcontrol.Options) {
-		if endpoint := c.Endpoints[names.CloudControl]; endpoint != "" {
-			o.EndpointResolver = cloudcontrol.EndpointResolverFromURL(endpoint)
-		}
-	})
-	client.comprehendClient = comprehend.NewFromConfig(cfg, func(o *comprehend.Options) {
-		if endpoint := c.Endpoints[names.Comprehend]; endpoint != "" {
-			o.EndpointResolver = comprehend.EndpointResolverFromURL(endpoint)
-		}
-	})
-	client.computeoptimizerClient = computeoptimizer.NewFromConfig(cfg, func(o *computeoptimizer.Options) {
-		if endpoint := c.Endpoints[names.ComputeOptimizer]; endpoint != "" {
-			o.EndpointResolver = computeoptimizer.EndpointResolverFromURL(endpoint)
-		}
-	})
-	client.fisClient = fis.NewFromConfig(cfg, func(o *fis.Options) {
-		if endpoint := c.Endpoints[names.FIS]; endpoint != "" {
-			o.EndpointResolver = fis.EndpointResolverFromURL(endpoint)
-		}
-	})
-	client.ivschatClient = ivschat.NewFromConfig(cfg, func(o *ivschat.Options) {
-		if endpoint := c.Endpoints[names.IVSChat]; endpoint != "" {
-			o.EndpointResolver = ivschat.EndpointResolverFromURL(endpoint)
-		}
-	})
-	client.identitystoreClient = identitystore.NewFromConfig(cfg, func(o *identitystore.Options) {
-		if endpoint := c.Endpoints[names.IdentityStore]; endpoint != "" {
-			o.EndpointResolver = identitystore.EndpointResolverFromURL(endpoint)
-		}
-	})
-	client.inspector2Client = inspector2.NewFromConfig(cfg, func(o *inspector2.Options) {
-		if endpoint := c.Endpoints[names.Inspector2]; endpoint != "" {
-			o.EndpointResolver = inspector2.EndpointResolverFromURL(endpoint)
-		}
-	})
-	client.kendraClient = kendra.NewFromConfig(cfg, func(o *kendra.Options) {
-		if endpoint := c.Endpoints[names.Kendra]; endpoint != "" {
-			o.EndpointResolver = kendra.EndpointResolverFromURL(endpoint)
-		}
-	})
-	client.medialiveClient = medialive.NewFromConfig(cfg, func(o *medialive.Options) {
-		if endpoint := c.Endpoints[names.MediaLive]; endpoint != "" {
-			o.EndpointResolver = medialive.EndpointResolverFromURL(endpoint)
-		}
-	})
-	client.oamClient = oam.NewFromConfig(cfg, func(o *oam.Options) {
-		if endpoint := c.Endpoints[names.ObservabilityAccessManager]; endpoint != "" {
-			o.EndpointResolver = oam.EndpointResolverFromURL(endpoint)
-		}
-	})
-	client.opensearchserverlessClient = opensearchserverless.NewFromConfig(cfg, func(o *opensearchserverless.Options) {
-		if endpoint := c.Endpoints[names.OpenSearchServerless]; endpoint != "" {
-			o.EndpointResolver = opensearchserverless.EndpointResolverFromURL(endpoint)
-		}
-	})
-	client.pipesClient = pipes.NewFromConfig(cfg, func(o *pipes.Options) {
-		if endpoint := c.Endpoints[names.Pipes]; endpoint != "" {
-			o.EndpointResolver = pipes.EndpointResolverFromURL(endpoint)
-		}
-	})
-	client.resourceexplorer2Client = resourceexplorer2.NewFromConfig(cfg, func(o *resourceexplorer2.Options) {
-		if endpoint := c.Endpoints[names.ResourceExplorer2]; endpoint != "" {
-			o.EndpointResolver = resourceexplorer2.EndpointResolverFromURL(endpoint)
-		}
-	})
-	client.rolesanywhereClient = rolesanywhere.NewFromConfig(cfg, func(o *rolesanywhere.Options) {
-		if endpoint := c.Endpoints[names.RolesAnywhere]; endpoint != "" {
-			o.EndpointResolver = rolesanywhere.EndpointResolverFromURL(endpoint)
-		}
-	})
-	client.sesv2Client = sesv2.NewFromConfig(cfg, func(o *sesv2.Options) {
-		if endpoint := c.Endpoints[names.SESV2]; endpoint != "" {
-			o.EndpointResolver = sesv2.EndpointResolverFromURL(endpoint)
-		}
-	})
-	client.ssmincidentsClient = ssmincidents.NewFromConfig(cfg, func(o *ssmincidents.Options) {
-		if endpoint := c.Endpoints[names.SSMIncidents]; endpoint != "" {
-			o.EndpointResolver = ssmincidents.EndpointResolverFromURL(endpoint)
-		}
-	})
-	client.schedulerClient = scheduler.NewFromConfig(cfg, func(o *scheduler.Options) {
-		if endpoint := c.Endpoints[names.Scheduler]; endpoint != "" {
-			o.EndpointResolver = scheduler.EndpointResolverFromURL(endpoint)
-		}
-	})
-	client.transcribeClient = transcribe.NewFromConfig(cfg, func(o *transcribe.Options) {
-		if endpoint := c.Endpoints[names.Transcribe]; endpoint != "" {
-			o.EndpointResolver = transcribe.EndpointResolverFromURL(endpoint)
-		}
+	client.auditmanagerClient.init(func() *auditmanager.Client {
+		return auditmanager.NewFromConfig(cfg, func(o *auditmanager.Options) {
+			o.APIOptions = c.sdkv2APIOptions(names.AuditManager, o.APIOptions)
+			o.Retryer = c.sdkv2Retryer(names.AuditManager, o.Retryer)
+			if endpoint := c.Endpoints[names.AuditManager]; endpoint != "" {
+				o.EndpointResolver = auditmanager.EndpointResolverFromURL(endpoint)
+			}
+		})
 	})
-}
-
-// sdkv2LazyConns initializes AWS SDK for Go v2 lazy-load clients.
-func (c *Config) sdkv2LazyConns(client *AWSClient, cfg aws_sdkv2.Config) {
-	client.ec2Client.init(&cfg, func() *ec2_sdkv2.Client {
+	client.cloudcontrolClient.init(func() *cloudcontrol.Client {
+		return cloudcontrol.NewFromConfig(cfg, func(o *cloudcontrol.Options) {
+			o.APIOptions = c.sdkv2APIOptions(names.CloudControl, o.APIOptions)
+			o.Retryer = c.sdkv2Retryer(names.CloudControl, o.Retryer)
+			if endpoint := c.Endpoints[names.CloudControl]; endpoint != "" {
+				o.EndpointResolver = cloudcontrol.EndpointResolverFromURL(endpoint)
+			}
+		})
+	})
+	client.comprehendClient.init(func() *comprehend.Client {
+		return comprehend.NewFromConfig(cfg, func(o *comprehend.Options) {
+			o.APIOptions = c.sdkv2APIOptions(names.Comprehend, o.APIOptions)
+			o.Retryer = c.sdkv2Retryer(names.Comprehend, o.Retryer)
+			if endpoint := c.Endpoints[names.Comprehend]; endpoint != "" {
+				o.EndpointResolver = comprehend.EndpointResolverFromURL(endpoint)
+			}
+		})
+	})
+	client.computeoptimizerClient.init(func() *computeoptimizer.Client {
+		return computeoptimizer.NewFromConfig(cfg, func(o *computeoptimizer.Options) {
+			o.APIOptions = c.sdkv2APIOptions(names.ComputeOptimizer, o.APIOptions)
+			o.Retryer = c.sdkv2Retryer(names.ComputeOptimizer, o.Retryer)
+			if endpoint := c.Endpoints[names.ComputeOptimizer]; endpoint != "" {
+				o.EndpointResolver = computeoptimizer.EndpointResolverFromURL(endpoint)
+			}
+		})
+	})
+	client.ec2Client.init(func() *ec2_sdkv2.Client {
 		return ec2_sdkv2.NewFromConfig(cfg, func(o *ec2_sdkv2.Options) {
+			o.APIOptions = c.sdkv2APIOptions(names.EC2, o.APIOptions)
+			o.Retryer = c.sdkv2Retryer(names.EC2, o.Retryer)
 			if endpoint := c.Endpoints[names.EC2]; endpoint != "" {
 				o.EndpointResolver = ec2_sdkv2.EndpointResolverFromURL(endpoint)
 			}
 		})
 	})
-	client.logsClient.init(&cfg, func() *cloudwatchlogs_sdkv2.Client {
+	client.fisClient.init(func() *fis.Client {
+		return fis.NewFromConfig(cfg, func(o *fis.Options) {
+			o.APIOptions = c.sdkv2APIOptions(names.FIS, o.APIOptions)
+			o.Retryer = c.sdkv2Retryer(names.FIS, o.Retryer)
+			if endpoint := c.Endpoints[names.FIS]; endpoint != "" {
+				o.EndpointResolver = fis.EndpointResolverFromURL(endpoint)
+			}
+		})
+	})
+	client.ivschatClient.init(func() *ivschat.Client {
+		return ivschat.NewFromConfig(cfg, func(o *ivschat.Options) {
+			o.APIOptions = c.sdkv2APIOptions(names.IVSChat, o.APIOptions)
+			o.Retryer = c.sdkv2Retryer(names.IVSChat, o.Retryer)
+			if endpoint := c.Endpoints[names.IVSChat]; endpoint != "" {
+				o.EndpointResolver = ivschat.EndpointResolverFromURL(endpoint)
+			}
+		})
+	})
+	client.identitystoreClient.init(func() *identitystore.Client {
+		return identitystore.NewFromConfig(cfg, func(o *identitystore.Options) {
+			o.APIOptions = c.sdkv2APIOptions(names.IdentityStore, o.APIOptions)
+			o.Retryer = c.sdkv2Retryer(names.IdentityStore, o.Retryer)
+			if endpoint := c.Endpoints[names.IdentityStore]; endpoint != "" {
+				o.EndpointResolver = identitystore.EndpointResolverFromURL(endpoint)
+			}
+		})
+	})
+	client.inspector2Client.init(func() *inspector2.Client {
+		return inspector2.NewFromConfig(cfg, func(o *inspector2.Options) {
+			o.APIOptions = c.sdkv2APIOptions(names.Inspector2, o.APIOptions)
+			o.Retryer = c.sdkv2Retryer(names.Inspector2, o.Retryer)
+			if endpoint := c.Endpoints[names.Inspector2]; endpoint != "" {
+				o.EndpointResolver = inspector2.EndpointResolverFromURL(endpoint)
+			}
+		})
+	})
+	client.kendraClient.init(func() *kendra.Client {
+		return kendra.NewFromConfig(cfg, func(o *kendra.Options) {
+			o.APIOptions = c.sdkv2APIOptions(names.Kendra, o.APIOptions)
+			o.Retryer = c.sdkv2Retryer(names.Kendra, o.Retryer)
+			if endpoint := c.Endpoints[names.Kendra]; endpoint != "" {
+				o.EndpointResolver = kendra.EndpointResolverFromURL(endpoint)
+			}
+		})
+	})
+	client.logsClient.init(func() *cloudwatchlogs_sdkv2.Client {
 		return cloudwatchlogs_sdkv2.NewFromConfig(cfg, func(o *cloudwatchlogs_sdkv2.Options) {
+			o.APIOptions = c.sdkv2APIOptions(names.Logs, o.APIOptions)
+			o.Retryer = c.sdkv2Retryer(names.Logs, o.Retryer)
 			if endpoint := c.Endpoints[names.Logs]; endpoint != "" {
 				o.EndpointResolver = cloudwatchlogs_sdkv2.EndpointResolverFromURL(endpoint)
 			}
 		})
 	})
-	client.rdsClient.init(&cfg, func() *rds_sdkv2.Client {
+	client.medialiveClient.init(func() *medialive.Client {
+		return medialive.NewFromConfig(cfg, func(o *medialive.Options) {
+			o.APIOptions = c.sdkv2APIOptions(names.MediaLive, o.APIOptions)
+			o.Retryer = c.sdkv2Retryer(names.MediaLive, o.Retryer)
+			if endpoint := c.Endpoints[names.MediaLive]; endpoint != "" {
+				o.EndpointResolver = medialive.EndpointResolverFromURL(endpoint)
+			}
+		})
+	})
+	client.oamClient.init(func() *oam.Client {
+		return oam.NewFromConfig(cfg, func(o *oam.Options) {
+			o.APIOptions = c.sdkv2APIOptions(names.ObservabilityAccessManager, o.APIOptions)
+			o.Retryer = c.sdkv2Retryer(names.ObservabilityAccessManager, o.Retryer)
+			if endpoint := c.Endpoints[names.ObservabilityAccessManager]; endpoint != "" {
+				o.EndpointResolver = oam.EndpointResolverFromURL(endpoint)
+			}
+		})
+	})
+	client.opensearchserverlessClient.init(func() *opensearchserverless.Client {
+		return opensearchserverless.NewFromConfig(cfg, func(o *opensearchserverless.Options) {
+			o.APIOptions = c.sdkv2APIOptions(names.OpenSearchServerless, o.APIOptions)
+			o.Retryer = c.sdkv2Retryer(names.OpenSearchServerless, o.Retryer)
+			if endpoint := c.Endpoints[names.OpenSearchServerless]; endpoint != "" {
+				o.EndpointResolver = opensearchserverless.EndpointResolverFromURL(endpoint)
+			}
+		})
+	})
+	client.pipesClient.init(func() *pipes.Client {
+		return pipes.NewFromConfig(cfg, func(o *pipes.Options) {
+			o.APIOptions = c.sdkv2APIOptions(names.Pipes, o.APIOptions)
+			o.Retryer = c.sdkv2Retryer(names.Pipes, o.Retryer)
+			if endpoint := c.Endpoints[names.Pipes]; endpoint != "" {
+				o.EndpointResolver = pipes.EndpointResolverFromURL(endpoint)
+			}
+		})
+	})
+	client.rdsClient.init(func() *rds_sdkv2.Client {
 		return rds_sdkv2.NewFromConfig(cfg, func(o *rds_sdkv2.Options) {
+			o.APIOptions = c.sdkv2APIOptions(names.RDS, o.APIOptions)
+			o.Retryer = c.sdkv2Retryer(names.RDS, o.Retryer)
 			if endpoint := c.Endpoints[names.RDS]; endpoint != "" {
 				o.EndpointResolver = rds_sdkv2.EndpointResolverFromURL(endpoint)
 			}
 		})
 	})
-	client.s3controlClient.init(&cfg, func() *s3control_sdkv2.Client {
+	client.resourceexplorer2Client.init(func() *resourceexplorer2.Client {
+		return resourceexplorer2.NewFromConfig(cfg, func(o *resourceexplorer2.Options) {
+			o.APIOptions = c.sdkv2APIOptions(names.ResourceExplorer2, o.APIOptions)
+			o.Retryer = c.sdkv2Retryer(names.ResourceExplorer2, o.Retryer)
+			if endpoint := c.Endpoints[names.ResourceExplorer2]; endpoint != "" {
+				o.EndpointResolver = resourceexplorer2.EndpointResolverFromURL(endpoint)
+			}
+		})
+	})
+	client.rolesanywhereClient.init(func() *rolesanywhere.Client {
+		return rolesanywhere.NewFromConfig(cfg, func(o *rolesanywhere.Options) {
+			o.APIOptions = c.sdkv2APIOptions(names.RolesAnywhere, o.APIOptions)
+			o.Retryer = c.sdkv2Retryer(names.RolesAnywhere, o.Retryer)
+			if endpoint := c.Endpoints[names.RolesAnywhere]; endpoint != "" {
+				o.EndpointResolver = rolesanywhere.EndpointResolverFromURL(endpoint)
+			}
+		})
+	})
+	client.s3controlClient.init(func() *s3control_sdkv2.Client {
 		return s3control_sdkv2.NewFromConfig(cfg, func(o *s3control_sdkv2.Options) {
+			o.APIOptions = c.sdkv2APIOptions(names.S3Control, o.APIOptions)
+			o.Retryer = c.sdkv2Retryer(names.S3Control, o.Retryer)
 			if endpoint := c.Endpoints[names.S3Control]; endpoint != "" {
 				o.EndpointResolver = s3control_sdkv2.EndpointResolverFromURL(endpoint)
 			}
 		})
 	})
-	client.ssmClient.init(&cfg, func() *ssm_sdkv2.Client {
+	client.sesv2Client.init(func() *sesv2.Client {
+		return sesv2.NewFromConfig(cfg, func(o *sesv2.Options) {
+			o.APIOptions = c.sdkv2APIOptions(names.SESV2, o.APIOptions)
+			o.Retryer = c.sdkv2Retryer(names.SESV2, o.Retryer)
+			if endpoint := c.Endpoints[names.SESV2]; endpoint != "" {
+				o.EndpointResolver = sesv2.EndpointResolverFromURL(endpoint)
+			}
+		})
+	})
+	client.ssmClient.init(func() *ssm_sdkv2.Client {
 		return ssm_sdkv2.NewFromConfig(cfg, func(o *ssm_sdkv2.Options) {
+			o.APIOptions = c.sdkv2APIOptions(names.SSM, o.APIOptions)
+			o.Retryer = c.sdkv2Retryer(names.SSM, o.Retryer)
 			if endpoint := c.Endpoints[names.SSM]; endpoint != "" {
 				o.EndpointResolver = ssm_sdkv2.EndpointResolverFromURL(endpoint)
 			}
 		})
 	})
+	client.ssmincidentsClient.init(func() *ssmincidents.Client {
+		return ssmincidents.NewFromConfig(cfg, func(o *ssmincidents.Options) {
+			o.APIOptions = c.sdkv2APIOptions(names.SSMIncidents, o.APIOptions)
+			o.Retryer = c.sdkv2Retryer(names.SSMIncidents, o.Retryer)
+			if endpoint := c.Endpoints[names.SSMIncidents]; endpoint != "" {
+				o.EndpointResolver = ssmincidents.EndpointResolverFromURL(endpoint)
+			}
+		})
+	})
+	client.schedulerClient.init(func() *scheduler.Client {
+		return scheduler.NewFromConfig(cfg, func(o *scheduler.Options) {
+			o.APIOptions = c.sdkv2APIOptions(names.Scheduler, o.APIOptions)
+			o.Retryer = c.sdkv2Retryer(names.Scheduler, o.Retryer)
+			if endpoint := c.Endpoints[names.Scheduler]; endpoint != "" {
+				o.EndpointResolver = scheduler.EndpointResolverFromURL(endpoint)
+			}
+		})
+	})
+	client.transcribeClient.init(func() *transcribe.Client {
+		return transcribe.NewFromConfig(cfg, func(o *transcribe.Options) {
+			o.APIOptions = c.sdkv2APIOptions(names.Transcribe, o.APIOptions)
+			o.Retryer = c.sdkv2Retryer(names.Transcribe, o.Retryer)
+			if endpoint := c.Endpoints[names.Transcribe]; endpoint != "" {
+				o.EndpointResolver = transcribe.EndpointResolverFromURL(endpoint)
+			}
+		})
+	})
 }
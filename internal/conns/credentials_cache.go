@@ -0,0 +1,161 @@
+package conns
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"log"
+	"sync"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Process-wide credentials cache.
+// Provider configurations (e.g. aliases for different Regions) that share a credential source,
+// IAM Role chain and partition reuse the credentials and caller identity resolved by the first of them,
+// instead of each repeating STS AssumeRole and GetCallerIdentity calls.
+
+// credentialsCacheEntry is the resolved identity for a credentials cache key.
+type credentialsCacheEntry struct {
+	AccountID string
+	// Credentials is shared by all provider configurations with the same key.
+	// An aws.CredentialsCache refreshes expiring (e.g. assumed IAM Role) credentials on use.
+	Credentials aws_sdkv2.CredentialsProvider
+	Partition   string
+}
+
+type credentialsCache struct {
+	mu      sync.Mutex
+	entries map[string]*credentialsCacheEntry
+}
+
+func newCredentialsCache() *credentialsCache {
+	return &credentialsCache{
+		entries: make(map[string]*credentialsCacheEntry),
+	}
+}
+
+var processCredentialsCache = newCredentialsCache()
+
+// get returns the cache entry for the specified key.
+// An entry whose credentials can no longer be retrieved (e.g. refreshing expired credentials fails) is evicted.
+func (cc *credentialsCache) get(ctx context.Context, key string) (*credentialsCacheEntry, aws_sdkv2.Credentials, bool) {
+	cc.mu.Lock()
+	entry, ok := cc.entries[key]
+	cc.mu.Unlock()
+
+	if !ok {
+		log.Printf("[DEBUG] Credentials cache miss (%s)", shortCredentialsCacheKey(key))
+		return nil, aws_sdkv2.Credentials{}, false
+	}
+
+	creds, err := entry.Credentials.Retrieve(ctx)
+	if err != nil {
+		log.Printf("[DEBUG] Credentials cache entry (%s) evicted: retrieving credentials: %s", shortCredentialsCacheKey(key), err)
+		cc.remove(key)
+		return nil, aws_sdkv2.Credentials{}, false
+	}
+
+	log.Printf("[DEBUG] Credentials cache hit (%s): account ID %q, partition %q", shortCredentialsCacheKey(key), entry.AccountID, entry.Partition)
+
+	return entry, creds, true
+}
+
+func (cc *credentialsCache) put(key string, entry *credentialsCacheEntry) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+
+	cc.entries[key] = entry
+}
+
+func (cc *credentialsCache) remove(key string) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+
+	delete(cc.entries, key)
+}
+
+// shortCredentialsCacheKey returns an abbreviated key for logging.
+func shortCredentialsCacheKey(key string) string {
+	return key[:12]
+}
+
+// credentialsCacheKeyData is everything that determines a provider configuration's credentials and caller identity.
+type credentialsCacheKeyData struct {
+	AccessKey                      string
+	AssumeRole                     []*awsbase.AssumeRole
+	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
+	CustomCABundle                 string
+	EC2MetadataServiceEnableState  imds.ClientEnableState
+	EC2MetadataServiceEndpoint     string
+	EC2MetadataServiceEndpointMode string
+	HTTPProxy                      string
+	IAMEndpoint                    string
+	Insecure                       bool
+	Partition                      string
+	Profile                        string
+	SecretKey                      string
+	SharedConfigFiles              []string
+	SharedCredentialsFiles         []string
+	SkipCredsValidation            bool
+	SkipRequestingAccountId        bool
+	STSEndpoint                    string
+	STSRegion                      string
+	Token                          string
+	UseDualStackEndpoint           bool
+	UseFIPSEndpoint                bool
+}
+
+// credentialsCacheKey returns the key identifying the configuration's credential source, IAM Role chain and partition.
+// Returns false if the configuration's credentials must not be cached, e.g. if the Region (and so the partition) is not known in advance.
+// The key is a hash so that secrets are not held in the clear.
+func (c *Config) credentialsCacheKey() (string, bool) {
+	if c.Region == "" {
+		return "", false
+	}
+
+	data := credentialsCacheKeyData{
+		AccessKey:                      c.AccessKey,
+		AssumeRole:                     c.AssumeRole,
+		AssumeRoleWithWebIdentity:      c.AssumeRoleWithWebIdentity,
+		CustomCABundle:                 c.CustomCABundle,
+		EC2MetadataServiceEnableState:  c.EC2MetadataServiceEnableState,
+		EC2MetadataServiceEndpoint:     c.EC2MetadataServiceEndpoint,
+		EC2MetadataServiceEndpointMode: c.EC2MetadataServiceEndpointMode,
+		HTTPProxy:                      c.HTTPProxy,
+		IAMEndpoint:                    c.Endpoints[names.IAM],
+		Insecure:                       c.Insecure,
+		Profile:                        c.Profile,
+		SecretKey:                      c.SecretKey,
+		SharedConfigFiles:              c.SharedConfigFiles,
+		SharedCredentialsFiles:         c.SharedCredentialsFiles,
+		SkipCredsValidation:            c.SkipCredsValidation,
+		SkipRequestingAccountId:        c.SkipRequestingAccountId,
+		STSEndpoint:                    c.Endpoints[names.STS],
+		STSRegion:                      c.STSRegion,
+		Token:                          c.Token,
+		UseDualStackEndpoint:           c.UseDualStackEndpoint,
+		UseFIPSEndpoint:                c.UseFIPSEndpoint,
+	}
+
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), c.Region); ok {
+		data.Partition = p.ID()
+	} else {
+		// Unknown Regions are not assumed to share a partition.
+		data.Partition = c.Region
+	}
+
+	b, err := json.Marshal(data)
+	if err != nil {
+		return "", false
+	}
+
+	sum := sha256.Sum256(b)
+
+	return hex.EncodeToString(sum[:]), true
+}
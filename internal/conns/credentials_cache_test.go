@@ -0,0 +1,210 @@
+package conns
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const testGetCallerIdentityResponse = `<GetCallerIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <GetCallerIdentityResult>
+    <Arn>arn:aws:sts::111111111111:assumed-role/broker/session</Arn>
+    <UserId>ARO123EXAMPLE123:session</UserId>
+    <Account>111111111111</Account>
+  </GetCallerIdentityResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</GetCallerIdentityResponse>`
+
+func TestConfigCredentialsCacheKey(t *testing.T) {
+	t.Parallel()
+
+	base := func(region string) *Config {
+		return &Config{
+			AccessKey: "AKID",
+			AssumeRole: []*awsbase.AssumeRole{
+				{RoleARN: "arn:aws:iam::111111111111:role/broker"},
+			},
+			Region:    region,
+			SecretKey: "SECRET",
+		}
+	}
+
+	testCases := []struct {
+		Name          string
+		Config1       *Config
+		Config2       *Config
+		ExpectedEqual bool
+	}{
+		{
+			Name:          "same partition",
+			Config1:       base("us-west-2"), //lintignore:AWSAT003
+			Config2:       base("us-east-1"), //lintignore:AWSAT003
+			ExpectedEqual: true,
+		},
+		{
+			Name:    "different partition",
+			Config1: base("us-west-2"),     //lintignore:AWSAT003
+			Config2: base("us-gov-west-1"), //lintignore:AWSAT003
+		},
+		{
+			Name:    "different role chain",
+			Config1: base("us-west-2"), //lintignore:AWSAT003
+			Config2: func() *Config {
+				c := base("us-west-2") //lintignore:AWSAT003
+				c.AssumeRole = append(c.AssumeRole, &awsbase.AssumeRole{RoleARN: "arn:aws:iam::222222222222:role/workload"})
+				return c
+			}(),
+		},
+		{
+			Name:    "different session name",
+			Config1: base("us-west-2"), //lintignore:AWSAT003
+			Config2: func() *Config {
+				c := base("us-west-2") //lintignore:AWSAT003
+				c.AssumeRole = []*awsbase.AssumeRole{{RoleARN: "arn:aws:iam::111111111111:role/broker", SessionName: "session"}}
+				return c
+			}(),
+		},
+		{
+			Name:    "different credentials",
+			Config1: base("us-west-2"), //lintignore:AWSAT003
+			Config2: func() *Config {
+				c := base("us-west-2") //lintignore:AWSAT003
+				c.SecretKey = "OTHER"
+				return c
+			}(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			key1, ok1 := testCase.Config1.credentialsCacheKey()
+			key2, ok2 := testCase.Config2.credentialsCacheKey()
+
+			if !ok1 || !ok2 {
+				t.Fatalf("expected cacheable configurations")
+			}
+
+			if got, want := key1 == key2, testCase.ExpectedEqual; got != want {
+				t.Errorf("keys equal: got %t, expected %t", got, want)
+			}
+		})
+	}
+
+	t.Run("no Region", func(t *testing.T) {
+		t.Parallel()
+
+		if _, ok := base("").credentialsCacheKey(); ok {
+			t.Errorf("expected configuration without Region not to be cacheable")
+		}
+	})
+}
+
+func TestConfigureProviderCredentialsCache(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	actions := make(map[string]int)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		action := r.FormValue("Action")
+
+		mu.Lock()
+		actions[action]++
+		mu.Unlock()
+
+		switch action {
+		case "AssumeRole":
+			fmt.Fprintf(w, testAssumeRoleResponse, r.FormValue("RoleArn"), "broker")
+		case "GetCallerIdentity":
+			fmt.Fprint(w, testGetCallerIdentityResponse)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	calls := func(action string) int {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return actions[action]
+	}
+
+	ctx := context.Background()
+	configure := func(region, sessionName string) *AWSClient {
+		t.Helper()
+
+		c := &Config{
+			AccessKey: "AKID",
+			AssumeRole: []*awsbase.AssumeRole{
+				{RoleARN: "arn:aws:iam::111111111111:role/broker", SessionName: sessionName},
+			},
+			EC2MetadataServiceEnableState: imds.ClientDisabled,
+			Endpoints: map[string]string{
+				names.STS: server.URL,
+			},
+			Region:    region,
+			SecretKey: "SECRET",
+		}
+
+		client, diags := c.ConfigureProvider(ctx, new(AWSClient))
+
+		if diags.HasError() {
+			t.Fatalf("configuring provider: %v", diags)
+		}
+
+		return client
+	}
+
+	configure("us-west-2", "session1") //lintignore:AWSAT003
+
+	assumeRoleCalls, getCallerIdentityCalls := calls("AssumeRole"), calls("GetCallerIdentity")
+
+	if assumeRoleCalls == 0 || getCallerIdentityCalls == 0 {
+		t.Fatalf("expected STS calls, got AssumeRole: %d, GetCallerIdentity: %d", assumeRoleCalls, getCallerIdentityCalls)
+	}
+
+	// Aliases in the same partition share the assumed IAM Role credentials and caller identity.
+	for _, region := range []string{"us-east-1", "eu-west-1"} { //lintignore:AWSAT003
+		client := configure(region, "session1")
+
+		if got, want := client.AccountID, "111111111111"; got != want {
+			t.Errorf("%s AccountID: got %s, expected %s", region, got, want)
+		}
+		if got, want := client.Region, region; got != want {
+			t.Errorf("Region: got %s, expected %s", got, want)
+		}
+	}
+
+	if got, want := calls("AssumeRole"), assumeRoleCalls; got != want {
+		t.Errorf("AssumeRole calls: got %d, expected %d", got, want)
+	}
+	if got, want := calls("GetCallerIdentity"), getCallerIdentityCalls; got != want {
+		t.Errorf("GetCallerIdentity calls: got %d, expected %d", got, want)
+	}
+
+	// A different IAM Role session is not shared.
+	configure("us-west-2", "session2") //lintignore:AWSAT003
+
+	if got, want := calls("AssumeRole"), 2*assumeRoleCalls; got != want {
+		t.Errorf("AssumeRole calls: got %d, expected %d", got, want)
+	}
+}
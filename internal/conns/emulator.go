@@ -0,0 +1,73 @@
+package conns
+
+import (
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Local emulator mode.
+// All AWS API clients, including those for "global" services, are pointed at a single emulator endpoint
+// and the provider skips the checks and lookups that require real AWS.
+
+const (
+	// DefaultEmulatorAccountID is the AWS account ID used in emulator mode if none is configured.
+	DefaultEmulatorAccountID = "000000000000"
+	// emulatorCredential is the access key and secret key used in emulator mode if no credentials are configured.
+	emulatorCredential = "test"
+)
+
+// EmulatorConfig configures the provider to use a local AWS API emulator.
+type EmulatorConfig struct {
+	AccountID string
+	Endpoint  string
+}
+
+// applyEmulator expands the emulator configuration into the equivalent provider settings.
+// Settings that are explicitly configured, such as individual service endpoints, take precedence.
+// Boolean settings are explicitly configured if they are in ExplicitSettings, even if false.
+func (c *Config) applyEmulator() {
+	if c.Emulator == nil || c.Emulator.Endpoint == "" {
+		return
+	}
+
+	e := *c.Emulator
+	if e.AccountID == "" {
+		e.AccountID = DefaultEmulatorAccountID
+	}
+	c.Emulator = &e
+
+	log.Printf("[INFO] Emulator mode: (Endpoint: %q, AccountID: %q)", e.Endpoint, e.AccountID)
+
+	if c.Endpoints == nil {
+		c.Endpoints = make(map[string]string)
+	}
+
+	for _, pkg := range names.ProviderPackages() {
+		if c.Endpoints[pkg] == "" {
+			c.Endpoints[pkg] = e.Endpoint
+		}
+	}
+
+	if c.AccessKey == "" && c.SecretKey == "" && c.Profile == "" {
+		c.AccessKey = emulatorCredential
+		c.SecretKey = emulatorCredential
+	}
+
+	if c.EC2MetadataServiceEnableState == imds.ClientDefaultEnableState {
+		c.EC2MetadataServiceEnableState = imds.ClientDisabled
+	}
+
+	for name, v := range map[string]*bool{
+		"s3_use_path_style":           &c.S3UsePathStyle,
+		"skip_credentials_validation": &c.SkipCredsValidation,
+		"skip_get_ec2_platforms":      &c.SkipGetEC2Platforms,
+		"skip_region_validation":      &c.SkipRegionValidation,
+		"skip_requesting_account_id":  &c.SkipRequestingAccountId,
+	} {
+		if !c.ExplicitSettings[name] {
+			*v = true
+		}
+	}
+}
@@ -0,0 +1,234 @@
+package conns
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const testListHostedZonesResponse = `<ListHostedZonesResponse xmlns="https://route53.amazonaws.com/doc/2013-04-01/">
+  <HostedZones/>
+  <IsTruncated>false</IsTruncated>
+  <MaxItems>100</MaxItems>
+</ListHostedZonesResponse>`
+
+func TestConfigApplyEmulator(t *testing.T) {
+	t.Parallel()
+
+	const endpoint = "http://localhost:4566"
+
+	testCases := []struct {
+		Name              string
+		Config            Config
+		ExpectedAccessKey string
+		ExpectedAccountID string
+		ExpectedEndpoints map[string]string
+		ExpectedEmulator  bool
+		ExpectedSettings  map[string]bool
+	}{
+		{
+			Name: "no emulator",
+			Config: Config{
+				Endpoints: map[string]string{},
+			},
+			ExpectedEndpoints: map[string]string{
+				names.EC2:     "",
+				names.Route53: "",
+			},
+		},
+		{
+			Name: "defaults",
+			Config: Config{
+				Emulator: &EmulatorConfig{Endpoint: endpoint},
+			},
+			ExpectedAccessKey: emulatorCredential,
+			ExpectedAccountID: DefaultEmulatorAccountID,
+			ExpectedEndpoints: map[string]string{
+				names.EC2:               endpoint,
+				names.GlobalAccelerator: endpoint,
+				names.IAM:               endpoint,
+				names.Route53:           endpoint,
+				names.Route53Domains:    endpoint,
+				names.S3:                endpoint,
+				names.Shield:            endpoint,
+				names.STS:               endpoint,
+			},
+			ExpectedEmulator: true,
+		},
+		{
+			Name: "explicit settings",
+			Config: Config{
+				AccessKey: "AKID",
+				Emulator:  &EmulatorConfig{AccountID: "123456789012", Endpoint: endpoint},
+				Endpoints: map[string]string{
+					names.S3: "http://localhost:9000",
+				},
+				SecretKey: "SECRET",
+			},
+			ExpectedAccessKey: "AKID",
+			ExpectedAccountID: "123456789012",
+			ExpectedEndpoints: map[string]string{
+				names.EC2: endpoint,
+				names.S3:  "http://localhost:9000",
+			},
+			ExpectedEmulator: true,
+		},
+		{
+			Name: "explicit boolean settings",
+			Config: Config{
+				Emulator: &EmulatorConfig{Endpoint: endpoint},
+				ExplicitSettings: map[string]bool{
+					"s3_use_path_style":      true,
+					"skip_region_validation": true,
+				},
+				EC2MetadataServiceEnableState: imds.ClientEnabled,
+				SkipRegionValidation:          true,
+			},
+			ExpectedAccessKey: emulatorCredential,
+			ExpectedAccountID: DefaultEmulatorAccountID,
+			ExpectedEndpoints: map[string]string{
+				names.EC2: endpoint,
+			},
+			ExpectedEmulator: true,
+			ExpectedSettings: map[string]bool{
+				"EC2MetadataServiceDisabled": false,
+				"S3UsePathStyle":             false,
+				"SkipRegionValidation":       true,
+			},
+		},
+		{
+			Name: "profile",
+			Config: Config{
+				Emulator: &EmulatorConfig{Endpoint: endpoint},
+				Profile:  "emulator",
+			},
+			ExpectedAccountID: DefaultEmulatorAccountID,
+			ExpectedEndpoints: map[string]string{
+				names.EC2: endpoint,
+			},
+			ExpectedEmulator: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			c := testCase.Config
+			c.applyEmulator()
+
+			if got, want := c.AccessKey, testCase.ExpectedAccessKey; got != want {
+				t.Errorf("AccessKey: got %q, expected %q", got, want)
+			}
+
+			if testCase.ExpectedEmulator {
+				if got, want := c.Emulator.AccountID, testCase.ExpectedAccountID; got != want {
+					t.Errorf("AccountID: got %q, expected %q", got, want)
+				}
+			}
+
+			for service, want := range testCase.ExpectedEndpoints {
+				if got := c.Endpoints[service]; got != want {
+					t.Errorf("Endpoints[%s]: got %q, expected %q", service, got, want)
+				}
+			}
+
+			for name, got := range map[string]bool{
+				"EC2MetadataServiceDisabled": c.EC2MetadataServiceEnableState == imds.ClientDisabled,
+				"S3UsePathStyle":             c.S3UsePathStyle,
+				"SkipCredsValidation":        c.SkipCredsValidation,
+				"SkipGetEC2Platforms":        c.SkipGetEC2Platforms,
+				"SkipRegionValidation":       c.SkipRegionValidation,
+				"SkipRequestingAccountId":    c.SkipRequestingAccountId,
+			} {
+				want, ok := testCase.ExpectedSettings[name]
+				if !ok {
+					want = testCase.ExpectedEmulator
+				}
+
+				if got != want {
+					t.Errorf("%s: got %t, expected %t", name, got, want)
+				}
+			}
+		})
+	}
+
+	// The caller's configuration is not modified.
+	e := &EmulatorConfig{Endpoint: endpoint}
+	c := Config{Emulator: e}
+	c.applyEmulator()
+
+	if e.AccountID != "" {
+		t.Errorf("emulator configuration modified: %+v", e)
+	}
+}
+
+func TestConfigureProviderEmulator(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	var paths []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		paths = append(paths, r.URL.Path)
+		mu.Unlock()
+
+		fmt.Fprint(w, testListHostedZonesResponse)
+	}))
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+	c := &Config{
+		Emulator: &EmulatorConfig{Endpoint: server.URL},
+		Region:   "us-west-2", //lintignore:AWSAT003
+	}
+
+	client, diags := c.ConfigureProvider(ctx, new(AWSClient))
+
+	if diags.HasError() {
+		t.Fatalf("configuring provider: %v", diags)
+	}
+
+	if got, want := client.AccountID, DefaultEmulatorAccountID; got != want {
+		t.Errorf("AccountID: got %s, expected %s", got, want)
+	}
+
+	// "Global" services use the emulator endpoint.
+	for service, got := range map[string]string{
+		names.GlobalAccelerator: client.GlobalAcceleratorConn().Endpoint,
+		names.Route53:           client.Route53Conn().Endpoint,
+		names.S3:                client.S3Conn().Endpoint,
+		names.Shield:            client.ShieldConn().Endpoint,
+	} {
+		if want := server.URL; got != want {
+			t.Errorf("%s endpoint: got %s, expected %s", service, got, want)
+		}
+	}
+
+	if got := aws.BoolValue(client.S3Conn().Config.S3ForcePathStyle); !got {
+		t.Errorf("S3 path-style addressing: got %t, expected true", got)
+	}
+
+	if _, err := client.Route53Conn().ListHostedZonesWithContext(ctx, &route53.ListHostedZonesInput{}); err != nil {
+		t.Fatalf("listing Route 53 Hosted Zones: %s", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	// No requests are made while configuring the provider.
+	if got, want := paths, []string{"/2013-04-01/hostedzone"}; len(got) != len(want) || got[0] != want[0] {
+		t.Errorf("requests: got %v, expected %v", got, want)
+	}
+}
@@ -0,0 +1,256 @@
+package conns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/organizations"
+)
+
+// Account guardrails.
+// In addition to the allowed and forbidden account ID lists, the provider's AWS account can be allowed or denied
+// by its AWS Organizations organizational unit (OU) path, its account tags or its account alias.
+// Guardrails are evaluated once, when the provider is configured, so that a misconfigured provider fails before any plan is made.
+
+// GuardrailsConfig configures account guardrails.
+// The account is not allowed if it matches any Deny rule, or if there are Allow rules and it matches none of them.
+type GuardrailsConfig struct {
+	Allow []GuardrailRule
+	Deny  []GuardrailRule
+}
+
+// GuardrailRule matches an AWS account that satisfies all of the rule's conditions.
+type GuardrailRule struct {
+	// AccountAliasPatterns are glob patterns, in the syntax of path.Match, at least one of which must match one of the account's aliases.
+	AccountAliasPatterns []string
+	// AccountTags are the tags that the account must have in AWS Organizations. Values are glob patterns.
+	AccountTags map[string]string
+	// OrganizationalUnitPaths are AWS Organizations entity paths, e.g. "o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/".
+	// The account must be in one of the OUs or in an OU nested beneath one of them.
+	OrganizationalUnitPaths []string
+}
+
+func (c *GuardrailsConfig) enabled() bool {
+	return c != nil && (len(c.Allow) > 0 || len(c.Deny) > 0)
+}
+
+func (c *GuardrailsConfig) rules() []GuardrailRule {
+	return append(append([]GuardrailRule{}, c.Allow...), c.Deny...)
+}
+
+// accountDetails are the details of an AWS account against which guardrails are evaluated.
+// Only the details needed by the configured rules are looked up.
+type accountDetails struct {
+	Aliases []string
+	OUPath  string
+	Tags    map[string]string
+}
+
+func (r GuardrailRule) matches(account *accountDetails) bool {
+	if len(r.AccountAliasPatterns) > 0 && !matchesAnyAlias(r.AccountAliasPatterns, account.Aliases) {
+		return false
+	}
+
+	for k, pattern := range r.AccountTags {
+		v, ok := account.Tags[k]
+
+		if !ok {
+			return false
+		}
+
+		if ok, _ := path.Match(pattern, v); !ok {
+			return false
+		}
+	}
+
+	if len(r.OrganizationalUnitPaths) > 0 {
+		found := false
+
+		for _, ouPath := range r.OrganizationalUnitPaths {
+			if account.OUPath != "" && strings.HasPrefix(account.OUPath, normalizeOUPath(ouPath)) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func matchesAnyAlias(patterns, aliases []string) bool {
+	for _, pattern := range patterns {
+		for _, alias := range aliases {
+			if ok, _ := path.Match(pattern, alias); ok {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// normalizeOUPath returns the specified entity path with a trailing "/" so that prefix matching respects path segments.
+func normalizeOUPath(ouPath string) string {
+	if !strings.HasSuffix(ouPath, "/") {
+		return ouPath + "/"
+	}
+
+	return ouPath
+}
+
+// evaluate returns an error if the account is not allowed by the guardrails.
+func (c *GuardrailsConfig) evaluate(account *accountDetails) error {
+	for i, rule := range c.Deny {
+		if rule.matches(account) {
+			return fmt.Errorf("matches guardrails deny rule %d", i)
+		}
+	}
+
+	if len(c.Allow) == 0 {
+		return nil
+	}
+
+	for _, rule := range c.Allow {
+		if rule.matches(account) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("matches no guardrails allow rule")
+}
+
+// findAccountDetails looks up the details of the specified AWS account needed to evaluate the guardrails.
+// Account aliases are read using IAM. OU paths and account tags are read using AWS Organizations,
+// which requires the provider's credentials to be for the organization's management account or a delegated administrator.
+func (c *GuardrailsConfig) findAccountDetails(ctx context.Context, client *AWSClient, accountID string) (*accountDetails, error) {
+	var needAliases, needOUPath, needTags bool
+
+	for _, rule := range c.rules() {
+		needAliases = needAliases || len(rule.AccountAliasPatterns) > 0
+		needOUPath = needOUPath || len(rule.OrganizationalUnitPaths) > 0
+		needTags = needTags || len(rule.AccountTags) > 0
+	}
+
+	account := &accountDetails{}
+
+	if needAliases {
+		err := client.IAMConn().ListAccountAliasesPagesWithContext(ctx, &iam.ListAccountAliasesInput{}, func(page *iam.ListAccountAliasesOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			account.Aliases = append(account.Aliases, aws.StringValueSlice(page.AccountAliases)...)
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("listing IAM account aliases: %w", err)
+		}
+	}
+
+	if needOUPath {
+		ouPath, err := findOrganizationalUnitPath(ctx, client.OrganizationsConn(), accountID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		account.OUPath = ouPath
+	}
+
+	if needTags {
+		account.Tags = make(map[string]string)
+		input := &organizations.ListTagsForResourceInput{
+			ResourceId: aws.String(accountID),
+		}
+
+		err := client.OrganizationsConn().ListTagsForResourcePagesWithContext(ctx, input, func(page *organizations.ListTagsForResourceOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, tag := range page.Tags {
+				account.Tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("listing AWS Organizations account (%s) tags: %w", accountID, err)
+		}
+	}
+
+	return account, nil
+}
+
+// findOrganizationalUnitPath returns the AWS Organizations entity path of the specified account's OU,
+// e.g. "o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/".
+func findOrganizationalUnitPath(ctx context.Context, conn *organizations.Organizations, accountID string) (string, error) {
+	var ids []string
+
+	for id := accountID; ; {
+		output, err := conn.ListParentsWithContext(ctx, &organizations.ListParentsInput{
+			ChildId: aws.String(id),
+		})
+
+		if err != nil {
+			return "", fmt.Errorf("listing AWS Organizations parents (%s): %w", id, err)
+		}
+
+		if output == nil || len(output.Parents) == 0 {
+			return "", fmt.Errorf("AWS Organizations entity (%s) has no parent", id)
+		}
+
+		parent := output.Parents[0]
+		id = aws.StringValue(parent.Id)
+		ids = append([]string{id}, ids...)
+
+		if aws.StringValue(parent.Type) == organizations.ParentTypeRoot {
+			break
+		}
+	}
+
+	output, err := conn.DescribeOrganizationWithContext(ctx, &organizations.DescribeOrganizationInput{})
+
+	if err != nil {
+		return "", fmt.Errorf("describing AWS Organizations organization: %w", err)
+	}
+
+	if output == nil || output.Organization == nil {
+		return "", fmt.Errorf("describing AWS Organizations organization: empty result")
+	}
+
+	return aws.StringValue(output.Organization.Id) + "/" + strings.Join(ids, "/") + "/", nil
+}
+
+// check returns an error if the specified AWS account is not allowed by the guardrails.
+func (c *GuardrailsConfig) check(ctx context.Context, client *AWSClient, accountID string) error {
+	if accountID == "" {
+		return fmt.Errorf("the AWS account ID is required to evaluate guardrails")
+	}
+
+	account, err := c.findAccountDetails(ctx, client, accountID)
+
+	if err != nil {
+		return fmt.Errorf("reading AWS account (%s) details for guardrails: %w", accountID, err)
+	}
+
+	log.Printf("[DEBUG] Evaluating guardrails for AWS account (%s): (Aliases: %q, OUPath: %q, Tags: %q)", accountID, account.Aliases, account.OUPath, account.Tags)
+
+	if err := c.evaluate(account); err != nil {
+		return fmt.Errorf("AWS account ID not allowed: %s (%s)", accountID, err)
+	}
+
+	return nil
+}
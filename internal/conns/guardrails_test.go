@@ -0,0 +1,342 @@
+package conns
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestGuardrailRuleMatches(t *testing.T) {
+	t.Parallel()
+
+	account := &accountDetails{
+		Aliases: []string{"example-prod"},
+		OUPath:  "o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/ou-ab12-22222222/",
+		Tags: map[string]string{
+			"Environment": "production",
+			"Team":        "payments",
+		},
+	}
+
+	testCases := []struct {
+		Name     string
+		Rule     GuardrailRule
+		Expected bool
+	}{
+		{
+			Name:     "empty",
+			Rule:     GuardrailRule{},
+			Expected: true,
+		},
+		{
+			Name:     "alias pattern",
+			Rule:     GuardrailRule{AccountAliasPatterns: []string{"*-dev", "*-prod"}},
+			Expected: true,
+		},
+		{
+			Name:     "alias pattern no match",
+			Rule:     GuardrailRule{AccountAliasPatterns: []string{"*-dev"}},
+			Expected: false,
+		},
+		{
+			Name:     "tags",
+			Rule:     GuardrailRule{AccountTags: map[string]string{"Environment": "prod*", "Team": "payments"}},
+			Expected: true,
+		},
+		{
+			Name:     "tag value no match",
+			Rule:     GuardrailRule{AccountTags: map[string]string{"Environment": "dev*"}},
+			Expected: false,
+		},
+		{
+			Name:     "tag missing",
+			Rule:     GuardrailRule{AccountTags: map[string]string{"CostCenter": "*"}},
+			Expected: false,
+		},
+		{
+			Name:     "OU path",
+			Rule:     GuardrailRule{OrganizationalUnitPaths: []string{"o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/ou-ab12-22222222/"}},
+			Expected: true,
+		},
+		{
+			Name:     "parent OU path",
+			Rule:     GuardrailRule{OrganizationalUnitPaths: []string{"o-a1b2c3d4e5/r-ab12/ou-ab12-11111111"}},
+			Expected: true,
+		},
+		{
+			Name:     "OU path prefix of segment",
+			Rule:     GuardrailRule{OrganizationalUnitPaths: []string{"o-a1b2c3d4e5/r-ab12/ou-ab12-1111"}},
+			Expected: false,
+		},
+		{
+			Name:     "OU path no match",
+			Rule:     GuardrailRule{OrganizationalUnitPaths: []string{"o-a1b2c3d4e5/r-ab12/ou-ab12-33333333/"}},
+			Expected: false,
+		},
+		{
+			Name: "all conditions",
+			Rule: GuardrailRule{
+				AccountAliasPatterns:    []string{"*-prod"},
+				AccountTags:             map[string]string{"Environment": "production"},
+				OrganizationalUnitPaths: []string{"o-a1b2c3d4e5/r-ab12/"},
+			},
+			Expected: true,
+		},
+		{
+			Name: "one condition not met",
+			Rule: GuardrailRule{
+				AccountAliasPatterns:    []string{"*-prod"},
+				AccountTags:             map[string]string{"Environment": "development"},
+				OrganizationalUnitPaths: []string{"o-a1b2c3d4e5/r-ab12/"},
+			},
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.Rule.matches(account), testCase.Expected; got != want {
+				t.Errorf("got %t, expected %t", got, want)
+			}
+		})
+	}
+}
+
+func TestGuardrailsConfigEvaluate(t *testing.T) {
+	t.Parallel()
+
+	account := &accountDetails{
+		Aliases: []string{"example-prod"},
+	}
+	prod := GuardrailRule{AccountAliasPatterns: []string{"*-prod"}}
+	dev := GuardrailRule{AccountAliasPatterns: []string{"*-dev"}}
+
+	testCases := []struct {
+		Name          string
+		Config        GuardrailsConfig
+		ExpectedError bool
+	}{
+		{
+			Name:   "no rules",
+			Config: GuardrailsConfig{},
+		},
+		{
+			Name:   "allowed",
+			Config: GuardrailsConfig{Allow: []GuardrailRule{dev, prod}},
+		},
+		{
+			Name:          "not allowed",
+			Config:        GuardrailsConfig{Allow: []GuardrailRule{dev}},
+			ExpectedError: true,
+		},
+		{
+			Name:          "denied",
+			Config:        GuardrailsConfig{Deny: []GuardrailRule{prod}},
+			ExpectedError: true,
+		},
+		{
+			Name:   "not denied",
+			Config: GuardrailsConfig{Deny: []GuardrailRule{dev}},
+		},
+		{
+			Name:          "deny takes precedence",
+			Config:        GuardrailsConfig{Allow: []GuardrailRule{prod}, Deny: []GuardrailRule{prod}},
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.Config.evaluate(account)
+
+			if got, want := err != nil, testCase.ExpectedError; got != want {
+				t.Errorf("error: got %v, expected error %t", err, want)
+			}
+		})
+	}
+}
+
+// newTestGuardrailsServer returns an emulator that answers the IAM and AWS Organizations requests made to evaluate guardrails
+// for an account with alias "example-prod", tag Environment=production and OU path "o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/".
+func newTestGuardrailsServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if r.Form.Get("Action") == "ListAccountAliases" {
+			fmt.Fprint(w, `<ListAccountAliasesResponse xmlns="https://iam.amazonaws.com/doc/2010-05-08/">
+  <ListAccountAliasesResult>
+    <IsTruncated>false</IsTruncated>
+    <AccountAliases><member>example-prod</member></AccountAliases>
+  </ListAccountAliasesResult>
+</ListAccountAliasesResponse>`)
+			return
+		}
+
+		switch target := r.Header.Get("X-Amz-Target"); {
+		case strings.HasSuffix(target, ".ListParents"):
+			body, err := io.ReadAll(r.Body)
+
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			if strings.Contains(string(body), "ou-ab12-11111111") {
+				fmt.Fprint(w, `{"Parents":[{"Id":"r-ab12","Type":"ROOT"}]}`)
+			} else {
+				fmt.Fprint(w, `{"Parents":[{"Id":"ou-ab12-11111111","Type":"ORGANIZATIONAL_UNIT"}]}`)
+			}
+		case strings.HasSuffix(target, ".DescribeOrganization"):
+			fmt.Fprint(w, `{"Organization":{"Id":"o-a1b2c3d4e5"}}`)
+		case strings.HasSuffix(target, ".ListTagsForResource"):
+			fmt.Fprint(w, `{"Tags":[{"Key":"Environment","Value":"production"}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestConfigureProviderGuardrails(t *testing.T) {
+	t.Parallel()
+
+	server := newTestGuardrailsServer(t)
+
+	testCases := []struct {
+		Name          string
+		Guardrails    *GuardrailsConfig
+		ExpectedError bool
+	}{
+		{
+			Name: "allowed by OU path",
+			Guardrails: &GuardrailsConfig{
+				Allow: []GuardrailRule{{OrganizationalUnitPaths: []string{"o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/"}}},
+			},
+		},
+		{
+			Name: "not allowed by OU path",
+			Guardrails: &GuardrailsConfig{
+				Allow: []GuardrailRule{{OrganizationalUnitPaths: []string{"o-a1b2c3d4e5/r-ab12/ou-ab12-22222222/"}}},
+			},
+			ExpectedError: true,
+		},
+		{
+			Name: "denied by tag",
+			Guardrails: &GuardrailsConfig{
+				Deny: []GuardrailRule{{AccountTags: map[string]string{"Environment": "production"}}},
+			},
+			ExpectedError: true,
+		},
+		{
+			Name: "denied by alias pattern",
+			Guardrails: &GuardrailsConfig{
+				Deny: []GuardrailRule{{AccountAliasPatterns: []string{"*-prod"}}},
+			},
+			ExpectedError: true,
+		},
+		{
+			Name: "not denied by alias pattern",
+			Guardrails: &GuardrailsConfig{
+				Deny: []GuardrailRule{{AccountAliasPatterns: []string{"*-dev"}}},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			c := &Config{
+				Emulator:   &EmulatorConfig{Endpoint: server.URL},
+				Guardrails: testCase.Guardrails,
+				Region:     "us-west-2", //lintignore:AWSAT003
+			}
+
+			_, diags := c.ConfigureProvider(context.Background(), new(AWSClient))
+
+			if got, want := diags.HasError(), testCase.ExpectedError; got != want {
+				t.Errorf("error: got %v, expected error %t", diags, want)
+			}
+		})
+	}
+}
+
+func TestConfigureProviderAccountIDs(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(server.Close)
+
+	testCases := []struct {
+		Name                string
+		AllowedAccountIDs   []string
+		ForbiddenAccountIDs []string
+		ExpectedError       bool
+	}{
+		{
+			Name: "none",
+		},
+		{
+			Name:              "allowed",
+			AllowedAccountIDs: []string{"111111111111", DefaultEmulatorAccountID},
+		},
+		{
+			Name:              "not allowed",
+			AllowedAccountIDs: []string{"111111111111"},
+			ExpectedError:     true,
+		},
+		{
+			Name:                "forbidden",
+			ForbiddenAccountIDs: []string{"111111111111", DefaultEmulatorAccountID},
+			ExpectedError:       true,
+		},
+		{
+			Name:                "not forbidden",
+			ForbiddenAccountIDs: []string{"111111111111"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			c := &Config{
+				AllowedAccountIds:   testCase.AllowedAccountIDs,
+				Emulator:            &EmulatorConfig{Endpoint: server.URL},
+				ForbiddenAccountIds: testCase.ForbiddenAccountIDs,
+				Region:              "us-west-2", //lintignore:AWSAT003
+			}
+
+			_, diags := c.ConfigureProvider(context.Background(), new(AWSClient))
+
+			if got, want := diags.HasError(), testCase.ExpectedError; got != want {
+				t.Errorf("error: got %v, expected error %t", diags, want)
+			}
+		})
+	}
+}
@@ -2,12 +2,11 @@ package conns
 
 import (
 	"sync"
-
-	"github.com/aws/aws-sdk-go-v2/aws"
 )
 
 type clientInitFunc[T any] func() T
 
+// lazyClient is an AWS API client that is built on first use.
 type lazyClient[T any] struct {
 	initf clientInitFunc[T]
 
@@ -15,7 +14,7 @@ type lazyClient[T any] struct {
 	client T
 }
 
-func (l *lazyClient[T]) init(config *aws.Config, f clientInitFunc[T]) {
+func (l *lazyClient[T]) init(f clientInitFunc[T]) {
 	l.initf = f
 }
 
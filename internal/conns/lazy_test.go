@@ -0,0 +1,69 @@
+package conns
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestLazyClient(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+	var l lazyClient[*int]
+
+	l.init(func() *int {
+		calls++
+		v := calls
+		return &v
+	})
+
+	if calls != 0 {
+		t.Fatalf("client initialized before first use")
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			l.Client()
+		}()
+	}
+	wg.Wait()
+
+	if got, want := *l.Client(), 1; got != want {
+		t.Errorf("Client: got %d, expected %d", got, want)
+	}
+	if calls != 1 {
+		t.Errorf("client initialized %d times, expected once", calls)
+	}
+}
+
+// BenchmarkConfigureProvider measures configuring a provider with 20 aliases.
+func BenchmarkConfigureProvider(b *testing.B) {
+	const aliases = 20
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	b.Cleanup(server.Close)
+
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < aliases; j++ {
+			c := &Config{
+				Emulator: &EmulatorConfig{Endpoint: server.URL},
+				Region:   "us-west-2", //lintignore:AWSAT003
+			}
+
+			if _, diags := c.ConfigureProvider(ctx, new(AWSClient)); diags.HasError() {
+				b.Fatalf("configuring provider: %v", diags)
+			}
+		}
+	}
+}
@@ -0,0 +1,198 @@
+package conns
+
+import (
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Client-side rate limiting.
+// Requests to an AWS service are delayed before they are sent so as to stay within the configured
+// request rate and number of concurrent requests for the service.
+
+// RateLimitConfig configures client-side rate limiting for an AWS service.
+type RateLimitConfig struct {
+	Burst             int
+	MaxConcurrent     int
+	RequestsPerSecond float64
+	Service           string
+}
+
+// tokenBucket is a token bucket rate limiter.
+type tokenBucket struct {
+	burst  float64
+	last   time.Time
+	mu     sync.Mutex
+	rate   float64
+	tokens float64
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	if burst < 1 {
+		burst = int(math.Max(1, math.Ceil(rate)))
+	}
+
+	return &tokenBucket{
+		burst:  float64(burst),
+		rate:   rate,
+		tokens: float64(burst),
+	}
+}
+
+// reserve takes a token from the bucket, returning how long to wait before the token can be used.
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.last.IsZero() {
+		b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	}
+	b.last = now
+	b.tokens--
+
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// Wait blocks until a request can be sent or the context is done.
+func (b *tokenBucket) Wait(ctx context.Context) error {
+	d := b.reserve(time.Now())
+
+	if d == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// semaphore limits the number of concurrent requests.
+type semaphore chan struct{}
+
+func newSemaphore(limit int) semaphore {
+	return make(semaphore, limit)
+}
+
+// acquire blocks until a request slot is free or the context is done.
+// A successful acquire must be paired with a release.
+func (s semaphore) acquire(ctx context.Context) error {
+	select {
+	case s <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// release frees a request slot.
+func (s semaphore) release() {
+	<-s
+}
+
+// releaseOnCloseBody is a response body that frees its request slot when it is closed,
+// so that a request counts as concurrent until its response has been read.
+type releaseOnCloseBody struct {
+	io.ReadCloser
+	once      sync.Once
+	semaphore semaphore
+}
+
+func (b *releaseOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.semaphore.release)
+
+	return err
+}
+
+// serviceRateLimiter limits the requests to a single AWS service.
+type serviceRateLimiter struct {
+	bucket    *tokenBucket
+	semaphore semaphore
+}
+
+// rateLimitTransport is an http.RoundTripper that enforces per-service client-side rate limits.
+type rateLimitTransport struct {
+	limiters map[string]*serviceRateLimiter
+	next     http.RoundTripper
+}
+
+// newServiceRateLimiters returns rate limiters for the specified configurations, keyed by service identifier.
+func newServiceRateLimiters(configs []RateLimitConfig) map[string]*serviceRateLimiter {
+	limiters := make(map[string]*serviceRateLimiter, len(configs))
+
+	for _, c := range configs {
+		limiter := &serviceRateLimiter{}
+
+		if c.RequestsPerSecond > 0 {
+			limiter.bucket = newTokenBucket(c.RequestsPerSecond, c.Burst)
+		}
+
+		if c.MaxConcurrent > 0 {
+			limiter.semaphore = newSemaphore(c.MaxConcurrent)
+		}
+
+		limiters[c.Service] = limiter
+	}
+
+	return limiters
+}
+
+// wait blocks until the request rate allows a request to be sent or the context is done.
+func (l *serviceRateLimiter) wait(ctx context.Context) error {
+	if l.bucket == nil {
+		return nil
+	}
+
+	return l.bucket.Wait(ctx)
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	limiter, ok := t.limiters[serviceFromContext(ctx)]
+
+	if !ok {
+		return t.next.RoundTrip(req)
+	}
+
+	if limiter.semaphore == nil {
+		if err := limiter.wait(ctx); err != nil {
+			return nil, err
+		}
+
+		return t.next.RoundTrip(req)
+	}
+
+	if err := limiter.semaphore.acquire(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := limiter.wait(ctx); err != nil {
+		limiter.semaphore.release()
+		return nil, err
+	}
+
+	resp, err := t.next.RoundTrip(req)
+
+	if err != nil || resp.Body == nil {
+		limiter.semaphore.release()
+		return resp, err
+	}
+
+	// The request slot is held until the response body is closed.
+	resp.Body = &releaseOnCloseBody{ReadCloser: resp.Body, semaphore: limiter.semaphore}
+
+	return resp, nil
+}
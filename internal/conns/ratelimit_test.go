@@ -0,0 +1,252 @@
+package conns
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestTokenBucketReserve(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	testCases := []struct {
+		Name     string
+		Rate     float64
+		Burst    int
+		Elapsed  []time.Duration
+		Expected []time.Duration
+	}{
+		{
+			Name:     "within burst",
+			Rate:     1,
+			Burst:    3,
+			Elapsed:  []time.Duration{0, 0, 0},
+			Expected: []time.Duration{0, 0, 0},
+		},
+		{
+			Name:     "exceeds burst",
+			Rate:     2,
+			Burst:    1,
+			Elapsed:  []time.Duration{0, 0, 0},
+			Expected: []time.Duration{0, 500 * time.Millisecond, 1 * time.Second},
+		},
+		{
+			Name:     "refills",
+			Rate:     2,
+			Burst:    1,
+			Elapsed:  []time.Duration{0, 500 * time.Millisecond, 1 * time.Second},
+			Expected: []time.Duration{0, 0, 0},
+		},
+		{
+			Name:     "refill capped at burst",
+			Rate:     10,
+			Burst:    2,
+			Elapsed:  []time.Duration{0, 10 * time.Second, 10 * time.Second, 10 * time.Second},
+			Expected: []time.Duration{0, 0, 0, 100 * time.Millisecond},
+		},
+		{
+			Name:     "default burst",
+			Rate:     1.5,
+			Elapsed:  []time.Duration{0, 0, 0},
+			Expected: []time.Duration{0, 0, 666666666 * time.Nanosecond},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			bucket := newTokenBucket(testCase.Rate, testCase.Burst)
+
+			for i, elapsed := range testCase.Elapsed {
+				if got, want := bucket.reserve(now.Add(elapsed)), testCase.Expected[i]; got != want {
+					t.Errorf("reservation %d: got %s, expected %s", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestRateLimitTransportMaxConcurrent(t *testing.T) {
+	t.Parallel()
+
+	const (
+		maxConcurrent = 2
+		requests      = 6
+	)
+
+	var inFlight, limitedInFlight, peak, unlimitedInFlight, unlimitedPeak int32
+	release := make(chan struct{})
+
+	transport := &rateLimitTransport{
+		limiters: newServiceRateLimiters([]RateLimitConfig{{Service: "route53", MaxConcurrent: maxConcurrent}}),
+		next: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			atomic.AddInt32(&inFlight, 1)
+			defer atomic.AddInt32(&inFlight, -1)
+
+			c, p := &limitedInFlight, &peak
+			if serviceFromContext(req.Context()) != "route53" {
+				c, p = &unlimitedInFlight, &unlimitedPeak
+			}
+			n := atomic.AddInt32(c, 1)
+			defer atomic.AddInt32(c, -1)
+			for {
+				v := atomic.LoadInt32(p)
+				if n <= v || atomic.CompareAndSwapInt32(p, v, n) {
+					break
+				}
+			}
+
+			<-release
+
+			return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+		}),
+	}
+
+	var wg sync.WaitGroup
+
+	for _, service := range []string{"route53", "sqs"} {
+		ctx := withService(context.Background(), service)
+
+		for i := 0; i < requests; i++ {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://example.com", nil)
+				if err != nil {
+					t.Errorf("creating request: %s", err)
+					return
+				}
+
+				resp, err := transport.RoundTrip(req)
+				if err != nil {
+					t.Errorf("sending request: %s", err)
+					return
+				}
+
+				resp.Body.Close()
+			}()
+		}
+	}
+
+	// Wait for the unlimited requests and the permitted limited requests to be in flight.
+	deadline := time.Now().Add(10 * time.Second)
+	for atomic.LoadInt32(&inFlight) < requests+maxConcurrent && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	time.Sleep(50 * time.Millisecond)
+
+	if got, want := atomic.LoadInt32(&inFlight), int32(requests+maxConcurrent); got != want {
+		t.Errorf("in flight: got %d, expected %d", got, want)
+	}
+
+	close(release)
+	wg.Wait()
+
+	if got, want := atomic.LoadInt32(&peak), int32(maxConcurrent); got != want {
+		t.Errorf("limited peak concurrency: got %d, expected %d", got, want)
+	}
+
+	if got, want := atomic.LoadInt32(&unlimitedPeak), int32(requests); got != want {
+		t.Errorf("unlimited peak concurrency: got %d, expected %d", got, want)
+	}
+}
+
+func TestRateLimitTransportMaxConcurrentBody(t *testing.T) {
+	t.Parallel()
+
+	var fail bool
+
+	transport := &rateLimitTransport{
+		limiters: newServiceRateLimiters([]RateLimitConfig{{Service: "route53", MaxConcurrent: 1}}),
+		next: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			if fail {
+				return nil, errors.New("test")
+			}
+
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("body"))}, nil
+		}),
+	}
+
+	ctx := withService(context.Background(), "route53")
+
+	send := func(ctx context.Context) (*http.Response, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://example.com", nil)
+		if err != nil {
+			t.Fatalf("creating request: %s", err)
+		}
+
+		return transport.RoundTrip(req)
+	}
+
+	resp, err := send(ctx)
+	if err != nil {
+		t.Fatalf("sending request: %s", err)
+	}
+
+	// The slot is held until the response body is closed.
+	waitCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+
+	if _, err := send(waitCtx); err != context.DeadlineExceeded { //nolint:errorlint // Exact error expected.
+		t.Errorf("request with open body: got error %v, expected %v", err, context.DeadlineExceeded)
+	}
+
+	resp.Body.Close()
+	resp.Body.Close()
+
+	// The slot is released at once when the request fails.
+	fail = true
+
+	for i := 0; i < 2; i++ {
+		if _, err := send(ctx); err == nil {
+			t.Errorf("request %d: expected error", i)
+		}
+	}
+
+	fail = false
+
+	resp, err = send(ctx)
+	if err != nil {
+		t.Fatalf("sending request: %s", err)
+	}
+
+	resp.Body.Close()
+}
+
+func TestRateLimitTransportContextDone(t *testing.T) {
+	t.Parallel()
+
+	transport := &rateLimitTransport{
+		limiters: newServiceRateLimiters([]RateLimitConfig{{Service: "route53", RequestsPerSecond: 0.001, Burst: 1}}),
+		next: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+		}),
+	}
+
+	ctx, cancel := context.WithTimeout(withService(context.Background(), "route53"), 100*time.Millisecond)
+	defer cancel()
+
+	for i, expected := range []error{nil, context.DeadlineExceeded} {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://example.com", nil)
+		if err != nil {
+			t.Fatalf("creating request: %s", err)
+		}
+
+		if _, err := transport.RoundTrip(req); err != expected { //nolint:errorlint // Exact error expected.
+			t.Errorf("request %d: got error %v, expected %v", i, err, expected)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package conns
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+)
+
+// regionalClients is a cache of AWSClients for Regions other than the provider's configured Region.
+// The cache is shared by the provider's AWSClient and all the regional AWSClients derived from it.
+type regionalClients struct {
+	awsConfig aws_sdkv2.Config
+	base      *AWSClient
+	config    Config
+
+	mu      sync.Mutex
+	clients map[string]*AWSClient
+}
+
+func newRegionalClients(c *Config, base *AWSClient, cfg aws_sdkv2.Config) *regionalClients {
+	return &regionalClients{
+		awsConfig: cfg,
+		base:      base,
+		config:    *c,
+		clients:   make(map[string]*AWSClient),
+	}
+}
+
+// RegionalClient returns an AWSClient for the specified Region.
+// The AWSClient shares credentials, HTTP client and provider-level configuration with the provider's AWSClient.
+// AWS SDK for Go v1 sessions and v2 configurations are built on first use and then cached for the lifetime of the provider.
+// An empty Region, or the AWSClient's own Region, returns the AWSClient itself.
+func (client *AWSClient) RegionalClient(ctx context.Context, region string) (*AWSClient, error) {
+	if region == "" || region == client.Region {
+		return client, nil
+	}
+
+	if client.regionalClients == nil {
+		return nil, fmt.Errorf("AWS client for Region (%s): provider not configured", region)
+	}
+
+	return client.regionalClients.get(ctx, region)
+}
+
+func (rc *regionalClients) get(_ context.Context, region string) (*AWSClient, error) {
+	if region == rc.base.Region {
+		return rc.base, nil
+	}
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	if client, ok := rc.clients[region]; ok {
+		return client, nil
+	}
+
+	if !rc.config.SkipRegionValidation {
+		if err := awsbase.ValidateRegion(region); err != nil {
+			return nil, err
+		}
+	}
+
+	DNSSuffix := rc.base.DNSSuffix
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok {
+		if p.ID() != rc.base.Partition {
+			return nil, fmt.Errorf("Region (%s) is not in the provider's partition (%s)", region, rc.base.Partition)
+		}
+
+		DNSSuffix = p.DNSSuffix()
+	}
+
+	client := &AWSClient{
+		AccountID:         rc.base.AccountID,
+		DefaultTagsConfig: rc.base.DefaultTagsConfig,
+		DNSSuffix:         DNSSuffix,
+		IgnoreTagsConfig:  rc.base.IgnoreTagsConfig,
+		Partition:         rc.base.Partition,
+		Region:            region,
+		ReverseDNSPrefix:  ReverseDNS(DNSSuffix),
+		ServicePackages:   rc.base.ServicePackages,
+		Session:           rc.base.Session.Copy(&aws.Config{Region: aws.String(region)}),
+		TerraformVersion:  rc.base.TerraformVersion,
+		httpClient:        rc.base.httpClient,
+		regionalClients:   rc,
+		tracerProvider:    rc.base.tracerProvider,
+	}
+
+	cfg := rc.awsConfig.Copy()
+	cfg.Region = region
+
+	c := rc.config
+	c.Region = region
+
+	c.configureClients(client, client.Session, cfg)
+
+	rc.clients[region] = client
+
+	return client, nil
+}
@@ -0,0 +1,92 @@
+package conns
+
+import (
+	"context"
+	"testing"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+func TestAWSClientRegionalClient(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.Background()
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Region:      aws.String("us-west-2"), //lintignore:AWSAT003
+	})
+
+	if err != nil {
+		t.Fatalf("creating session: %s", err)
+	}
+
+	client := &AWSClient{
+		AccountID: "123456789012",
+		DNSSuffix: "amazonaws.com",
+		Partition: "aws",
+		Region:    "us-west-2", //lintignore:AWSAT003
+		Session:   sess,
+	}
+	client.regionalClients = newRegionalClients(&Config{Region: client.Region}, client, aws_sdkv2.Config{Region: client.Region})
+
+	if got, err := client.RegionalClient(ctx, ""); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	} else if got != client {
+		t.Errorf("empty Region: expected provider's client")
+	}
+
+	if got, err := client.RegionalClient(ctx, client.Region); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	} else if got != client {
+		t.Errorf("provider's Region: expected provider's client")
+	}
+
+	regional, err := client.RegionalClient(ctx, "eu-west-1") //lintignore:AWSAT003
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := regional.Region, "eu-west-1"; got != want { //lintignore:AWSAT003
+		t.Errorf("Region: got %s, expected %s", got, want)
+	}
+	if got, want := regional.AccountID, client.AccountID; got != want {
+		t.Errorf("AccountID: got %s, expected %s", got, want)
+	}
+	if got, want := aws.StringValue(regional.Session.Config.Region), "eu-west-1"; got != want { //lintignore:AWSAT003
+		t.Errorf("Session Region: got %s, expected %s", got, want)
+	}
+	if got, want := aws.StringValue(regional.SQSConn().Config.Region), "eu-west-1"; got != want { //lintignore:AWSAT003
+		t.Errorf("SQS client Region: got %s, expected %s", got, want)
+	}
+
+	if again, err := client.RegionalClient(ctx, "eu-west-1"); err != nil { //lintignore:AWSAT003
+		t.Fatalf("unexpected error: %s", err)
+	} else if again != regional {
+		t.Errorf("expected cached client")
+	}
+
+	if got, err := regional.RegionalClient(ctx, client.Region); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	} else if got != client {
+		t.Errorf("regional client for provider's Region: expected provider's client")
+	}
+
+	if _, err := client.RegionalClient(ctx, "cn-north-1"); err == nil { //lintignore:AWSAT003
+		t.Errorf("expected error for Region in another partition")
+	}
+}
+
+func TestAWSClientRegionalClientNotConfigured(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	client := &AWSClient{
+		Region: "us-west-2", //lintignore:AWSAT003
+	}
+
+	if _, err := client.RegionalClient(context.Background(), "eu-west-1"); err == nil { //lintignore:AWSAT003
+		t.Errorf("expected error")
+	}
+}
@@ -0,0 +1,34 @@
+package conns
+
+import (
+	"context"
+
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/throttling"
+)
+
+type resourceKey struct{}
+
+// resourceIdentity identifies the Terraform resource on whose behalf AWS API calls are made.
+type resourceIdentity struct {
+	id           string
+	resourceType string
+}
+
+// NewResourceContext returns a copy of the specified context carrying the Terraform resource type and ID.
+// The provider's default tags are evaluated for the resource type.
+// The AWS services called with the returned context are recorded so that the resource's retry and wait loops back off when they are throttled.
+// API calls made with the returned context are attributed to the resource in the API audit log, if enabled.
+func NewResourceContext(ctx context.Context, resourceType, id string) context.Context {
+	ctx = tftags.NewResourceTypeContext(ctx, resourceType)
+	ctx = throttling.NewContext(ctx)
+
+	return context.WithValue(ctx, resourceKey{}, &resourceIdentity{id: id, resourceType: resourceType})
+}
+
+// resourceFromContext returns the Terraform resource carried in the specified context, if any.
+func resourceFromContext(ctx context.Context) (*resourceIdentity, bool) {
+	v, ok := ctx.Value(resourceKey{}).(*resourceIdentity)
+
+	return v, ok
+}
@@ -0,0 +1,196 @@
+package conns
+
+import (
+	"log"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+// Retry settings.
+// The provider-level retry mode, maximum number of retries and maximum backoff apply to all AWS SDK for Go v1 and v2
+// API clients and can be overridden per-service.
+
+// RetryConfig configures AWS SDK retry behavior. Zero values are not set.
+type RetryConfig struct {
+	MaxBackoff time.Duration
+	MaxRetries int
+	Mode       aws_sdkv2.RetryMode
+}
+
+// resolveRetryConfig sets any provider-level retry settings not set in the provider configuration to the values
+// resolved by the AWS SDK for Go v2 from environment variables and shared configuration files, or to the defaults.
+func (c *Config) resolveRetryConfig(cfg aws_sdkv2.Config) {
+	if cfg.Retryer != nil {
+		c.MaxRetries = cfg.Retryer().MaxAttempts()
+	}
+
+	if c.RetryMode == "" {
+		c.RetryMode = cfg.RetryMode
+	}
+
+	if c.RetryMode == "" {
+		c.RetryMode = aws_sdkv2.RetryModeStandard
+	}
+}
+
+// retryConfig returns the effective retry settings for the specified service.
+// An empty service returns the provider-level settings.
+func (c *Config) retryConfig(service string) RetryConfig {
+	rc := RetryConfig{
+		MaxBackoff: c.MaxBackoff,
+		MaxRetries: c.MaxRetries,
+		Mode:       c.RetryMode,
+	}
+
+	if v, ok := c.RetryOverrides[service]; ok {
+		if v.MaxBackoff > 0 {
+			rc.MaxBackoff = v.MaxBackoff
+		}
+
+		if v.MaxRetries > 0 {
+			rc.MaxRetries = v.MaxRetries
+		}
+
+		if v.Mode != "" {
+			rc.Mode = v.Mode
+		}
+	}
+
+	return rc
+}
+
+// logRetryConfig logs the effective retry settings.
+func (c *Config) logRetryConfig() {
+	rc := c.retryConfig("")
+
+	log.Printf("[INFO] AWS SDK retry settings: (Mode: %s, MaxRetries: %d, MaxBackoff: %s)", rc.Mode, rc.MaxRetries, maxBackoffString(rc.MaxBackoff))
+
+	for service := range c.RetryOverrides {
+		rc := c.retryConfig(service)
+
+		log.Printf("[INFO] AWS SDK retry settings for %s: (Mode: %s, MaxRetries: %d, MaxBackoff: %s)", service, rc.Mode, rc.MaxRetries, maxBackoffString(rc.MaxBackoff))
+	}
+}
+
+func maxBackoffString(d time.Duration) string {
+	if d == 0 {
+		return "SDK default"
+	}
+
+	return d.String()
+}
+
+// sdkv1RetryConfig returns the AWS SDK for Go v1 configuration for the specified retry settings.
+func sdkv1RetryConfig(rc RetryConfig) *aws.Config {
+	cfg := &aws.Config{
+		MaxRetries: aws.Int(rc.MaxRetries),
+	}
+
+	if rc.MaxBackoff > 0 {
+		cfg.Retryer = client.DefaultRetryer{
+			NumMaxRetries:    rc.MaxRetries,
+			MaxRetryDelay:    rc.MaxBackoff,
+			MaxThrottleDelay: rc.MaxBackoff,
+		}
+	}
+
+	return cfg
+}
+
+// configureSDKv1RetryMode configures the specified AWS SDK for Go v1 session for the specified retry mode.
+// The AWS SDK for Go v1 has no retry modes. Adaptive retry mode is emulated by adding client-side rate limiting
+// of request attempts, as in the AWS SDK for Go v2. Attempts are rate limited once requests start to be throttled.
+func configureSDKv1RetryMode(sess *session.Session, mode aws_sdkv2.RetryMode) {
+	const name = "tf-aws.AdaptiveRetry"
+
+	// Any rate limiter inherited from the session being copied is replaced.
+	sess.Handlers.Sign.RemoveByName(name)
+
+	if mode != aws_sdkv2.RetryModeAdaptive {
+		return
+	}
+
+	adaptive := retry.NewAdaptiveMode(func(o *retry.AdaptiveModeOptions) {
+		o.Throttles = append(o.Throttles, retry.IsErrorThrottleFunc(func(err error) aws_sdkv2.Ternary {
+			return aws_sdkv2.BoolTernary(request.IsErrorThrottle(err))
+		}))
+	})
+
+	// Sign handlers run before each attempt.
+	sess.Handlers.Sign.PushFrontNamed(request.NamedHandler{
+		Name: name,
+		Fn: func(r *request.Request) {
+			r.Handlers.CompleteAttempt.RemoveByName(name)
+
+			release, err := adaptive.GetAttemptToken(r.Context())
+
+			if err != nil {
+				r.Error = err
+				return
+			}
+
+			r.Handlers.CompleteAttempt.PushBackNamed(request.NamedHandler{
+				Name: name,
+				Fn: func(r *request.Request) {
+					_ = release(r.Error)
+				},
+			})
+		},
+	})
+}
+
+// newSDKv2Retryer returns an AWS SDK for Go v2 retryer for the specified retry settings.
+// In standard retry mode the specified retryer is wrapped so as to keep its behavior.
+func newSDKv2Retryer(retryer aws_sdkv2.Retryer, rc RetryConfig) aws_sdkv2.Retryer {
+	if rc.Mode == aws_sdkv2.RetryModeAdaptive {
+		return retry.NewAdaptiveMode(func(o *retry.AdaptiveModeOptions) {
+			o.StandardOptions = append(o.StandardOptions, func(o *retry.StandardOptions) {
+				o.MaxAttempts = rc.MaxRetries
+
+				if rc.MaxBackoff > 0 {
+					o.Backoff = retry.NewExponentialJitterBackoff(rc.MaxBackoff)
+					o.MaxBackoff = rc.MaxBackoff
+				}
+			})
+		})
+	}
+
+	if _, ok := retryer.(*retry.AdaptiveMode); ok || retryer == nil {
+		retryer = retry.NewStandard()
+	}
+
+	// AddWithMaxAttempts also ensures that the retryer is an aws.RetryerV2.
+	if _, ok := retryer.(aws_sdkv2.RetryerV2); !ok || retryer.MaxAttempts() != rc.MaxRetries {
+		retryer = retry.AddWithMaxAttempts(retryer, rc.MaxRetries)
+	}
+
+	if rc.MaxBackoff > 0 {
+		retryer = &maxBackoffRetryer{
+			RetryerV2: retryer.(aws_sdkv2.RetryerV2),
+			backoff:   retry.NewExponentialJitterBackoff(rc.MaxBackoff),
+		}
+	}
+
+	return retryer
+}
+
+// maxBackoffRetryer is an AWS SDK for Go v2 retryer with a maximum backoff.
+type maxBackoffRetryer struct {
+	aws_sdkv2.RetryerV2
+	backoff *retry.ExponentialJitterBackoff
+}
+
+func (r *maxBackoffRetryer) RetryDelay(attempt int, err error) (time.Duration, error) {
+	// The wrapped retryer may stop retrying, e.g. on networking errors.
+	if _, err := r.RetryerV2.RetryDelay(attempt, err); err != nil {
+		return 0, err
+	}
+
+	return r.backoff.BackoffDelay(attempt, err)
+}
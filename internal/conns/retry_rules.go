@@ -0,0 +1,280 @@
+package conns
+
+import (
+	"context"
+	"errors"
+	"path"
+	"strings"
+	"sync/atomic"
+
+	awsmiddleware_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/appconfig"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/aws/aws-sdk-go/service/chime"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	smithy "github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Retry rules.
+// A retry rule makes AWS API errors that the AWS SDKs don't retry by default retryable, e.g. errors caused by
+// eventual consistency or concurrent modification. Rules apply to both AWS SDK for Go v1 and v2 API clients.
+
+// RetryRule makes matching AWS API errors retryable.
+type RetryRule struct {
+	// ErrorCode is the AWS API error code.
+	ErrorCode string
+	// MaxRetries, if set, is the number of times a matching error is retried before it is no longer retryable.
+	MaxRetries int
+	// MessageContains, if set, must be contained in the AWS API error message.
+	MessageContains string
+	// Operation, if set, is a glob pattern (see path.Match) that the API operation name must match, e.g. "Describe*".
+	Operation string
+	// Service is the provider's identifier for the AWS service (see the names package).
+	Service string
+}
+
+// matches returns whether the rule matches an error from the specified API operation.
+// attempt is the number of the attempt, starting at 1.
+func (rule RetryRule) matches(operation, code, message string, attempt int) bool {
+	if code != rule.ErrorCode {
+		return false
+	}
+
+	if rule.Operation != "" {
+		if ok, _ := path.Match(rule.Operation, operation); !ok {
+			return false
+		}
+	}
+
+	if rule.MessageContains != "" && !strings.Contains(message, rule.MessageContains) {
+		return false
+	}
+
+	return rule.MaxRetries == 0 || attempt <= rule.MaxRetries
+}
+
+// retryRules returns the retry rules for the specified service, the provider's own rules followed by any configured.
+func (c *Config) retryRules(service string) []RetryRule {
+	var rules []RetryRule
+
+	for _, rule := range defaultRetryRules {
+		if rule.Service == service {
+			rules = append(rules, rule)
+		}
+	}
+
+	for _, rule := range c.RetryRules {
+		if rule.Service == service {
+			rules = append(rules, rule)
+		}
+	}
+
+	return rules
+}
+
+// retryRulesMatch returns whether any of the specified retry rules matches an error from the specified API operation.
+func retryRulesMatch(rules []RetryRule, operation, code, message string, attempt int) bool {
+	for _, rule := range rules {
+		if rule.matches(operation, code, message, attempt) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// configureSDKv1RetryRules adds the specified retry rules to the specified AWS SDK for Go v1 session.
+func configureSDKv1RetryRules(sess *session.Session, rules []RetryRule) {
+	if len(rules) == 0 {
+		return
+	}
+
+	sess.Handlers.Retry.PushBackNamed(request.NamedHandler{
+		Name: "tf-aws.RetryRules",
+		Fn: func(r *request.Request) {
+			var awsErr awserr.Error
+
+			if !errors.As(r.Error, &awsErr) {
+				return
+			}
+
+			if retryRulesMatch(rules, r.Operation.Name, awsErr.Code(), awsErr.Message(), r.RetryCount+1) {
+				r.Retryable = aws.Bool(true)
+			}
+		},
+	})
+}
+
+// addSDKv2RetryRules adds middleware that applies the specified retry rules to the specified AWS SDK for Go v2 stack.
+// The Finalize step middleware runs for each attempt, after the retry middleware, marking errors that match a rule as retryable.
+func addSDKv2RetryRules(stack *middleware.Stack, rules []RetryRule) error {
+	err := stack.Initialize.Add(middleware.InitializeMiddlewareFunc("tf-aws.RetryRules", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		return next.HandleInitialize(context.WithValue(ctx, retryRulesAttemptsKey{}, new(int32)), in)
+	}), middleware.After)
+
+	if err != nil {
+		return err
+	}
+
+	return stack.Finalize.Add(middleware.FinalizeMiddlewareFunc("tf-aws.RetryRules", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		attempt := 1
+		if v, ok := ctx.Value(retryRulesAttemptsKey{}).(*int32); ok {
+			attempt = int(atomic.AddInt32(v, 1))
+		}
+
+		out, metadata, err := next.HandleFinalize(ctx, in)
+
+		var apiErr smithy.APIError
+
+		if !errors.As(err, &apiErr) {
+			return out, metadata, err
+		}
+
+		if retryRulesMatch(rules, awsmiddleware_sdkv2.GetOperationName(ctx), apiErr.ErrorCode(), apiErr.ErrorMessage(), attempt) {
+			err = &retryableError{err: err}
+		}
+
+		return out, metadata, err
+	}), middleware.After)
+}
+
+// retryRulesAttemptsKey is the context key for an API operation's attempt counter.
+type retryRulesAttemptsKey struct{}
+
+// retryableError is an AWS SDK for Go v2 error that is retryable.
+type retryableError struct {
+	err error
+}
+
+func (e *retryableError) Error() string {
+	return e.err.Error()
+}
+
+func (e *retryableError) RetryableError() bool {
+	return true
+}
+
+func (e *retryableError) Unwrap() error {
+	return e.err
+}
+
+// defaultRetryRules are the provider's own retry rules.
+var defaultRetryRules = []RetryRule{
+	// Many operations can return an error such as:
+	//   ConflictException: Unable to complete operation due to concurrent modification. Please try again later.
+	// Handle them all globally for the service client.
+	{Service: names.APIGateway, ErrorCode: apigateway.ErrCodeConflictException, MessageContains: "try again later"},
+
+	// Workaround for https://github.com/aws/aws-sdk-go/issues/1472
+	{Service: names.AppAutoScaling, Operation: "Describe*", ErrorCode: applicationautoscaling.ErrCodeFailedResourceAccessException},
+	{Service: names.AppAutoScaling, Operation: "List*", ErrorCode: applicationautoscaling.ErrCodeFailedResourceAccessException},
+
+	// StartDeployment operations can return a ConflictException
+	// if ongoing deployments are in-progress.
+	{Service: names.AppConfig, Operation: "StartDeployment", ErrorCode: appconfig.ErrCodeConflictException},
+
+	{Service: names.AppSync, Operation: "CreateGraphqlApi", ErrorCode: appsync.ErrCodeConcurrentModificationException, MessageContains: "a GraphQL API creation is already in progress"},
+
+	// When calling CreateVoiceConnector across multiple resources,
+	// the API can randomly return a BadRequestException without explanation
+	{Service: names.Chime, Operation: "CreateVoiceConnector", ErrorCode: chime.ErrCodeBadRequestException, MessageContains: "Service received a bad request"},
+
+	{Service: names.CloudFormation, ErrorCode: cloudformation.ErrCodeOperationInProgressException, MessageContains: "Another Operation on StackSet"},
+
+	{Service: names.CloudHSMV2, ErrorCode: cloudhsmv2.ErrCodeCloudHsmInternalFailureException, MessageContains: "request was rejected because of an AWS CloudHSM internal failure"},
+
+	// When calling Config Organization Rules API actions immediately
+	// after Organization creation, the API can randomly return the
+	// OrganizationAccessDeniedException error for a few minutes, even
+	// after succeeding a few requests.
+	// We only want to retry briefly as the default max retry count would
+	// excessively retry when the error could be legitimate.
+	// ~10 retries gives a fair backoff of a few seconds.
+	{Service: names.ConfigService, Operation: "DeleteOrganizationConfigRule", ErrorCode: configservice.ErrCodeOrganizationAccessDeniedException, MessageContains: "This action can be only made by AWS Organization's master account.", MaxRetries: 9},
+	{Service: names.ConfigService, Operation: "DescribeOrganizationConfigRules", ErrorCode: configservice.ErrCodeOrganizationAccessDeniedException, MessageContains: "This action can be only made by AWS Organization's master account.", MaxRetries: 9},
+	{Service: names.ConfigService, Operation: "DescribeOrganizationConfigRuleStatuses", ErrorCode: configservice.ErrCodeOrganizationAccessDeniedException, MessageContains: "This action can be only made by AWS Organization's master account.", MaxRetries: 9},
+	{Service: names.ConfigService, Operation: "PutOrganizationConfigRule", ErrorCode: configservice.ErrCodeOrganizationAccessDeniedException, MessageContains: "This action can be only made by AWS Organization's master account.", MaxRetries: 9},
+	{Service: names.ConfigService, Operation: "DeleteOrganizationConformancePack", ErrorCode: configservice.ErrCodeOrganizationAccessDeniedException, MaxRetries: 9},
+	{Service: names.ConfigService, Operation: "DescribeOrganizationConformancePacks", ErrorCode: configservice.ErrCodeOrganizationAccessDeniedException, MaxRetries: 9},
+	{Service: names.ConfigService, Operation: "DescribeOrganizationConformancePackStatuses", ErrorCode: configservice.ErrCodeOrganizationAccessDeniedException, MaxRetries: 9},
+	{Service: names.ConfigService, Operation: "PutOrganizationConformancePack", ErrorCode: configservice.ErrCodeOrganizationAccessDeniedException, MaxRetries: 9},
+
+	// See https://github.com/aws/aws-sdk-go/pull/1276
+	{Service: names.DynamoDB, Operation: "DeleteItem", ErrorCode: dynamodb.ErrCodeLimitExceededException, MessageContains: "Subscriber limit exceeded:"},
+	{Service: names.DynamoDB, Operation: "PutItem", ErrorCode: dynamodb.ErrCodeLimitExceededException, MessageContains: "Subscriber limit exceeded:"},
+	{Service: names.DynamoDB, Operation: "UpdateItem", ErrorCode: dynamodb.ErrCodeLimitExceededException, MessageContains: "Subscriber limit exceeded:"},
+
+	{Service: names.EC2, Operation: "AttachVpnGateway", ErrorCode: "InvalidParameterValue", MessageContains: "This call cannot be completed because there are pending VPNs or Virtual Interfaces"},
+	{Service: names.EC2, Operation: "DetachVpnGateway", ErrorCode: "InvalidParameterValue", MessageContains: "This call cannot be completed because there are pending VPNs or Virtual Interfaces"},
+	{Service: names.EC2, Operation: "CreateClientVpnEndpoint", ErrorCode: "OperationNotPermitted", MessageContains: "Endpoint cannot be created while another endpoint is being created"},
+	{Service: names.EC2, Operation: "CreateClientVpnRoute", ErrorCode: "ConcurrentMutationLimitExceeded", MessageContains: "Cannot initiate another change for this endpoint at this time"},
+	{Service: names.EC2, Operation: "DeleteClientVpnRoute", ErrorCode: "ConcurrentMutationLimitExceeded", MessageContains: "Cannot initiate another change for this endpoint at this time"},
+	{Service: names.EC2, Operation: "CreateVpnConnection", ErrorCode: "VpnConnectionLimitExceeded", MessageContains: "maximum number of mutating objects has been reached"},
+	{Service: names.EC2, Operation: "CreateVpnGateway", ErrorCode: "VpnGatewayLimitExceeded", MessageContains: "maximum number of mutating objects has been reached"},
+
+	// Acceptance testing creates and deletes resources in quick succession.
+	// The FMS onboarding process into Organizations is opaque to consumers.
+	// Since we cannot reasonably check this status before receiving the error,
+	// set the operation as retryable.
+	{Service: names.FMS, Operation: "AssociateAdminAccount", ErrorCode: fms.ErrCodeInvalidOperationException, MessageContains: "Your AWS Organization is currently offboarding with AWS Firewall Manager. Please submit onboard request after offboarded."},
+	{Service: names.FMS, Operation: "DisassociateAdminAccount", ErrorCode: fms.ErrCodeInvalidOperationException, MessageContains: "Your AWS Organization is currently onboarding with AWS Firewall Manager and cannot be offboarded."},
+	// System problems can arise during FMS policy updates (maybe also creation),
+	// so we set the following operation as retryable.
+	// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/23946
+	{Service: names.FMS, Operation: "PutPolicy", ErrorCode: fms.ErrCodeInternalErrorException},
+
+	{Service: names.Kafka, ErrorCode: kafka.ErrCodeTooManyRequestsException, MessageContains: "Too Many Requests"},
+
+	{Service: names.Kinesis, Operation: "CreateStream", ErrorCode: kinesis.ErrCodeLimitExceededException, MessageContains: "simultaneously be in CREATING or DELETING"},
+	{Service: names.Kinesis, Operation: "CreateStream", ErrorCode: kinesis.ErrCodeLimitExceededException, MessageContains: "Rate exceeded for stream"},
+	{Service: names.Kinesis, Operation: "DeleteStream", ErrorCode: kinesis.ErrCodeLimitExceededException, MessageContains: "Rate exceeded for stream"},
+
+	{Service: names.Lightsail, Operation: "CreateContainerService", ErrorCode: lightsail.ErrCodeInvalidInputException, MessageContains: "Please try again in a few minutes"},
+	{Service: names.Lightsail, Operation: "CreateContainerServiceDeployment", ErrorCode: lightsail.ErrCodeInvalidInputException, MessageContains: "Please try again in a few minutes"},
+	{Service: names.Lightsail, Operation: "DeleteContainerService", ErrorCode: lightsail.ErrCodeInvalidInputException, MessageContains: "Please try again in a few minutes"},
+	{Service: names.Lightsail, Operation: "DeleteContainerService", ErrorCode: lightsail.ErrCodeInvalidInputException, MessageContains: "Please wait for it to complete before trying again"},
+	{Service: names.Lightsail, Operation: "UpdateContainerService", ErrorCode: lightsail.ErrCodeInvalidInputException, MessageContains: "Please try again in a few minutes"},
+
+	// ConcurrentModificationException: AWS Organizations can't complete your request because it conflicts with another attempt to modify the same entity. Try again later.
+	{Service: names.Organizations, ErrorCode: organizations.ErrCodeConcurrentModificationException, MessageContains: "Try again later"},
+
+	{Service: names.S3, ErrorCode: "OperationAborted", MessageContains: "A conflicting conditional operation is currently in progress against this resource. Please try again."},
+
+	// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/17996
+	{Service: names.SecurityHub, Operation: "EnableOrganizationAdminAccount", ErrorCode: securityhub.ErrCodeResourceConflictException},
+
+	// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/19215
+	{Service: names.SSOAdmin, Operation: "AttachManagedPolicyToPermissionSet", ErrorCode: ssoadmin.ErrCodeConflictException},
+	{Service: names.SSOAdmin, Operation: "DetachManagedPolicyFromPermissionSet", ErrorCode: ssoadmin.ErrCodeConflictException},
+
+	// InvalidGatewayRequestException: The specified gateway proxy network connection is busy.
+	{Service: names.StorageGateway, ErrorCode: storagegateway.ErrCodeInvalidGatewayRequestException, MessageContains: "The specified gateway proxy network connection is busy"},
+
+	{Service: names.WAFV2, ErrorCode: wafv2.ErrCodeWAFInternalErrorException, MessageContains: "Retry your request"},
+	{Service: names.WAFV2, ErrorCode: wafv2.ErrCodeWAFServiceLinkedRoleErrorException, MessageContains: "Retry"},
+	// WAFv2 supports tag on create which can result in the below error codes according to the documentation
+	{Service: names.WAFV2, Operation: "CreateIPSet", ErrorCode: wafv2.ErrCodeWAFTagOperationException, MessageContains: "Retry your request"},
+	{Service: names.WAFV2, Operation: "CreateRegexPatternSet", ErrorCode: wafv2.ErrCodeWAFTagOperationException, MessageContains: "Retry your request"},
+	{Service: names.WAFV2, Operation: "CreateRuleGroup", ErrorCode: wafv2.ErrCodeWAFTagOperationException, MessageContains: "Retry your request"},
+	{Service: names.WAFV2, Operation: "CreateWebACL", ErrorCode: wafv2.ErrCodeWAFTagOperationException, MessageContains: "Retry your request"},
+}
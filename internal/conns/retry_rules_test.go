@@ -0,0 +1,294 @@
+package conns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	awsmiddleware_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	smithy "github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestRetryRuleMatches(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name      string
+		Rule      RetryRule
+		Operation string
+		Code      string
+		Message   string
+		Attempt   int
+		Expected  bool
+	}{
+		{
+			Name:      "code",
+			Rule:      RetryRule{ErrorCode: "ConflictException"},
+			Operation: "CreateThing",
+			Code:      "ConflictException",
+			Attempt:   1,
+			Expected:  true,
+		},
+		{
+			Name:      "code mismatch",
+			Rule:      RetryRule{ErrorCode: "ConflictException"},
+			Operation: "CreateThing",
+			Code:      "ValidationException",
+			Attempt:   1,
+		},
+		{
+			Name:      "operation glob",
+			Rule:      RetryRule{ErrorCode: "ConflictException", Operation: "Describe*"},
+			Operation: "DescribeThings",
+			Code:      "ConflictException",
+			Attempt:   1,
+			Expected:  true,
+		},
+		{
+			Name:      "operation glob mismatch",
+			Rule:      RetryRule{ErrorCode: "ConflictException", Operation: "Describe*"},
+			Operation: "ListThings",
+			Code:      "ConflictException",
+			Attempt:   1,
+		},
+		{
+			Name:      "message",
+			Rule:      RetryRule{ErrorCode: "ConflictException", MessageContains: "try again later"},
+			Operation: "CreateThing",
+			Code:      "ConflictException",
+			Message:   "Unable to complete operation due to concurrent modification. Please try again later.",
+			Attempt:   1,
+			Expected:  true,
+		},
+		{
+			Name:      "message mismatch",
+			Rule:      RetryRule{ErrorCode: "ConflictException", MessageContains: "try again later"},
+			Operation: "CreateThing",
+			Code:      "ConflictException",
+			Message:   "Thing already exists.",
+			Attempt:   1,
+		},
+		{
+			Name:      "within max retries",
+			Rule:      RetryRule{ErrorCode: "ConflictException", MaxRetries: 9},
+			Operation: "CreateThing",
+			Code:      "ConflictException",
+			Attempt:   9,
+			Expected:  true,
+		},
+		{
+			Name:      "exceeds max retries",
+			Rule:      RetryRule{ErrorCode: "ConflictException", MaxRetries: 9},
+			Operation: "CreateThing",
+			Code:      "ConflictException",
+			Attempt:   10,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.Rule.matches(testCase.Operation, testCase.Code, testCase.Message, testCase.Attempt), testCase.Expected; got != want {
+				t.Errorf("got %t, expected %t", got, want)
+			}
+		})
+	}
+}
+
+func TestConfigRetryRules(t *testing.T) {
+	t.Parallel()
+
+	c := &Config{
+		RetryRules: []RetryRule{
+			{Service: names.EC2, Operation: "CreateRoute", ErrorCode: "InvalidTransitGatewayID.NotFound"},
+			{Service: names.SQS, ErrorCode: "AWS.SimpleQueueService.QueueDeletedRecently"},
+		},
+	}
+
+	rules := c.retryRules(names.EC2)
+
+	if len(rules) < 2 {
+		t.Fatalf("got %d rules, expected the default rules and the configured rule", len(rules))
+	}
+
+	for _, rule := range rules {
+		if rule.Service != names.EC2 {
+			t.Errorf("got rule for service %q", rule.Service)
+		}
+	}
+
+	// Configured rules follow the default rules.
+	if got, want := rules[len(rules)-1], c.RetryRules[0]; got != want {
+		t.Errorf("last rule: got %+v, expected %+v", got, want)
+	}
+
+	if got, want := len(c.retryRules(names.SQS)), 1; got != want {
+		t.Errorf("SQS rules: got %d, expected %d", got, want)
+	}
+}
+
+func TestSDKv1RetryRules(t *testing.T) {
+	t.Parallel()
+
+	sess, err := session.NewSession(&aws.Config{
+		Region: aws.String("us-west-2"), //lintignore:AWSAT003
+	})
+	if err != nil {
+		t.Fatalf("creating session: %s", err)
+	}
+
+	configureSDKv1RetryRules(sess, []RetryRule{
+		{Operation: "CreateThing", ErrorCode: "ConflictException", MaxRetries: 2},
+	})
+
+	testCases := []struct {
+		Name       string
+		Operation  string
+		Err        error
+		RetryCount int
+		Expected   bool
+	}{
+		{
+			Name:      "matches",
+			Operation: "CreateThing",
+			Err:       awserr.New("ConflictException", "conflict", nil),
+			Expected:  true,
+		},
+		{
+			Name:      "wrapped",
+			Operation: "CreateThing",
+			Err:       awserr.NewRequestFailure(awserr.New("ConflictException", "conflict", nil), 409, "request-id"),
+			Expected:  true,
+		},
+		{
+			Name:       "exceeds max retries",
+			Operation:  "CreateThing",
+			Err:        awserr.New("ConflictException", "conflict", nil),
+			RetryCount: 2,
+		},
+		{
+			Name:      "other operation",
+			Operation: "DeleteThing",
+			Err:       awserr.New("ConflictException", "conflict", nil),
+		},
+		{
+			Name:      "not an AWS error",
+			Operation: "CreateThing",
+			Err:       errors.New("ConflictException"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			r := &request.Request{
+				Error:      testCase.Err,
+				Operation:  &request.Operation{Name: testCase.Operation},
+				RetryCount: testCase.RetryCount,
+			}
+
+			sess.Handlers.Retry.Run(r)
+
+			if got, want := aws.BoolValue(r.Retryable), testCase.Expected; got != want {
+				t.Errorf("got %t, expected %t", got, want)
+			}
+		})
+	}
+}
+
+func TestSDKv2RetryRules(t *testing.T) {
+	t.Parallel()
+
+	rules := []RetryRule{
+		{Operation: "CreateThing", ErrorCode: "ConflictException", MaxRetries: 2},
+	}
+
+	testCases := []struct {
+		Name      string
+		Operation string
+		Err       error
+		Expected  []bool
+	}{
+		{
+			Name:      "matches",
+			Operation: "CreateThing",
+			Err:       &smithy.GenericAPIError{Code: "ConflictException", Message: "conflict"},
+			Expected:  []bool{true, true, false},
+		},
+		{
+			Name:      "other operation",
+			Operation: "DeleteThing",
+			Err:       &smithy.GenericAPIError{Code: "ConflictException", Message: "conflict"},
+			Expected:  []bool{false},
+		},
+		{
+			Name:      "not an API error",
+			Operation: "CreateThing",
+			Err:       errors.New("ConflictException"),
+			Expected:  []bool{false},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []bool
+			stack := middleware.NewStack(testCase.Operation, func() interface{} { return nil })
+
+			if err := stack.Initialize.Add(&awsmiddleware_sdkv2.RegisterServiceMetadata{OperationName: testCase.Operation}, middleware.Before); err != nil {
+				t.Fatalf("adding middleware: %s", err)
+			}
+
+			if err := addSDKv2RetryRules(stack, rules); err != nil {
+				t.Fatalf("adding middleware: %s", err)
+			}
+
+			// Simulate the retry middleware.
+			err := stack.Finalize.Add(middleware.FinalizeMiddlewareFunc("Retry", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+				for range testCase.Expected {
+					_, _, err := next.HandleFinalize(ctx, in)
+					retryable := retry.RetryableError{}.IsErrorRetryable(err).Bool()
+					got = append(got, retryable)
+
+					if !errors.Is(err, testCase.Err) {
+						t.Errorf("error %v does not wrap %v", err, testCase.Err)
+					}
+				}
+
+				return middleware.FinalizeOutput{}, middleware.Metadata{}, nil
+			}), middleware.Before)
+			if err != nil {
+				t.Fatalf("adding middleware: %s", err)
+			}
+
+			handler := middleware.DecorateHandler(middleware.HandlerFunc(func(ctx context.Context, input interface{}) (interface{}, middleware.Metadata, error) {
+				return nil, middleware.Metadata{}, testCase.Err
+			}), stack)
+
+			if _, _, err := handler.Handle(context.Background(), nil); err != nil {
+				t.Fatalf("handling: %s", err)
+			}
+
+			if fmt.Sprint(got) != fmt.Sprint(testCase.Expected) {
+				t.Errorf("retryable: got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,227 @@
+package conns
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+func TestConfigRetryConfig(t *testing.T) {
+	t.Parallel()
+
+	c := &Config{
+		MaxBackoff: 20 * time.Second,
+		MaxRetries: 25,
+		RetryMode:  aws_sdkv2.RetryModeStandard,
+		RetryOverrides: map[string]RetryConfig{
+			"ec2": {
+				MaxRetries: 50,
+			},
+			"route53": {
+				MaxBackoff: time.Minute,
+				Mode:       aws_sdkv2.RetryModeAdaptive,
+			},
+		},
+	}
+
+	testCases := []struct {
+		Service  string
+		Expected RetryConfig
+	}{
+		{
+			Service: "",
+			Expected: RetryConfig{
+				MaxBackoff: 20 * time.Second,
+				MaxRetries: 25,
+				Mode:       aws_sdkv2.RetryModeStandard,
+			},
+		},
+		{
+			Service: "sqs",
+			Expected: RetryConfig{
+				MaxBackoff: 20 * time.Second,
+				MaxRetries: 25,
+				Mode:       aws_sdkv2.RetryModeStandard,
+			},
+		},
+		{
+			Service: "ec2",
+			Expected: RetryConfig{
+				MaxBackoff: 20 * time.Second,
+				MaxRetries: 50,
+				Mode:       aws_sdkv2.RetryModeStandard,
+			},
+		},
+		{
+			Service: "route53",
+			Expected: RetryConfig{
+				MaxBackoff: time.Minute,
+				MaxRetries: 25,
+				Mode:       aws_sdkv2.RetryModeAdaptive,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Service, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := c.retryConfig(testCase.Service), testCase.Expected; got != want {
+				t.Errorf("got %+v, expected %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestNewSDKv2Retryer(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name             string
+		Retryer          aws_sdkv2.Retryer
+		Config           RetryConfig
+		ExpectedAdaptive bool
+		ExpectedMaxDelay time.Duration
+	}{
+		{
+			Name:             "standard",
+			Retryer:          retry.NewStandard(),
+			Config:           RetryConfig{MaxRetries: 10, Mode: aws_sdkv2.RetryModeStandard},
+			ExpectedMaxDelay: retry.DefaultMaxBackoff,
+		},
+		{
+			Name:             "standard max backoff",
+			Retryer:          retry.NewStandard(),
+			Config:           RetryConfig{MaxBackoff: 2 * time.Second, MaxRetries: 10, Mode: aws_sdkv2.RetryModeStandard},
+			ExpectedMaxDelay: 2 * time.Second,
+		},
+		{
+			Name:             "adaptive",
+			Retryer:          retry.NewStandard(),
+			Config:           RetryConfig{MaxRetries: 10, Mode: aws_sdkv2.RetryModeAdaptive},
+			ExpectedAdaptive: true,
+			ExpectedMaxDelay: retry.DefaultMaxBackoff,
+		},
+		{
+			Name:             "adaptive max backoff",
+			Retryer:          retry.NewStandard(),
+			Config:           RetryConfig{MaxBackoff: 2 * time.Second, MaxRetries: 10, Mode: aws_sdkv2.RetryModeAdaptive},
+			ExpectedAdaptive: true,
+			ExpectedMaxDelay: 2 * time.Second,
+		},
+		{
+			Name:             "adaptive to standard",
+			Retryer:          retry.NewAdaptiveMode(),
+			Config:           RetryConfig{MaxRetries: 10, Mode: aws_sdkv2.RetryModeStandard},
+			ExpectedMaxDelay: retry.DefaultMaxBackoff,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			retryer := newSDKv2Retryer(testCase.Retryer, testCase.Config)
+
+			if got, want := retryer.MaxAttempts(), testCase.Config.MaxRetries; got != want {
+				t.Errorf("MaxAttempts: got %d, expected %d", got, want)
+			}
+
+			if _, got := retryer.(*retry.AdaptiveMode); got != testCase.ExpectedAdaptive {
+				t.Errorf("adaptive: got %t, expected %t", got, testCase.ExpectedAdaptive)
+			}
+
+			// The delay for a late attempt is the maximum backoff, with jitter.
+			delay, err := retryer.RetryDelay(20, errors.New("test"))
+
+			if err != nil {
+				t.Fatalf("RetryDelay: %s", err)
+			}
+
+			if delay > testCase.ExpectedMaxDelay {
+				t.Errorf("RetryDelay: got %s, expected at most %s", delay, testCase.ExpectedMaxDelay)
+			}
+		})
+	}
+}
+
+func TestSDKv1Session(t *testing.T) {
+	t.Parallel()
+
+	base, err := session.NewSession(&aws.Config{
+		Region: aws.String("us-west-2"), //lintignore:AWSAT003
+	})
+	if err != nil {
+		t.Fatalf("creating session: %s", err)
+	}
+
+	c := &Config{
+		MaxBackoff: 20 * time.Second,
+		MaxRetries: 25,
+		RetryMode:  aws_sdkv2.RetryModeStandard,
+		RetryOverrides: map[string]RetryConfig{
+			"route53": {
+				MaxRetries: 50,
+				Mode:       aws_sdkv2.RetryModeAdaptive,
+			},
+		},
+	}
+
+	testCases := []struct {
+		Service            string
+		ExpectedMaxRetries int
+		ExpectedAdaptive   bool
+	}{
+		{
+			Service:            "sqs",
+			ExpectedMaxRetries: 25,
+		},
+		{
+			Service:            "route53",
+			ExpectedMaxRetries: 50,
+			ExpectedAdaptive:   true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Service, func(t *testing.T) {
+			t.Parallel()
+
+			sess := c.sdkv1Session(base, testCase.Service)
+
+			retryer, ok := sess.Config.Retryer.(client.DefaultRetryer)
+
+			if !ok {
+				t.Fatalf("Retryer: got %T, expected client.DefaultRetryer", sess.Config.Retryer)
+			}
+
+			if got, want := retryer.NumMaxRetries, testCase.ExpectedMaxRetries; got != want {
+				t.Errorf("NumMaxRetries: got %d, expected %d", got, want)
+			}
+
+			if got, want := retryer.MaxRetryDelay, c.MaxBackoff; got != want {
+				t.Errorf("MaxRetryDelay: got %s, expected %s", got, want)
+			}
+
+			// Removing the handler reports whether it was present.
+			before := sess.Handlers.Sign.Len()
+			sess.Handlers.Sign.RemoveByName("tf-aws.AdaptiveRetry")
+
+			if got := before != sess.Handlers.Sign.Len(); got != testCase.ExpectedAdaptive {
+				t.Errorf("adaptive: got %t, expected %t", got, testCase.ExpectedAdaptive)
+			}
+		})
+	}
+}
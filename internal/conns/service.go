@@ -0,0 +1,110 @@
+package conns
+
+import (
+	"context"
+	"net/http"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// Each AWS API client tags its requests' contexts with the provider's identifier for its service
+// (see the names package), so that requests can be handled per-service in the shared HTTP transport.
+
+type serviceKey struct{}
+
+// withService returns a copy of the specified context carrying the specified service identifier.
+func withService(ctx context.Context, service string) context.Context {
+	return context.WithValue(ctx, serviceKey{}, service)
+}
+
+// serviceFromContext returns the service identifier carried by the specified context.
+func serviceFromContext(ctx context.Context) string {
+	service, _ := ctx.Value(serviceKey{}).(string)
+
+	return service
+}
+
+// sdkv1Session returns a copy of the AWS SDK for Go v1 session for the specified service's API client.
+// The copy uses any custom endpoint and retry settings for the service, overridden by any additional configuration.
+func (c *Config) sdkv1Session(sess *session.Session, service string, cfgs ...*aws.Config) *session.Session {
+	rc := c.retryConfig(service)
+	sess = sess.Copy(append([]*aws.Config{{Endpoint: aws.String(c.Endpoints[service])}, sdkv1RetryConfig(rc)}, cfgs...)...)
+
+	configureSDKv1RetryMode(sess, rc.Mode)
+	configureSDKv1RetryRules(sess, c.retryRules(service))
+
+	sess.Handlers.Build.PushFrontNamed(request.NamedHandler{
+		Name: "tf-aws.Service",
+		Fn: func(r *request.Request) {
+			r.SetContext(withService(r.Context(), service))
+		},
+	})
+
+	return sess
+}
+
+// sdkv2APIOptions returns the AWS SDK for Go v2 API options for the specified service's API client.
+func (c *Config) sdkv2APIOptions(service string, apiOptions []func(*middleware.Stack) error) []func(*middleware.Stack) error {
+	// Force a copy so that API clients don't share the underlying array.
+	apiOptions = append(apiOptions[:len(apiOptions):len(apiOptions)], func(stack *middleware.Stack) error {
+		return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("tf-aws.Service", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			return next.HandleInitialize(withService(ctx, service), in)
+		}), middleware.Before)
+	})
+
+	if rules := c.retryRules(service); len(rules) > 0 {
+		apiOptions = append(apiOptions, func(stack *middleware.Stack) error {
+			return addSDKv2RetryRules(stack, rules)
+		})
+	}
+
+	return apiOptions
+}
+
+// sdkv2Retryer returns the AWS SDK for Go v2 retryer for the specified service's API client.
+func (c *Config) sdkv2Retryer(service string, retryer aws_sdkv2.Retryer) aws_sdkv2.Retryer {
+	if _, ok := c.RetryOverrides[service]; !ok {
+		return retryer
+	}
+
+	return newSDKv2Retryer(retryer, c.retryConfig(service))
+}
+
+// wrapTransport wraps the HTTP transport shared by the AWS SDK for Go v1 session and v2 configuration.
+// Must be called before any API clients are created.
+func wrapTransport(sess *session.Session, cfg *aws_sdkv2.Config, wrap func(http.RoundTripper) http.RoundTripper) {
+	httpClient := sess.Config.HTTPClient
+	next := httpClient.Transport
+
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	httpClient.Transport = wrap(next)
+
+	// The AWS SDK for Go v2 uses its own HTTP client unless the provider's HTTP client has been set.
+	if v, ok := cfg.HTTPClient.(*http.Client); !ok || v != httpClient {
+		cfg.HTTPClient = &transportHTTPClient{
+			transport: wrap(roundTripperFunc(cfg.HTTPClient.Do)),
+		}
+	}
+}
+
+// transportHTTPClient is an AWS SDK for Go v2 HTTP client that sends requests via an http.RoundTripper.
+type transportHTTPClient struct {
+	transport http.RoundTripper
+}
+
+func (c *transportHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	return c.transport.RoundTrip(req)
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -0,0 +1,52 @@
+package conns
+
+import (
+	"context"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	smithymiddleware "github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-provider-aws/internal/throttling"
+)
+
+// configureThrottling reports the AWS API calls made with the specified AWS SDK for Go v1 session and v2 configuration,
+// and the throttling errors returned by each attempt, to the shared throttling controller.
+func configureThrottling(sess *session.Session, cfg *aws_sdkv2.Config) {
+	sess.Handlers.Send.PushFrontNamed(request.NamedHandler{
+		Name: "tf-aws.Throttling",
+		Fn: func(r *request.Request) {
+			throttling.Called(r.Context(), r.ClientInfo.ServiceID, aws.StringValue(r.Config.Region))
+		},
+	})
+	sess.Handlers.Retry.PushFrontNamed(request.NamedHandler{
+		Name: "tf-aws.Throttling",
+		Fn: func(r *request.Request) {
+			if r.IsErrorThrottle() {
+				throttling.Throttled(r.ClientInfo.ServiceID, aws.StringValue(r.Config.Region))
+			}
+		},
+	})
+
+	throttles := retry.IsErrorThrottles(retry.DefaultThrottles)
+
+	cfg.APIOptions = append(cfg.APIOptions, func(stack *smithymiddleware.Stack) error {
+		// Added after the retry middleware so that each attempt is seen.
+		return stack.Finalize.Add(smithymiddleware.FinalizeMiddlewareFunc("tf-aws.Throttling", func(ctx context.Context, in smithymiddleware.FinalizeInput, next smithymiddleware.FinalizeHandler) (out smithymiddleware.FinalizeOutput, metadata smithymiddleware.Metadata, err error) {
+			service, region := awsmiddleware_sdkv2.GetServiceID(ctx), awsmiddleware_sdkv2.GetRegion(ctx)
+
+			throttling.Called(ctx, service, region)
+
+			out, metadata, err = next.HandleFinalize(ctx, in)
+
+			if err != nil && throttles.IsErrorThrottle(err).Bool() {
+				throttling.Throttled(service, region)
+			}
+
+			return out, metadata, err
+		}), smithymiddleware.After)
+	})
+}
@@ -0,0 +1,253 @@
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	smithymiddleware "github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
+	"github.com/hashicorp/terraform-provider-aws/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// OpenTelemetry tracing.
+// When enabled, a span is emitted for each AWS API operation made through the provider's AWS SDK for Go v1 and v2 API clients.
+// API operations made while handling a resource operation are children of the resource operation's span.
+
+const (
+	tracingFlushTimeout = 5 * time.Second
+	tracingServiceName  = "terraform-provider-aws"
+)
+
+// TracingConfig configures OpenTelemetry tracing.
+type TracingConfig struct {
+	Endpoint string
+	File     string
+	Headers  map[string]string
+}
+
+func (c *TracingConfig) enabled() bool {
+	return c != nil && (c.Endpoint != "" || c.File != "")
+}
+
+var (
+	tracerProvidersMu sync.Mutex
+	tracerProviders   = make(map[string]*sdktrace.TracerProvider)
+)
+
+// newTracerProvider returns an OpenTelemetry TracerProvider for the specified configuration.
+// TracerProviders are shared by all provider instances in the process with the same configuration.
+// Spans are written to a file as they end and are exported to an OTLP endpoint in batches, the last of which are exported by FlushTracing or ShutdownTracing.
+func newTracerProvider(ctx context.Context, c *TracingConfig) (*sdktrace.TracerProvider, error) {
+	b, err := json.Marshal(c)
+
+	if err != nil {
+		return nil, err
+	}
+
+	key := string(b)
+
+	tracerProvidersMu.Lock()
+	defer tracerProvidersMu.Unlock()
+
+	if tp, ok := tracerProviders[key]; ok {
+		return tp, nil
+	}
+
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceName(tracingServiceName),
+			semconv.ServiceVersion(version.ProviderVersion),
+		)),
+	}
+
+	if c.File != "" {
+		f, err := os.OpenFile(c.File, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+
+		if err != nil {
+			return nil, fmt.Errorf("opening tracing file (%s): %w", c.File, err)
+		}
+
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(f))
+
+		if err != nil {
+			return nil, fmt.Errorf("creating tracing file exporter: %w", err)
+		}
+
+		opts = append(opts, sdktrace.WithSyncer(exporter))
+	}
+
+	if c.Endpoint != "" {
+		u, err := url.Parse(c.Endpoint)
+
+		if err != nil {
+			return nil, fmt.Errorf("parsing tracing endpoint (%s): %w", c.Endpoint, err)
+		}
+
+		exporterOpts := []otlptracehttp.Option{
+			otlptracehttp.WithEndpoint(u.Host),
+		}
+
+		if u.Scheme == "http" {
+			exporterOpts = append(exporterOpts, otlptracehttp.WithInsecure())
+		}
+
+		// The default path is "/v1/traces".
+		if u.Path != "" && u.Path != "/" {
+			exporterOpts = append(exporterOpts, otlptracehttp.WithURLPath(u.Path))
+		}
+
+		if len(c.Headers) > 0 {
+			exporterOpts = append(exporterOpts, otlptracehttp.WithHeaders(c.Headers))
+		}
+
+		exporter, err := otlptracehttp.New(ctx, exporterOpts...)
+
+		if err != nil {
+			return nil, fmt.Errorf("creating tracing OTLP exporter: %w", err)
+		}
+
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	}
+
+	tp := sdktrace.NewTracerProvider(opts...)
+
+	tracerProviders[key] = tp
+
+	return tp, nil
+}
+
+// FlushTracing exports any spans buffered by the provider's TracerProviders.
+// Called when Terraform asks the provider to stop, as the provider process may be killed at any time afterwards.
+// Export is bounded by a timeout rather than a request's context so that spans are still exported once Terraform cancels its requests.
+func FlushTracing() {
+	ctx, cancel := context.WithTimeout(context.Background(), tracingFlushTimeout)
+	defer cancel()
+
+	tracerProvidersMu.Lock()
+	defer tracerProvidersMu.Unlock()
+
+	for _, tp := range tracerProviders {
+		_ = tp.ForceFlush(ctx)
+	}
+}
+
+// ShutdownTracing exports any spans buffered by the provider's TracerProviders and shuts them down.
+// Called when the provider process exits.
+func ShutdownTracing(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, tracingFlushTimeout)
+	defer cancel()
+
+	tracerProvidersMu.Lock()
+	defer tracerProvidersMu.Unlock()
+
+	for key, tp := range tracerProviders {
+		_ = tp.Shutdown(ctx)
+
+		delete(tracerProviders, key)
+	}
+}
+
+// apiSpanName returns the name of the span for an AWS API operation, e.g. "S3.PutObject".
+func apiSpanName(service, operation string) string {
+	return fmt.Sprintf("%s.%s", service, operation)
+}
+
+type tracingSpanKey struct{}
+
+// configureTracing enables OpenTelemetry tracing of AWS API operations for the specified AWS SDK for Go v1 session and v2 configuration.
+// Must be called before any API clients are created.
+func configureTracing(tp trace.TracerProvider, sess *session.Session, cfg *aws_sdkv2.Config) {
+	sess.Handlers.Build.PushFrontNamed(request.NamedHandler{
+		Name: "tf-aws.Tracing",
+		Fn: func(r *request.Request) {
+			ctx, span := tracing.Start(tracing.NewContext(r.Context(), tp), apiSpanName(r.ClientInfo.ServiceID, r.Operation.Name),
+				tracing.AttrAWSOperation.String(r.Operation.Name),
+				tracing.AttrAWSRegion.String(aws.StringValue(r.Config.Region)),
+				tracing.AttrAWSService.String(r.ClientInfo.ServiceID),
+			)
+
+			r.SetContext(context.WithValue(ctx, tracingSpanKey{}, span))
+		},
+	})
+
+	sess.Handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "tf-aws.Tracing",
+		Fn: func(r *request.Request) {
+			// Only end the span started for this request.
+			span, ok := r.Context().Value(tracingSpanKey{}).(trace.Span)
+
+			if !ok {
+				return
+			}
+
+			attrs := []attribute.KeyValue{
+				tracing.AttrAWSRetryCount.Int(r.RetryCount),
+			}
+
+			if r.RequestID != "" {
+				attrs = append(attrs, tracing.AttrAWSRequestID.String(r.RequestID))
+			}
+
+			if r.HTTPResponse != nil {
+				attrs = append(attrs, tracing.AttrHTTPStatusCode.Int(r.HTTPResponse.StatusCode))
+			}
+
+			span.SetAttributes(attrs...)
+			tracing.End(span, r.Error)
+		},
+	})
+
+	cfg.APIOptions = append(cfg.APIOptions, func(stack *smithymiddleware.Stack) error {
+		return stack.Initialize.Add(smithymiddleware.InitializeMiddlewareFunc("tf-aws.Tracing", func(ctx context.Context, in smithymiddleware.InitializeInput, next smithymiddleware.InitializeHandler) (out smithymiddleware.InitializeOutput, metadata smithymiddleware.Metadata, err error) {
+			operation := awsmiddleware_sdkv2.GetOperationName(ctx)
+			service := awsmiddleware_sdkv2.GetServiceID(ctx)
+
+			ctx, span := tracing.Start(tracing.NewContext(ctx, tp), apiSpanName(service, operation),
+				tracing.AttrAWSOperation.String(operation),
+				tracing.AttrAWSRegion.String(awsmiddleware_sdkv2.GetRegion(ctx)),
+				tracing.AttrAWSService.String(service),
+			)
+
+			out, metadata, err = next.HandleInitialize(ctx, in)
+
+			var attrs []attribute.KeyValue
+
+			if v, ok := retry.GetAttemptResults(metadata); ok && len(v.Results) > 0 {
+				attrs = append(attrs, tracing.AttrAWSRetryCount.Int(len(v.Results)-1))
+			}
+
+			if v, ok := awsmiddleware_sdkv2.GetRequestIDMetadata(metadata); ok {
+				attrs = append(attrs, tracing.AttrAWSRequestID.String(v))
+			}
+
+			if v, ok := awsmiddleware_sdkv2.GetRawResponse(metadata).(*smithyhttp.Response); ok {
+				attrs = append(attrs, tracing.AttrHTTPStatusCode.Int(v.StatusCode))
+			}
+
+			span.SetAttributes(attrs...)
+			tracing.End(span, err)
+
+			return out, metadata, err
+		}), smithymiddleware.After)
+	})
+}
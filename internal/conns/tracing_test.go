@@ -0,0 +1,187 @@
+package conns
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	rds_sdkv2 "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
+)
+
+// testSpan is the subset of a span written by the file exporter checked by tests.
+type testSpan struct {
+	Name        string
+	SpanContext struct {
+		SpanID  string
+		TraceID string
+	}
+	Parent struct {
+		SpanID string
+	}
+	Attributes []struct {
+		Key   string
+		Value struct {
+			Value any
+		}
+	}
+	Status struct {
+		Code string
+	}
+}
+
+func (s testSpan) attribute(key string) any {
+	for _, v := range s.Attributes {
+		if v.Key == key {
+			return v.Value.Value
+		}
+	}
+
+	return nil
+}
+
+func readTestSpans(t *testing.T, file string) map[string]testSpan {
+	t.Helper()
+
+	f, err := os.Open(file)
+
+	if err != nil {
+		t.Fatalf("opening tracing file: %s", err)
+	}
+
+	defer f.Close()
+
+	spans := make(map[string]testSpan)
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 1024*1024), 1024*1024)
+
+	for scanner.Scan() {
+		var span testSpan
+
+		if err := json.Unmarshal(scanner.Bytes(), &span); err != nil {
+			t.Fatalf("reading span: %s", err)
+		}
+
+		spans[span.Name] = span
+	}
+
+	return spans
+}
+
+func TestConfigureProviderTracing(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/2013-04-01/hostedzone") {
+			fmt.Fprint(w, testListHostedZonesResponse)
+			return
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(server.Close)
+
+	file := filepath.Join(t.TempDir(), "traces.json")
+	ctx := context.Background()
+	c := &Config{
+		Emulator: &EmulatorConfig{Endpoint: server.URL},
+		Region:   "us-west-2", //lintignore:AWSAT003
+		Tracing:  &TracingConfig{File: file},
+	}
+
+	client, diags := c.ConfigureProvider(ctx, new(AWSClient))
+
+	if diags.HasError() {
+		t.Fatalf("configuring provider: %v", diags)
+	}
+
+	ctx, span := tracing.Start(client.InitContext(ctx), "test")
+
+	if _, err := client.Route53Conn().ListHostedZonesWithContext(ctx, &route53.ListHostedZonesInput{}); err != nil {
+		t.Fatalf("listing Route 53 Hosted Zones: %s", err)
+	}
+
+	if _, err := client.RDSClient().DescribeDBInstances(ctx, &rds_sdkv2.DescribeDBInstancesInput{}); err == nil {
+		t.Fatalf("expected error describing RDS DB Instances")
+	}
+
+	tracing.End(span, nil)
+
+	spans := readTestSpans(t, file)
+	parent, ok := spans["test"]
+
+	if !ok {
+		t.Fatalf("parent span not found: %v", spans)
+	}
+
+	testCases := []struct {
+		Name           string
+		ExpectedStatus string
+		ExpectedHTTP   float64
+	}{
+		{
+			Name:           "Route 53.ListHostedZones",
+			ExpectedStatus: "Unset",
+			ExpectedHTTP:   http.StatusOK,
+		},
+		{
+			Name:           "RDS.DescribeDBInstances",
+			ExpectedStatus: "Error",
+			ExpectedHTTP:   http.StatusNotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		span, ok := spans[testCase.Name]
+
+		if !ok {
+			t.Errorf("span %q not found", testCase.Name)
+			continue
+		}
+
+		if got, want := span.Parent.SpanID, parent.SpanContext.SpanID; got != want {
+			t.Errorf("%s parent span ID: got %s, expected %s", testCase.Name, got, want)
+		}
+
+		if got, want := span.Status.Code, testCase.ExpectedStatus; got != want {
+			t.Errorf("%s status: got %s, expected %s", testCase.Name, got, want)
+		}
+
+		if got, want := span.attribute(string(tracing.AttrHTTPStatusCode)), testCase.ExpectedHTTP; got != want {
+			t.Errorf("%s HTTP status code: got %v, expected %v", testCase.Name, got, want)
+		}
+	}
+}
+
+func TestConfigureProviderTracingDisabled(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, testListHostedZonesResponse)
+	}))
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+	c := &Config{
+		Emulator: &EmulatorConfig{Endpoint: server.URL},
+		Region:   "us-west-2", //lintignore:AWSAT003
+	}
+
+	client, diags := c.ConfigureProvider(ctx, new(AWSClient))
+
+	if diags.HasError() {
+		t.Fatalf("configuring provider: %v", diags)
+	}
+
+	if ctx := client.InitContext(ctx); tracing.Enabled(ctx) {
+		t.Errorf("expected tracing not to be enabled")
+	}
+}
@@ -72,6 +72,22 @@ const (
 	AssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used to configure the provider
+const (
+	// Path of the file to which API audit log records are appended
+	AuditLogFile = "TF_AWS_AUDIT_LOG_FILE"
+
+	// URL of the OTLP/HTTP endpoint to which tracing spans are exported
+	TracingEndpoint = "TF_AWS_TRACING_ENDPOINT"
+
+	// Path of the file to which tracing spans are appended
+	TracingFile = "TF_AWS_TRACING_FILE"
+
+	// Path of the file in which the durations of long-running waits are kept
+	// to estimate the time remaining for later waits
+	WaitHistoryFile = "TF_AWS_WAIT_HISTORY_FILE"
+)
+
 // GetWithDefault gets an environment variable value if non-empty or returns the default.
 func GetWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
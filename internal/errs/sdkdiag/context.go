@@ -0,0 +1,61 @@
+package sdkdiag
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// Plugin SDK functions that can only return an error, e.g. a resource's CustomizeDiff, add warnings to their context instead.
+// The provider server returns the warnings collected by the context with the RPC's response.
+
+type warningsKey struct{}
+
+type warnings struct {
+	mu    sync.Mutex
+	diags diag.Diagnostics
+}
+
+// NewWarningsContext returns a copy of the specified context that collects the warnings added by AddWarning.
+func NewWarningsContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, warningsKey{}, &warnings{})
+}
+
+// AddWarning adds a warning to the specified context's warnings.
+// If the context doesn't collect warnings, the warning is logged.
+func AddWarning(ctx context.Context, summary, detail string) {
+	w, ok := ctx.Value(warningsKey{}).(*warnings)
+
+	if !ok {
+		tflog.Warn(ctx, summary, map[string]any{
+			"detail": detail,
+		})
+
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.diags = append(w.diags, diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  summary,
+		Detail:   detail,
+	})
+}
+
+// WarningsFromContext returns the warnings collected by the specified context.
+func WarningsFromContext(ctx context.Context) diag.Diagnostics {
+	w, ok := ctx.Value(warningsKey{}).(*warnings)
+
+	if !ok {
+		return nil
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return append(diag.Diagnostics(nil), w.diags...)
+}
@@ -53,13 +53,13 @@ func (r *ResourceWithConfigure) Configure(_ context.Context, request resource.Co
 
 // ExpandTags returns the API tags for the specified "tags" value.
 func (r *ResourceWithConfigure) ExpandTags(ctx context.Context, tags types.Map) tftags.KeyValueTags {
-	return r.Meta().DefaultTagsConfig.MergeTags(tftags.New(ctx, tags))
+	return r.Meta().DefaultTagsConfig.MergeTags(ctx, tftags.New(ctx, tags))
 }
 
 // FlattenTags returns the "tags" value from the specified API tags.
 func (r *ResourceWithConfigure) FlattenTags(ctx context.Context, apiTags tftags.KeyValueTags) types.Map {
 	// AWS APIs often return empty lists of tags when none have been configured.
-	if v := apiTags.IgnoreAWS().IgnoreConfig(r.Meta().IgnoreTagsConfig).RemoveDefaultConfig(r.Meta().DefaultTagsConfig).Map(); len(v) == 0 {
+	if v := apiTags.IgnoreAWS().IgnoreConfig(r.Meta().IgnoreTagsConfig).RemoveDefaultConfig(ctx, r.Meta().DefaultTagsConfig).Map(); len(v) == 0 {
 		return tftags.Null
 	} else {
 		return flex.FlattenFrameworkStringValueMapLegacy(ctx, v)
@@ -92,13 +92,29 @@ func (r *ResourceWithConfigure) SetTagsAll(ctx context.Context, request resource
 	if !planTags.IsUnknown() {
 		resourceTags := tftags.New(ctx, planTags)
 
-		if defaultTagsConfig.TagsEqual(resourceTags) {
+		if defaultTagsConfig.TagsEqual(ctx, resourceTags) {
 			response.Diagnostics.AddError(
 				`"tags" are identical to those in the "default_tags" configuration block of the provider`,
 				"please de-duplicate and try again")
 		}
 
-		allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
+		if requiredTagsConfig := defaultTagsConfig.GetRequiredTags(); requiredTagsConfig != nil {
+			if err := requiredTagsConfig.Check(tftags.ResourceTypeFromContext(ctx), defaultTagsConfig.MergeTags(ctx, resourceTags)); err != nil {
+				if requiredTagsConfig.WarnOnly {
+					response.Diagnostics.AddWarning("Required tags", err.Error())
+				} else {
+					response.Diagnostics.AddError("Required tags", err.Error())
+				}
+			}
+		}
+
+		resourceType := tftags.ResourceTypeFromContext(ctx)
+
+		if err := tftags.ConstraintsForResourceType(resourceType).Validate(resourceType, defaultTagsConfig.MergeTags(ctx, resourceTags)); err != nil {
+			response.Diagnostics.AddError("Tag constraints", err.Error())
+		}
+
+		allTags := defaultTagsConfig.MergeTags(ctx, resourceTags).IgnoreConfig(ignoreTagsConfig)
 
 		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("tags_all"), flex.FlattenFrameworkStringValueMapLegacy(ctx, allTags.Map()))...)
 	} else {
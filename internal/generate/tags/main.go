@@ -23,6 +23,7 @@ const (
 
 var (
 	getTag             = flag.Bool("GetTag", false, "whether to generate GetTag")
+	getTagsIn          = flag.Bool("GetTagsIn", false, "whether to generate GetTagsIn")
 	listTags           = flag.Bool("ListTags", false, "whether to generate ListTags")
 	serviceTagsMap     = flag.Bool("ServiceTagsMap", false, "whether to generate service tags for map")
 	serviceTagsSlice   = flag.Bool("ServiceTagsSlice", false, "whether to generate service tags for slice")
@@ -74,6 +75,7 @@ func usage() {
 
 type TemplateBody struct {
 	getTag           string
+	getTagsIn        string
 	header           string
 	listTags         string
 	serviceTagsMap   string
@@ -86,6 +88,7 @@ func newTemplateBody(version int, kvtValues bool) *TemplateBody {
 	case sdkV1:
 		return &TemplateBody{
 			"\n" + v1.GetTagBody,
+			"\n" + v1.GetTagsInBody,
 			v1.HeaderBody,
 			"\n" + v1.ListTagsBody,
 			"\n" + v1.ServiceTagsMapBody,
@@ -96,6 +99,7 @@ func newTemplateBody(version int, kvtValues bool) *TemplateBody {
 		if kvtValues {
 			return &TemplateBody{
 				"\n" + v2.GetTagBody,
+				"\n" + v2.GetTagsInBody,
 				v2.HeaderBody,
 				"\n" + v2.ListTagsBody,
 				"\n" + v2.ServiceTagsValueMapBody,
@@ -105,6 +109,7 @@ func newTemplateBody(version int, kvtValues bool) *TemplateBody {
 		}
 		return &TemplateBody{
 			"\n" + v2.GetTagBody,
+			"\n" + v2.GetTagsInBody,
 			v2.HeaderBody,
 			"\n" + v2.ListTagsBody,
 			"\n" + v2.ServiceTagsMapBody,
@@ -123,6 +128,7 @@ type TemplateData struct {
 	ServicePackage         string
 
 	GetTagFunc              string
+	GetTagsInType           string
 	ListTagsFunc            string
 	ListTagsInFiltIDName    string
 	ListTagsInIDElem        string
@@ -158,6 +164,7 @@ type TemplateData struct {
 
 	// The following are specific to writing import paths in the `headerBody`;
 	// to include the package, set the corresponding field's value to true
+	ConnsPkg        bool
 	ContextPkg      bool
 	FmtPkg          bool
 	HelperSchemaPkg bool
@@ -199,6 +206,16 @@ func main() {
 		g.Fatalf("encountered: %s", err)
 	}
 
+	if *getTagsIn {
+		if !*serviceTagsMap && !*serviceTagsSlice {
+			g.Fatalf("GetTagsIn requires ServiceTagsMap or ServiceTagsSlice")
+		}
+
+		if *tagTypeIDElem != "" {
+			g.Fatalf("GetTagsIn does not support TagTypeIDElem")
+		}
+	}
+
 	var clientType string
 	if *sdkVersion == sdkV1 {
 		clientType = fmt.Sprintf("%siface.%sAPI", awsPkg, clientTypeName)
@@ -215,20 +232,34 @@ func main() {
 		}
 	}
 
+	var getTagsInType string
+	switch {
+	case *serviceTagsMap && *sdkVersion == sdkV2 && *kvtValues:
+		getTagsInType = "map[string]string"
+	case *serviceTagsMap:
+		getTagsInType = "map[string]*string"
+	case *sdkVersion == sdkV1:
+		getTagsInType = fmt.Sprintf("[]*%s.%s", tagPackage, *tagType)
+	default:
+		getTagsInType = fmt.Sprintf("[]types.%s", *tagType)
+	}
+
 	templateData := TemplateData{
 		AWSService:             awsPkg,
 		AWSServiceIfacePackage: awsIntfPkg,
 		ClientType:             clientType,
 		ServicePackage:         servicePackage,
 
+		ConnsPkg:        *getTagsIn,
 		ContextPkg:      *sdkVersion == sdkV2 || (*getTag || *listTags || *serviceTagsMap || *serviceTagsSlice || *updateTags),
 		FmtPkg:          *updateTags,
-		HelperSchemaPkg: awsPkg == "autoscaling",
+		HelperSchemaPkg: awsPkg == "autoscaling" || *getTagsIn,
 		SkipTypesImp:    *skipTypesImp,
 		StrConvPkg:      awsPkg == "autoscaling",
 		TfResourcePkg:   *getTag,
 
 		GetTagFunc:              *getTagFunc,
+		GetTagsInType:           getTagsInType,
 		ListTagsFunc:            *listTagsFunc,
 		ListTagsInFiltIDName:    *listTagsInFiltIDName,
 		ListTagsInIDElem:        *listTagsInIDElem,
@@ -302,6 +333,12 @@ func main() {
 		}
 	}
 
+	if *getTagsIn {
+		if err := d.WriteTemplate("gettagsin", templateBody.getTagsIn, templateData); err != nil {
+			g.Fatalf("generating file (%s): %s", filename, err)
+		}
+	}
+
 	if *updateTags {
 		if err := d.WriteTemplate("updatetags", templateBody.updateTags, templateData); err != nil {
 			g.Fatalf("generating file (%s): %s", filename, err)
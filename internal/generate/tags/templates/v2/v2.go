@@ -13,6 +13,9 @@ var HeaderBody string
 //go:embed get_tag_body.tmpl
 var GetTagBody string
 
+//go:embed get_tags_in_body.tmpl
+var GetTagsInBody string
+
 //go:embed list_tags_body.tmpl
 var ListTagsBody string
 
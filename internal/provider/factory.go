@@ -21,7 +21,9 @@ func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.Provide
 	}
 
 	servers := []func() tfprotov5.ProviderServer{
-		primary.GRPCProvider,
+		func() tfprotov5.ProviderServer {
+			return newInFlightOperationsServer(newPlanWarningsServer(primary.GRPCProvider()))
+		},
 		providerserver.NewProtocol5(fwprovider.New(primary)),
 	}
 
@@ -31,5 +33,7 @@ func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.Provide
 		return nil, nil, err
 	}
 
-	return muxServer.ProviderServer, primary, nil
+	return func() tfprotov5.ProviderServer {
+		return newInFlightOperationsStopServer(muxServer.ProviderServer())
+	}, primary, nil
 }
@@ -0,0 +1,47 @@
+package fwprovider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// newInFlightOperationContext returns the context in which the wrapped resource's in-flight operation is tracked,
+// starting with the one in the resource's private state, if any. getKey is nil for Create.
+func newInFlightOperationContext(ctx context.Context, getKey func(context.Context, string) ([]byte, diag.Diagnostics)) context.Context {
+	var operation *tfresource.InFlightOperation
+
+	if getKey == nil {
+		return tfresource.NewInFlightOperationContext(ctx, operation)
+	}
+
+	if v, diags := getKey(ctx, tfresource.InFlightOperationPrivateStateKey); !diags.HasError() && len(v) > 0 {
+		if err := json.Unmarshal(v, &operation); err != nil {
+			operation = nil
+		}
+	}
+
+	return tfresource.NewInFlightOperationContext(ctx, operation)
+}
+
+// setInFlightOperationPrivateState sets the wrapped resource's in-flight operation in its private state
+// if it has changed during the resource operation. A completed operation is replaced by JSON null.
+func setInFlightOperationPrivateState(ctx context.Context, setKey func(context.Context, string, []byte) diag.Diagnostics, diags *diag.Diagnostics) {
+	operation, changed := tfresource.InFlightOperationFromContext(ctx)
+
+	if !changed {
+		return
+	}
+
+	v, err := json.Marshal(operation)
+
+	if err != nil {
+		diags.AddError("Encoding in-flight operation", err.Error())
+
+		return
+	}
+
+	diags.Append(setKey(ctx, tfresource.InFlightOperationPrivateStateKey, v)...)
+}
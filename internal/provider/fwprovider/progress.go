@@ -0,0 +1,20 @@
+package fwprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// newProgressContext returns the context in which the progress of the waits made by an operation on the wrapped resource is tracked.
+func (w *wrappedResource) newProgressContext(ctx context.Context, operation string) context.Context {
+	return tfresource.NewProgressContext(ctx, w.resourceType+"."+operation)
+}
+
+// addProgressWarnings summarizes the operation's long-running waits in warning diagnostics.
+func addProgressWarnings(ctx context.Context, diags *diag.Diagnostics) {
+	for _, v := range tfresource.ProgressSummaries(ctx) {
+		diags.AddWarning("Long-running wait", v)
+	}
+}
@@ -72,6 +72,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, default value is `false`",
 			},
+			"max_backoff": schema.StringAttribute{
+				Optional:    true,
+				Description: "The maximum backoff delay between retries of an AWS API request. Valid time units are ns, us (or µs), ms, s, h, or m.",
+			},
 			"max_retries": schema.Int64Attribute{
 				Optional:    true,
 				Description: "The maximum number of times an AWS API request is\nbeing executed. If the API request still fails, an error is\nthrown.",
@@ -84,6 +88,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "The region where AWS operations will take place. Examples\nare us-east-1, us-west-2, etc.", // lintignore:AWSAT003
 			},
+			"retry_mode": schema.StringAttribute{
+				Optional:    true,
+				Description: "Specifies how retries are attempted. Valid values are `standard` and `adaptive`. Can also be configured using the `AWS_RETRY_MODE` environment variable.",
+			},
 			"s3_force_path_style": schema.BoolAttribute{
 				Optional:           true,
 				Description:        "Set this to true to enable the request to use path-style addressing,\ni.e., https://s3.amazonaws.com/BUCKET/KEY. By default, the S3 client will\nuse virtual hosted bucket addressing when possible\n(https://BUCKET.s3.amazonaws.com/KEY). Specific to the Amazon S3 service.",
@@ -152,9 +160,7 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 		},
 		Blocks: map[string]schema.Block{
 			"assume_role": schema.ListNestedBlock{
-				Validators: []validator.List{
-					listvalidator.SizeAtMost(1),
-				},
+				Description: "IAM Roles to assume in order. Each IAM Role is assumed using the credentials from the one before it.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"duration": schema.StringAttribute{
@@ -242,6 +248,24 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"audit_log": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings for the API audit log.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"file": schema.StringAttribute{
+							Optional:    true,
+							Description: "Path of the file to which one JSON record per AWS API request is appended. Can also be configured using the `TF_AWS_AUDIT_LOG_FILE` environment variable.",
+						},
+						"include_bodies": schema.BoolAttribute{
+							Optional:    true,
+							Description: "Include request and response bodies in audit log records. Bodies are redacted by default.",
+						},
+					},
+				},
+			},
 			"default_tags": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
@@ -255,9 +279,92 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 							Description: "Resource tags to default across all resources",
 						},
 					},
+					Blocks: map[string]schema.Block{
+						"rule": schema.ListNestedBlock{
+							Description: "Configuration block with settings to add and remove default tags for resource types.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"remove_keys": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Default tag keys to remove for the resource types.",
+									},
+									"resource_types": schema.SetAttribute{
+										ElementType: types.StringType,
+										Required:    true,
+										Description: "Resource types, or glob patterns matching resource types, e.g. `aws_autoscaling_*`, to which the rule applies.",
+									},
+									"tags": schema.MapAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Resource tags to default for the resource types.",
+									},
+								},
+							},
+						},
+						"required_tags": schema.ListNestedBlock{
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							Description: "Configuration block with settings to require resource tags across all resources.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"allowed_values": schema.MapAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Map of required resource tag keys to regular expressions that their whole values must match.",
+									},
+									"exclude_resource_types": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Resource types, or glob patterns matching resource types, that are not required to have the required tags.",
+									},
+									"keys": schema.SetAttribute{
+										ElementType: types.StringType,
+										Required:    true,
+										Description: "Resource tag keys required on all resources.",
+									},
+									"warn_only": schema.BoolAttribute{
+										Optional:    true,
+										Description: "Whether to warn, rather than fail the plan, when a resource does not have the required tags.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"emulator": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to use a local AWS API emulator for all services.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"account_id": schema.StringAttribute{
+							Optional:    true,
+							Description: "The AWS account ID reported by the provider. Defaults to `000000000000`.",
+						},
+						"endpoint": schema.StringAttribute{
+							Required:    true,
+							Description: "The emulator's endpoint, e.g. `http://localhost:4566`.",
+						},
+					},
 				},
 			},
 			"endpoints": endpointsBlock(),
+			"guardrails": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with rules that allow or deny the provider's AWS account by AWS Organizations OU path, account tag or account alias. Evaluated when the provider is configured.",
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"allow": guardrailRulesBlock("Rules, one of which the AWS account must match to be allowed."),
+						"deny":  guardrailRulesBlock("Rules, none of which the AWS account may match."),
+					},
+				},
+			},
 			"ignore_tags": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
@@ -270,6 +377,21 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 							Optional:    true,
 							Description: "Resource tag key prefixes to ignore across all resources.",
 						},
+						"key_patterns": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource tag key glob patterns, e.g. `kubernetes.io/cluster/*`, to ignore across all resources.",
+						},
+						"key_regexes": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Regular expressions matching resource tag keys to ignore across all resources.",
+						},
+						"value_regexes": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Regular expressions matching resource tag values to ignore across all resources.",
+						},
 						"keys": schema.SetAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
@@ -278,6 +400,99 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"rate_limits": schema.ListNestedBlock{
+				Description: "Client-side rate limits for AWS services. Requests are delayed before they are sent so as to stay within the limits.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"burst": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of requests that can be sent at once. Defaults to `requests_per_second`, rounded up.",
+						},
+						"max_concurrent": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of requests that can be in flight at the same time.",
+						},
+						"requests_per_second": schema.Float64Attribute{
+							Optional:    true,
+							Description: "The sustained number of requests per second.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service, e.g. `route53`. Valid values are the keys of the `endpoints` block.",
+						},
+					},
+				},
+			},
+			"retry_overrides": schema.ListNestedBlock{
+				Description: "Retry settings for AWS services that override the provider-level settings.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_backoff": schema.StringAttribute{
+							Optional:    true,
+							Description: "The maximum backoff delay between retries of an AWS API request. Valid time units are ns, us (or µs), ms, s, h, or m.",
+						},
+						"max_retries": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of times an AWS API request is being executed.",
+						},
+						"retry_mode": schema.StringAttribute{
+							Optional:    true,
+							Description: "Specifies how retries are attempted. Valid values are `standard` and `adaptive`.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service, e.g. `ec2`. Valid values are the keys of the `endpoints` block.",
+						},
+					},
+				},
+			},
+			"retryable_errors": schema.ListNestedBlock{
+				Description: "AWS API errors to retry, in addition to those retried by default.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"error_code": schema.StringAttribute{
+							Required:    true,
+							Description: "The AWS API error code, e.g. `InvalidParameterValue`.",
+						},
+						"message_contains": schema.StringAttribute{
+							Optional:    true,
+							Description: "Text that the AWS API error message must contain.",
+						},
+						"operation": schema.StringAttribute{
+							Optional:    true,
+							Description: "The AWS API operation name or a glob pattern that it must match, e.g. `Describe*`. Defaults to all operations.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service, e.g. `ec2`. Valid values are the keys of the `endpoints` block.",
+						},
+					},
+				},
+			},
+			"tracing": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings for OpenTelemetry tracing of resource operations, AWS API calls and waiter polls.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"endpoint": schema.StringAttribute{
+							Optional:    true,
+							Description: "URL of the OTLP/HTTP endpoint to which spans are exported. Can also be configured using the `TF_AWS_TRACING_ENDPOINT` environment variable.",
+						},
+						"file": schema.StringAttribute{
+							Optional:    true,
+							Description: "Path of the file to which one JSON record per span is appended. Can also be configured using the `TF_AWS_TRACING_FILE` environment variable.",
+						},
+						"headers": schema.MapAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "Additional HTTP headers sent to the OTLP/HTTP endpoint, e.g. for authentication.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -313,8 +528,11 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 				continue
 			}
 
+			var metadata datasource.MetadataResponse
+			v.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "aws"}, &metadata)
+
 			dataSources = append(dataSources, func() datasource.DataSource {
-				return newWrappedDataSource(v)
+				return newWrappedDataSource(v, metadata.TypeName)
 			})
 		}
 	}
@@ -331,8 +549,8 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 	var resources []func() resource.Resource
 
 	for _, sp := range p.Primary.Meta().(*conns.AWSClient).ServicePackages {
-		for _, v := range sp.FrameworkResources(ctx) {
-			v, err := v(ctx)
+		for _, factory := range sp.FrameworkResources(ctx) {
+			v, err := factory(ctx)
 
 			if err != nil {
 				tflog.Warn(ctx, "creating resource", map[string]interface{}{
@@ -343,8 +561,20 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 				continue
 			}
 
+			var metadata resource.MetadataResponse
+			v.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "aws"}, &metadata)
+
+			factory := factory
+			regional := newRegionalSchemas(ctx, v)
+
 			resources = append(resources, func() resource.Resource {
-				return newWrappedResource(v)
+				// A new instance of the inner resource is created for each RPC so that it can be
+				// configured with the per-resource Region's provider Meta (instance data).
+				if inner, err := factory(ctx); err == nil {
+					return newWrappedResource(inner, metadata.TypeName, regional)
+				}
+
+				return newWrappedResource(v, metadata.TypeName, regional)
 			})
 		}
 	}
@@ -352,6 +582,31 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 	return resources
 }
 
+func guardrailRulesBlock(description string) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		Description: description,
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"account_alias_patterns": schema.SetAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+					Description: "Glob patterns, e.g. `*-prod`, at least one of which must match one of the AWS account's aliases.",
+				},
+				"account_tags": schema.MapAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+					Description: "Map of tags that the AWS account must have in AWS Organizations. Values are glob patterns.",
+				},
+				"organizational_unit_paths": schema.SetAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+					Description: "AWS Organizations entity paths of OUs, e.g. `o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/`. The AWS account must be in one of the OUs or in an OU nested beneath one of them.",
+				},
+			},
+		},
+	}
+}
+
 func endpointsBlock() schema.SetNestedBlock {
 	endpointsAttributes := make(map[string]schema.Attribute)
 
@@ -371,12 +626,13 @@ func endpointsBlock() schema.SetNestedBlock {
 
 // wrappedDataSource wraps a data source, adding common functionality.
 type wrappedDataSource struct {
-	inner    datasource.DataSourceWithConfigure
-	typeName string
+	inner        datasource.DataSourceWithConfigure
+	resourceType string
+	typeName     string
 }
 
-func newWrappedDataSource(inner datasource.DataSourceWithConfigure) datasource.DataSourceWithConfigure {
-	return &wrappedDataSource{inner: inner, typeName: strings.TrimPrefix(reflect.TypeOf(inner).String(), "*")}
+func newWrappedDataSource(inner datasource.DataSourceWithConfigure, resourceType string) datasource.DataSourceWithConfigure {
+	return &wrappedDataSource{inner: inner, resourceType: resourceType, typeName: strings.TrimPrefix(reflect.TypeOf(inner).String(), "*")}
 }
 
 func (w *wrappedDataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
@@ -388,6 +644,8 @@ func (w *wrappedDataSource) Schema(ctx context.Context, request datasource.Schem
 }
 
 func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	ctx = conns.NewResourceContext(ctx, w.resourceType, "")
+
 	tflog.Debug(ctx, fmt.Sprintf("%s.Read enter", w.typeName))
 
 	w.inner.Read(ctx, request, response)
@@ -401,13 +659,20 @@ func (w *wrappedDataSource) Configure(ctx context.Context, request datasource.Co
 
 // wrappedResource wraps a resource, adding common functionality.
 type wrappedResource struct {
-	inner    resource.ResourceWithConfigure
-	meta     *conns.AWSClient
-	typeName string
+	inner        resource.ResourceWithConfigure
+	meta         *conns.AWSClient
+	regional     *regionalSchemas
+	resourceType string
+	typeName     string
 }
 
-func newWrappedResource(inner resource.ResourceWithConfigure) resource.ResourceWithConfigure {
-	return &wrappedResource{inner: inner, typeName: strings.TrimPrefix(reflect.TypeOf(inner).String(), "*")}
+func newWrappedResource(inner resource.ResourceWithConfigure, resourceType string, regional *regionalSchemas) resource.ResourceWithConfigure {
+	return &wrappedResource{inner: inner, regional: regional, resourceType: resourceType, typeName: strings.TrimPrefix(reflect.TypeOf(inner).String(), "*")}
+}
+
+// initContext returns the context for an operation on the wrapped resource.
+func (w *wrappedResource) initContext(ctx context.Context) context.Context {
+	return conns.NewResourceContext(w.meta.InitContext(ctx), w.resourceType, "")
 }
 
 func (w *wrappedResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -415,55 +680,111 @@ func (w *wrappedResource) Metadata(ctx context.Context, request resource.Metadat
 }
 
 func (w *wrappedResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	if w.regional != nil {
+		response.Schema = w.regional.outer
+
+		return
+	}
+
 	w.inner.Schema(ctx, request, response)
 }
 
 func (w *wrappedResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	if w.meta != nil {
-		ctx = w.meta.InitContext(ctx)
+		ctx = w.initContext(ctx)
 	}
 
+	ctx, span := w.startSpan(ctx, "Create")
+	ctx = w.newProgressContext(ctx, "Create")
+	ctx = newInFlightOperationContext(ctx, nil)
+
 	tflog.Debug(ctx, fmt.Sprintf("%s.Create enter", w.typeName))
 
-	w.inner.Create(ctx, request, response)
+	if w.regional != nil {
+		w.regionalCreate(ctx, request, response)
+	} else {
+		w.inner.Create(ctx, request, response)
+	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s.Create exit", w.typeName))
+
+	if response.Private != nil {
+		setInFlightOperationPrivateState(ctx, response.Private.SetKey, &response.Diagnostics)
+	}
+	addProgressWarnings(ctx, &response.Diagnostics)
+	endSpan(span, response.Diagnostics)
 }
 
 func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
 	if w.meta != nil {
-		ctx = w.meta.InitContext(ctx)
+		ctx = w.initContext(ctx)
 	}
 
+	ctx, span := w.startSpan(ctx, "Read")
+	ctx = newInFlightOperationContext(ctx, request.Private.GetKey)
+
 	tflog.Debug(ctx, fmt.Sprintf("%s.Read enter", w.typeName))
 
-	w.inner.Read(ctx, request, response)
+	if w.regional != nil {
+		w.regionalRead(ctx, request, response)
+	} else {
+		w.inner.Read(ctx, request, response)
+	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s.Read exit", w.typeName))
+
+	if response.Private != nil {
+		setInFlightOperationPrivateState(ctx, response.Private.SetKey, &response.Diagnostics)
+	}
+	endSpan(span, response.Diagnostics)
 }
 
 func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
 	if w.meta != nil {
-		ctx = w.meta.InitContext(ctx)
+		ctx = w.initContext(ctx)
 	}
 
+	ctx, span := w.startSpan(ctx, "Update")
+	ctx = w.newProgressContext(ctx, "Update")
+	ctx = newInFlightOperationContext(ctx, request.Private.GetKey)
+
 	tflog.Debug(ctx, fmt.Sprintf("%s.Update enter", w.typeName))
 
-	w.inner.Update(ctx, request, response)
+	if w.regional != nil {
+		w.regionalUpdate(ctx, request, response)
+	} else {
+		w.inner.Update(ctx, request, response)
+	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s.Update exit", w.typeName))
+
+	if response.Private != nil {
+		setInFlightOperationPrivateState(ctx, response.Private.SetKey, &response.Diagnostics)
+	}
+	addProgressWarnings(ctx, &response.Diagnostics)
+	endSpan(span, response.Diagnostics)
 }
 
 func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
 	if w.meta != nil {
-		ctx = w.meta.InitContext(ctx)
+		ctx = w.initContext(ctx)
 	}
 
+	ctx, span := w.startSpan(ctx, "Delete")
+	ctx = w.newProgressContext(ctx, "Delete")
+
 	tflog.Debug(ctx, fmt.Sprintf("%s.Delete enter", w.typeName))
 
-	w.inner.Delete(ctx, request, response)
+	if w.regional != nil {
+		w.regionalDelete(ctx, request, response)
+	} else {
+		w.inner.Delete(ctx, request, response)
+	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s.Delete exit", w.typeName))
+
+	addProgressWarnings(ctx, &response.Diagnostics)
+	endSpan(span, response.Diagnostics)
 }
 
 func (w *wrappedResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
@@ -477,10 +798,14 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
 		if w.meta != nil {
-			ctx = w.meta.InitContext(ctx)
+			ctx = w.initContext(ctx)
 		}
 
-		v.ImportState(ctx, request, response)
+		if w.regional != nil {
+			w.regionalImportState(ctx, v, request, response)
+		} else {
+			v.ImportState(ctx, request, response)
+		}
 
 		return
 	}
@@ -492,9 +817,19 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 }
 
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if w.regional != nil {
+		if w.meta != nil {
+			ctx = w.initContext(ctx)
+		}
+
+		w.regionalModifyPlan(ctx, request, response)
+
+		return
+	}
+
 	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
 		if w.meta != nil {
-			ctx = w.meta.InitContext(ctx)
+			ctx = w.initContext(ctx)
 		}
 
 		v.ModifyPlan(ctx, request, response)
@@ -514,9 +849,13 @@ func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.Confi
 func (w *wrappedResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
 	if v, ok := w.inner.(resource.ResourceWithValidateConfig); ok {
 		if w.meta != nil {
-			ctx = w.meta.InitContext(ctx)
+			ctx = w.initContext(ctx)
 		}
 
-		v.ValidateConfig(ctx, request, response)
+		if w.regional != nil {
+			w.regionalValidateConfig(ctx, v, request, response)
+		} else {
+			v.ValidateConfig(ctx, request, response)
+		}
 	}
 }
@@ -0,0 +1,492 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Per-resource Region override.
+// Resources that don't define their own top-level "region" attribute are given an optional "region" argument.
+// The wrapped (inner) resource is unaware of the additional attribute: it is removed from
+// configuration, plan and state values before they are passed to the inner resource and
+// added back to the values it returns.
+
+const importIDRegionSeparator = "@"
+
+// regionalSchemas holds the inner resource's schema and the schema with the "region" attribute added.
+type regionalSchemas struct {
+	inner schema.Schema
+	outer schema.Schema
+}
+
+// newRegionalSchemas returns the schemas for a resource that supports the per-resource Region override.
+// Returns nil if the resource already defines a top-level "region" attribute or block.
+func newRegionalSchemas(ctx context.Context, r resource.Resource) *regionalSchemas {
+	var response resource.SchemaResponse
+
+	r.Schema(ctx, resource.SchemaRequest{}, &response)
+
+	if response.Diagnostics.HasError() {
+		return nil
+	}
+
+	inner := response.Schema
+
+	if _, ok := inner.Attributes[names.AttrRegion]; ok {
+		return nil
+	}
+
+	if _, ok := inner.Blocks[names.AttrRegion]; ok {
+		return nil
+	}
+
+	outer := inner
+	outer.Attributes = make(map[string]schema.Attribute, len(inner.Attributes)+1)
+	for k, v := range inner.Attributes {
+		outer.Attributes[k] = v
+	}
+	outer.Attributes[names.AttrRegion] = schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+			stringplanmodifier.RequiresReplace(),
+		},
+		Description: "The Region in which the resource is managed. Defaults to the Region set in the provider configuration.",
+	}
+
+	return &regionalSchemas{
+		inner: inner,
+		outer: outer,
+	}
+}
+
+// toInner removes the "region" attribute from the specified object value, returning the attribute's value.
+func (s *regionalSchemas) toInner(ctx context.Context, raw tftypes.Value) (tftypes.Value, tftypes.Value, error) {
+	region := tftypes.NewValue(tftypes.String, nil)
+
+	// Zero value, e.g. a Plan during Delete.
+	if raw.Type() == nil {
+		return raw, region, nil
+	}
+
+	typ := s.inner.Type().TerraformType(ctx)
+
+	if raw.IsNull() {
+		return tftypes.NewValue(typ, nil), region, nil
+	}
+
+	if !raw.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), tftypes.NewValue(tftypes.String, tftypes.UnknownValue), nil
+	}
+
+	var attributes map[string]tftypes.Value
+
+	if err := raw.As(&attributes); err != nil {
+		return tftypes.Value{}, region, err
+	}
+
+	if v, ok := attributes[names.AttrRegion]; ok {
+		region = v
+		delete(attributes, names.AttrRegion)
+	}
+
+	return tftypes.NewValue(typ, attributes), region, nil
+}
+
+// toOuter adds the "region" attribute to the specified object value.
+func (s *regionalSchemas) toOuter(ctx context.Context, raw tftypes.Value, region tftypes.Value) (tftypes.Value, error) {
+	if raw.Type() == nil {
+		return raw, nil
+	}
+
+	typ := s.outer.Type().TerraformType(ctx)
+
+	if raw.IsNull() {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	if !raw.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	var attributes map[string]tftypes.Value
+
+	if err := raw.As(&attributes); err != nil {
+		return tftypes.Value{}, err
+	}
+
+	attributes[names.AttrRegion] = region
+
+	return tftypes.NewValue(typ, attributes), nil
+}
+
+func (s *regionalSchemas) innerConfig(ctx context.Context, config tfsdk.Config) (tfsdk.Config, tftypes.Value, error) {
+	raw, region, err := s.toInner(ctx, config.Raw)
+
+	return tfsdk.Config{Raw: raw, Schema: s.inner}, region, err
+}
+
+func (s *regionalSchemas) innerPlan(ctx context.Context, plan tfsdk.Plan) (tfsdk.Plan, tftypes.Value, error) {
+	raw, region, err := s.toInner(ctx, plan.Raw)
+
+	return tfsdk.Plan{Raw: raw, Schema: s.inner}, region, err
+}
+
+func (s *regionalSchemas) innerState(ctx context.Context, state tfsdk.State) (tfsdk.State, tftypes.Value, error) {
+	raw, region, err := s.toInner(ctx, state.Raw)
+
+	return tfsdk.State{Raw: raw, Schema: s.inner}, region, err
+}
+
+func (s *regionalSchemas) outerPlan(ctx context.Context, plan tfsdk.Plan, region tftypes.Value) (tfsdk.Plan, error) {
+	raw, err := s.toOuter(ctx, plan.Raw, region)
+
+	return tfsdk.Plan{Raw: raw, Schema: s.outer}, err
+}
+
+func (s *regionalSchemas) outerState(ctx context.Context, state tfsdk.State, region tftypes.Value) (tfsdk.State, error) {
+	raw, err := s.toOuter(ctx, state.Raw, region)
+
+	return tfsdk.State{Raw: raw, Schema: s.outer}, err
+}
+
+// regionValue returns the string value of a "region" attribute value.
+func regionValue(v tftypes.Value) string {
+	var region string
+
+	if v.IsKnown() && !v.IsNull() {
+		_ = v.As(&region)
+	}
+
+	return region
+}
+
+// importIDRegion splits an import ID of the form "<id>@<region>" into its ID and Region parts.
+func importIDRegion(id string) (string, string, bool) {
+	i := strings.LastIndex(id, importIDRegionSeparator)
+
+	if i < 0 {
+		return id, "", false
+	}
+
+	region := id[i+len(importIDRegionSeparator):]
+
+	if _, errs := verify.ValidRegionName(region, names.AttrRegion); region == "" || len(errs) > 0 {
+		return id, "", false
+	}
+
+	return id[:i], region, true
+}
+
+// configureRegion configures the inner resource with the provider Meta (instance data) for the specified Region.
+// Returns the provider Meta used.
+func (w *wrappedResource) configureRegion(ctx context.Context, region string) (*conns.AWSClient, error) {
+	if w.meta == nil {
+		return nil, nil
+	}
+
+	meta, err := w.meta.RegionalClient(ctx, region)
+
+	if err != nil {
+		return nil, fmt.Errorf("configuring Region (%s): %w", region, err)
+	}
+
+	if meta != w.meta {
+		var response resource.ConfigureResponse
+
+		w.inner.Configure(ctx, resource.ConfigureRequest{ProviderData: meta}, &response)
+
+		if response.Diagnostics.HasError() {
+			return nil, fmt.Errorf("configuring Region (%s)", region)
+		}
+	}
+
+	return meta, nil
+}
+
+// effectiveRegion returns the "region" attribute value for the specified provider Meta (instance data).
+func effectiveRegion(meta *conns.AWSClient, region tftypes.Value) tftypes.Value {
+	if meta == nil {
+		return region
+	}
+
+	return tftypes.NewValue(tftypes.String, meta.Region)
+}
+
+func (w *wrappedResource) regionalCreate(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var err error
+	var region tftypes.Value
+	innerRequest := request
+	innerResponse := &resource.CreateResponse{Private: response.Private}
+
+	if innerRequest.Config, _, err = w.regional.innerConfig(ctx, request.Config); err != nil {
+		response.Diagnostics.AddError("Create", err.Error())
+		return
+	}
+	if innerRequest.Plan, region, err = w.regional.innerPlan(ctx, request.Plan); err != nil {
+		response.Diagnostics.AddError("Create", err.Error())
+		return
+	}
+	if innerResponse.State, _, err = w.regional.innerState(ctx, response.State); err != nil {
+		response.Diagnostics.AddError("Create", err.Error())
+		return
+	}
+
+	meta, err := w.configureRegion(ctx, regionValue(region))
+
+	if err != nil {
+		response.Diagnostics.AddError("Create", err.Error())
+		return
+	}
+
+	w.inner.Create(ctx, innerRequest, innerResponse)
+
+	response.Diagnostics.Append(innerResponse.Diagnostics...)
+	response.Private = innerResponse.Private
+
+	if response.State, err = w.regional.outerState(ctx, innerResponse.State, effectiveRegion(meta, region)); err != nil {
+		response.Diagnostics.AddError("Create", err.Error())
+	}
+}
+
+func (w *wrappedResource) regionalRead(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var err error
+	var region tftypes.Value
+	innerRequest := request
+	innerResponse := &resource.ReadResponse{Private: response.Private}
+
+	if innerRequest.State, region, err = w.regional.innerState(ctx, request.State); err != nil {
+		response.Diagnostics.AddError("Read", err.Error())
+		return
+	}
+	if innerResponse.State, _, err = w.regional.innerState(ctx, response.State); err != nil {
+		response.Diagnostics.AddError("Read", err.Error())
+		return
+	}
+
+	meta, err := w.configureRegion(ctx, regionValue(region))
+
+	if err != nil {
+		response.Diagnostics.AddError("Read", err.Error())
+		return
+	}
+
+	w.inner.Read(ctx, innerRequest, innerResponse)
+
+	response.Diagnostics.Append(innerResponse.Diagnostics...)
+	response.Private = innerResponse.Private
+
+	if response.State, err = w.regional.outerState(ctx, innerResponse.State, effectiveRegion(meta, region)); err != nil {
+		response.Diagnostics.AddError("Read", err.Error())
+	}
+}
+
+func (w *wrappedResource) regionalUpdate(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var err error
+	var region tftypes.Value
+	innerRequest := request
+	innerResponse := &resource.UpdateResponse{Private: response.Private}
+
+	if innerRequest.Config, _, err = w.regional.innerConfig(ctx, request.Config); err != nil {
+		response.Diagnostics.AddError("Update", err.Error())
+		return
+	}
+	if innerRequest.Plan, region, err = w.regional.innerPlan(ctx, request.Plan); err != nil {
+		response.Diagnostics.AddError("Update", err.Error())
+		return
+	}
+	if innerRequest.State, _, err = w.regional.innerState(ctx, request.State); err != nil {
+		response.Diagnostics.AddError("Update", err.Error())
+		return
+	}
+	if innerResponse.State, _, err = w.regional.innerState(ctx, response.State); err != nil {
+		response.Diagnostics.AddError("Update", err.Error())
+		return
+	}
+
+	meta, err := w.configureRegion(ctx, regionValue(region))
+
+	if err != nil {
+		response.Diagnostics.AddError("Update", err.Error())
+		return
+	}
+
+	w.inner.Update(ctx, innerRequest, innerResponse)
+
+	response.Diagnostics.Append(innerResponse.Diagnostics...)
+	response.Private = innerResponse.Private
+
+	if response.State, err = w.regional.outerState(ctx, innerResponse.State, effectiveRegion(meta, region)); err != nil {
+		response.Diagnostics.AddError("Update", err.Error())
+	}
+}
+
+func (w *wrappedResource) regionalDelete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var err error
+	var region tftypes.Value
+	innerRequest := request
+	innerResponse := &resource.DeleteResponse{}
+
+	if innerRequest.State, region, err = w.regional.innerState(ctx, request.State); err != nil {
+		response.Diagnostics.AddError("Delete", err.Error())
+		return
+	}
+	if innerResponse.State, _, err = w.regional.innerState(ctx, response.State); err != nil {
+		response.Diagnostics.AddError("Delete", err.Error())
+		return
+	}
+
+	if _, err := w.configureRegion(ctx, regionValue(region)); err != nil {
+		response.Diagnostics.AddError("Delete", err.Error())
+		return
+	}
+
+	w.inner.Delete(ctx, innerRequest, innerResponse)
+
+	response.Diagnostics.Append(innerResponse.Diagnostics...)
+
+	if response.State, err = w.regional.outerState(ctx, innerResponse.State, region); err != nil {
+		response.Diagnostics.AddError("Delete", err.Error())
+	}
+}
+
+func (w *wrappedResource) regionalImportState(ctx context.Context, inner resource.ResourceWithImportState, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	var err error
+	var region string
+	innerRequest := request
+	innerResponse := &resource.ImportStateResponse{Private: response.Private}
+
+	if id, v, ok := importIDRegion(request.ID); ok {
+		innerRequest.ID = id
+		region = v
+	}
+
+	if innerResponse.State, _, err = w.regional.innerState(ctx, response.State); err != nil {
+		response.Diagnostics.AddError("ImportState", err.Error())
+		return
+	}
+
+	meta, err := w.configureRegion(ctx, region)
+
+	if err != nil {
+		response.Diagnostics.AddError("ImportState", err.Error())
+		return
+	}
+
+	inner.ImportState(ctx, innerRequest, innerResponse)
+
+	response.Diagnostics.Append(innerResponse.Diagnostics...)
+	response.Private = innerResponse.Private
+
+	if response.State, err = w.regional.outerState(ctx, innerResponse.State, effectiveRegion(meta, tftypes.NewValue(tftypes.String, nil))); err != nil {
+		response.Diagnostics.AddError("ImportState", err.Error())
+	}
+}
+
+func (w *wrappedResource) regionalModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// If the entire plan is null, the resource is planned for destruction.
+	if request.Plan.Raw.IsNull() {
+		if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
+			innerRequest := request
+			innerResponse := &resource.ModifyPlanResponse{Private: response.Private}
+			var err error
+
+			if innerRequest.Config, _, err = w.regional.innerConfig(ctx, request.Config); err != nil {
+				response.Diagnostics.AddError("ModifyPlan", err.Error())
+				return
+			}
+			if innerRequest.Plan, _, err = w.regional.innerPlan(ctx, request.Plan); err != nil {
+				response.Diagnostics.AddError("ModifyPlan", err.Error())
+				return
+			}
+			if innerRequest.State, _, err = w.regional.innerState(ctx, request.State); err != nil {
+				response.Diagnostics.AddError("ModifyPlan", err.Error())
+				return
+			}
+			innerResponse.Plan = innerRequest.Plan
+
+			v.ModifyPlan(ctx, innerRequest, innerResponse)
+
+			response.Diagnostics.Append(innerResponse.Diagnostics...)
+			response.Private = innerResponse.Private
+		}
+
+		return
+	}
+
+	var err error
+	var configRegion, planRegion, stateRegion tftypes.Value
+	innerRequest := request
+
+	if innerRequest.Config, configRegion, err = w.regional.innerConfig(ctx, request.Config); err != nil {
+		response.Diagnostics.AddError("ModifyPlan", err.Error())
+		return
+	}
+	if innerRequest.Plan, planRegion, err = w.regional.innerPlan(ctx, request.Plan); err != nil {
+		response.Diagnostics.AddError("ModifyPlan", err.Error())
+		return
+	}
+	if innerRequest.State, stateRegion, err = w.regional.innerState(ctx, request.State); err != nil {
+		response.Diagnostics.AddError("ModifyPlan", err.Error())
+		return
+	}
+
+	// A resource without a configured Region follows the provider's Region,
+	// so changing the provider's Region replaces the resource.
+	if configRegion.IsNull() && w.meta != nil {
+		planRegion = tftypes.NewValue(tftypes.String, w.meta.Region)
+
+		if !stateRegion.IsNull() && !stateRegion.Equal(planRegion) {
+			response.RequiresReplace = append(response.RequiresReplace, path.Root(names.AttrRegion))
+		}
+	}
+
+	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
+		innerResponse := &resource.ModifyPlanResponse{
+			Plan:            innerRequest.Plan,
+			Private:         response.Private,
+			RequiresReplace: response.RequiresReplace,
+		}
+
+		if _, err := w.configureRegion(ctx, regionValue(planRegion)); err != nil {
+			response.Diagnostics.AddError("ModifyPlan", err.Error())
+			return
+		}
+
+		v.ModifyPlan(ctx, innerRequest, innerResponse)
+
+		response.Diagnostics.Append(innerResponse.Diagnostics...)
+		response.Private = innerResponse.Private
+		response.RequiresReplace = innerResponse.RequiresReplace
+		innerRequest.Plan = innerResponse.Plan
+	}
+
+	if response.Plan, err = w.regional.outerPlan(ctx, innerRequest.Plan, planRegion); err != nil {
+		response.Diagnostics.AddError("ModifyPlan", err.Error())
+	}
+}
+
+func (w *wrappedResource) regionalValidateConfig(ctx context.Context, inner resource.ResourceWithValidateConfig, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var err error
+	innerRequest := request
+
+	if innerRequest.Config, _, err = w.regional.innerConfig(ctx, request.Config); err != nil {
+		response.Diagnostics.AddError("ValidateConfig", err.Error())
+		return
+	}
+
+	inner.ValidateConfig(ctx, innerRequest, response)
+}
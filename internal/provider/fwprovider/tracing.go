@@ -0,0 +1,37 @@
+package fwprovider
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// startSpan starts the span for an operation on the wrapped resource.
+// No span is emitted unless tracing is enabled for the provider.
+func (w *wrappedResource) startSpan(ctx context.Context, operation string) (context.Context, trace.Span) {
+	return tracing.Start(ctx, w.resourceType+"."+operation,
+		tracing.AttrTFOperation.String(operation),
+		tracing.AttrTFResourceType.String(w.resourceType),
+	)
+}
+
+// endSpan ends the span for an operation on the wrapped resource, recording any error diagnostics.
+func endSpan(span trace.Span, diags diag.Diagnostics) {
+	var err error
+
+	if diags.HasError() {
+		var summaries []string
+
+		for _, d := range diags.Errors() {
+			summaries = append(summaries, d.Summary())
+		}
+
+		err = errors.New(strings.Join(summaries, "; "))
+	}
+
+	tracing.End(span, err)
+}
@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// Interceptors.
+// Each operation on a Plugin SDK resource or data source runs through a chain of interceptors.
+// An interceptor adds one concern, e.g. tracing, to every operation. It calls the rest of the chain,
+// possibly with a different Context or provider Meta (instance data), the last link being the resource's own function.
+
+// Intercepted operations.
+const (
+	operationCreate        = "Create"
+	operationCustomizeDiff = "CustomizeDiff"
+	operationDelete        = "Delete"
+	operationImport        = "Import"
+	operationRead          = "Read"
+	operationStateUpgrade  = "StateUpgrade"
+	operationUpdate        = "Update"
+)
+
+// resourceData is the data of the resource being operated on.
+// It is implemented by schema.ResourceData, schema.ResourceDiff and rawStateData.
+type resourceData interface {
+	Get(key string) any
+	Id() string
+}
+
+// rawStateData is the raw state passed to a state upgrader.
+type rawStateData map[string]any
+
+func (s rawStateData) Get(key string) any {
+	return s[key]
+}
+
+func (s rawStateData) Id() string {
+	id, _ := s["id"].(string)
+
+	return id
+}
+
+// invocation describes an intercepted operation.
+type invocation struct {
+	d         resourceData
+	operation string
+	typeName  string
+}
+
+// next calls the rest of an interceptor chain.
+type next func(ctx context.Context, meta any) diag.Diagnostics
+
+// interceptor adds a concern to an operation. It must call next to continue the operation.
+type interceptor func(ctx context.Context, inv invocation, meta any, next next) diag.Diagnostics
+
+type interceptors []interceptor
+
+// run runs the specified function through the interceptors, the first interceptor being the outermost.
+func (s interceptors) run(ctx context.Context, inv invocation, meta any, f next) diag.Diagnostics {
+	if len(s) == 0 {
+		return f(ctx, meta)
+	}
+
+	return s[0](ctx, inv, meta, func(ctx context.Context, meta any) diag.Diagnostics {
+		return s[1:].run(ctx, inv, meta, f)
+	})
+}
+
+// initContextInterceptor initializes the Context for the provider instance.
+func initContextInterceptor(ctx context.Context, inv invocation, meta any, next next) diag.Diagnostics {
+	if v, ok := meta.(*conns.AWSClient); ok {
+		ctx = v.InitContext(ctx)
+	}
+
+	return next(ctx, meta)
+}
+
+// resourceContextInterceptor identifies the resource to the AWS API clients and to tagging.
+func resourceContextInterceptor(ctx context.Context, inv invocation, meta any, next next) diag.Diagnostics {
+	if inv.operation == operationStateUpgrade {
+		return next(ctx, meta)
+	}
+
+	return next(conns.NewResourceContext(ctx, inv.typeName, inv.d.Id()), meta)
+}
+
+// interceptedDataSource runs the specified data source's Read through the interceptors.
+func interceptedDataSource(typeName string, ds *schema.Resource, interceptors interceptors) {
+	if v := ds.ReadWithoutTimeout; v != nil {
+		ds.ReadWithoutTimeout = wrappedReadContextFunc(typeName, interceptors, v)
+	}
+}
+
+// interceptedResource runs the specified resource's operations through the interceptors.
+// A resource without a CustomizeDiff is given one, so that interceptors can customize its plans.
+func interceptedResource(typeName string, r *schema.Resource, interceptors interceptors) {
+	if v := r.CreateWithoutTimeout; v != nil {
+		r.CreateWithoutTimeout = wrappedCreateContextFunc(typeName, interceptors, v)
+	}
+	if v := r.ReadWithoutTimeout; v != nil {
+		r.ReadWithoutTimeout = wrappedReadContextFunc(typeName, interceptors, v)
+	}
+	if v := r.UpdateWithoutTimeout; v != nil {
+		r.UpdateWithoutTimeout = wrappedUpdateContextFunc(typeName, interceptors, v)
+	}
+	if v := r.DeleteWithoutTimeout; v != nil {
+		r.DeleteWithoutTimeout = wrappedDeleteContextFunc(typeName, interceptors, v)
+	}
+	if v := r.Importer; v != nil {
+		if v := v.StateContext; v != nil {
+			r.Importer.StateContext = wrappedStateContextFunc(typeName, interceptors, v)
+		}
+	}
+	if v := r.CustomizeDiff; v != nil {
+		r.CustomizeDiff = wrappedCustomizeDiffFunc(typeName, interceptors, v)
+	} else if r.Schema != nil {
+		r.CustomizeDiff = wrappedCustomizeDiffFunc(typeName, interceptors, func(context.Context, *schema.ResourceDiff, any) error {
+			return nil
+		})
+	}
+	for i, stateUpgrader := range r.StateUpgraders {
+		if v := stateUpgrader.Upgrade; v != nil {
+			r.StateUpgraders[i].Upgrade = wrappedStateUpgradeFunc(typeName, interceptors, v)
+		}
+	}
+}
+
+func wrappedCreateContextFunc(typeName string, interceptors interceptors, f schema.CreateContextFunc) schema.CreateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		return interceptors.run(ctx, invocation{d: d, operation: operationCreate, typeName: typeName}, meta, func(ctx context.Context, meta any) diag.Diagnostics {
+			return f(ctx, d, meta)
+		})
+	}
+}
+
+func wrappedReadContextFunc(typeName string, interceptors interceptors, f schema.ReadContextFunc) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		return interceptors.run(ctx, invocation{d: d, operation: operationRead, typeName: typeName}, meta, func(ctx context.Context, meta any) diag.Diagnostics {
+			return f(ctx, d, meta)
+		})
+	}
+}
+
+func wrappedUpdateContextFunc(typeName string, interceptors interceptors, f schema.UpdateContextFunc) schema.UpdateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		return interceptors.run(ctx, invocation{d: d, operation: operationUpdate, typeName: typeName}, meta, func(ctx context.Context, meta any) diag.Diagnostics {
+			return f(ctx, d, meta)
+		})
+	}
+}
+
+func wrappedDeleteContextFunc(typeName string, interceptors interceptors, f schema.DeleteContextFunc) schema.DeleteContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		return interceptors.run(ctx, invocation{d: d, operation: operationDelete, typeName: typeName}, meta, func(ctx context.Context, meta any) diag.Diagnostics {
+			return f(ctx, d, meta)
+		})
+	}
+}
+
+func wrappedStateContextFunc(typeName string, interceptors interceptors, f schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		var result []*schema.ResourceData
+		var err error
+
+		diags := interceptors.run(ctx, invocation{d: d, operation: operationImport, typeName: typeName}, meta, func(ctx context.Context, meta any) diag.Diagnostics {
+			result, err = f(ctx, d, meta)
+
+			return nil
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		if err := sdkdiag.DiagnosticsError(diags); err != nil {
+			return nil, err
+		}
+
+		return result, nil
+	}
+}
+
+func wrappedCustomizeDiffFunc(typeName string, interceptors interceptors, f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+		var err error
+
+		diags := interceptors.run(ctx, invocation{d: d, operation: operationCustomizeDiff, typeName: typeName}, meta, func(ctx context.Context, meta any) diag.Diagnostics {
+			err = f(ctx, d, meta)
+
+			return nil
+		})
+
+		if err != nil {
+			return err
+		}
+
+		return sdkdiag.DiagnosticsError(diags)
+	}
+}
+
+func wrappedStateUpgradeFunc(typeName string, interceptors interceptors, f schema.StateUpgradeFunc) schema.StateUpgradeFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta any) (map[string]interface{}, error) {
+		var result map[string]interface{}
+		var err error
+
+		diags := interceptors.run(ctx, invocation{d: rawStateData(rawState), operation: operationStateUpgrade, typeName: typeName}, meta, func(ctx context.Context, meta any) diag.Diagnostics {
+			result, err = f(ctx, rawState, meta)
+
+			return nil
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		if err := sdkdiag.DiagnosticsError(diags); err != nil {
+			return nil, err
+		}
+
+		return result, nil
+	}
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestInterceptedResource(t *testing.T) {
+	t.Parallel()
+
+	var calls []string
+
+	recorder := func(name string) interceptor {
+		return func(ctx context.Context, inv invocation, meta any, next next) diag.Diagnostics {
+			calls = append(calls, name+" before "+inv.operation)
+			diags := next(ctx, meta.(string)+"+"+name)
+			calls = append(calls, name+" after "+inv.operation)
+			return diags
+		}
+	}
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+		CreateWithoutTimeout: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			calls = append(calls, "Create "+meta.(string))
+			return nil
+		},
+	}
+
+	interceptedResource("aws_test", r, interceptors{recorder("outer"), recorder("inner")})
+
+	if r.CustomizeDiff == nil {
+		t.Error("expected CustomizeDiff to be set")
+	}
+
+	if diags := r.CreateWithoutTimeout(context.Background(), r.TestResourceData(), "meta"); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	want := []string{
+		"outer before Create",
+		"inner before Create",
+		"Create meta+outer+inner",
+		"inner after Create",
+		"outer after Create",
+	}
+
+	if diff := cmp.Diff(calls, want); diff != "" {
+		t.Errorf("unexpected calls (+want, -got): %s", diff)
+	}
+}
@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// inFlightOperationsStopServer interrupts waits for the in-flight operations of all resources,
+// Plugin SDK and Terraform Plugin Framework alike, when Terraform stops the provider.
+type inFlightOperationsStopServer struct {
+	tfprotov5.ProviderServer
+
+	stopOnce sync.Once
+	stop     chan struct{}
+}
+
+func newInFlightOperationsStopServer(server tfprotov5.ProviderServer) tfprotov5.ProviderServer {
+	return &inFlightOperationsStopServer{
+		ProviderServer: server,
+		stop:           make(chan struct{}),
+	}
+}
+
+func (s *inFlightOperationsStopServer) ApplyResourceChange(ctx context.Context, request *tfprotov5.ApplyResourceChangeRequest) (*tfprotov5.ApplyResourceChangeResponse, error) {
+	return s.ProviderServer.ApplyResourceChange(tfresource.NewInFlightOperationsStopContext(ctx, s.stop), request)
+}
+
+func (s *inFlightOperationsStopServer) ReadResource(ctx context.Context, request *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
+	return s.ProviderServer.ReadResource(tfresource.NewInFlightOperationsStopContext(ctx, s.stop), request)
+}
+
+// StopProvider interrupts waits for in-flight operations and exports any buffered trace spans before stopping the provider.
+func (s *inFlightOperationsStopServer) StopProvider(ctx context.Context, request *tfprotov5.StopProviderRequest) (*tfprotov5.StopProviderResponse, error) {
+	s.stopOnce.Do(func() {
+		close(s.stop)
+	})
+
+	conns.FlushTracing()
+
+	return s.ProviderServer.StopProvider(ctx, request)
+}
+
+// inFlightOperationsServer keeps the in-flight operations of Plugin SDK resources in their private state.
+// The Plugin SDK keeps a resource's private state as a JSON object, to which the in-flight operation is added.
+// Terraform Plugin Framework resources keep their in-flight operations in their private state themselves.
+type inFlightOperationsServer struct {
+	tfprotov5.ProviderServer
+}
+
+func newInFlightOperationsServer(server tfprotov5.ProviderServer) tfprotov5.ProviderServer {
+	return &inFlightOperationsServer{
+		ProviderServer: server,
+	}
+}
+
+// PlanResourceChange carries any in-flight operation from the prior private state to the planned private state,
+// which the Plugin SDK rebuilds from the planned diff.
+func (s *inFlightOperationsServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	response, err := s.ProviderServer.PlanResourceChange(ctx, request)
+
+	if err != nil || response == nil {
+		return response, err
+	}
+
+	if operation := inFlightOperationFromPrivateState(request.PriorPrivate); operation != nil {
+		ctx = tfresource.NewInFlightOperationContext(ctx, operation)
+		response.PlannedPrivate = setInFlightOperationPrivateState(ctx, response.PlannedPrivate)
+	}
+
+	return response, nil
+}
+
+func (s *inFlightOperationsServer) ApplyResourceChange(ctx context.Context, request *tfprotov5.ApplyResourceChangeRequest) (*tfprotov5.ApplyResourceChangeResponse, error) {
+	ctx = tfresource.NewInFlightOperationContext(ctx, inFlightOperationFromPrivateState(request.PlannedPrivate))
+
+	response, err := s.ProviderServer.ApplyResourceChange(ctx, request)
+
+	if err != nil || response == nil {
+		return response, err
+	}
+
+	response.Private = setInFlightOperationPrivateState(ctx, response.Private)
+
+	return response, nil
+}
+
+func (s *inFlightOperationsServer) ReadResource(ctx context.Context, request *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
+	ctx = tfresource.NewInFlightOperationContext(ctx, inFlightOperationFromPrivateState(request.Private))
+
+	response, err := s.ProviderServer.ReadResource(ctx, request)
+
+	if err != nil || response == nil {
+		return response, err
+	}
+
+	response.Private = setInFlightOperationPrivateState(ctx, response.Private)
+
+	return response, nil
+}
+
+// inFlightOperationFromPrivateState returns the in-flight operation in the specified Plugin SDK private state, if any.
+func inFlightOperationFromPrivateState(private []byte) *tfresource.InFlightOperation {
+	if len(private) == 0 {
+		return nil
+	}
+
+	var v map[string]json.RawMessage
+
+	if err := json.Unmarshal(private, &v); err != nil {
+		return nil
+	}
+
+	raw, ok := v[tfresource.InFlightOperationPrivateStateKey]
+
+	if !ok {
+		return nil
+	}
+
+	var operation tfresource.InFlightOperation
+
+	if err := json.Unmarshal(raw, &operation); err != nil {
+		return nil
+	}
+
+	return &operation
+}
+
+// setInFlightOperationPrivateState sets or removes the resource's in-flight operation in the specified Plugin SDK private state.
+// The Plugin SDK does not keep unknown private state keys across applies, so an in-flight operation is always set.
+func setInFlightOperationPrivateState(ctx context.Context, private []byte) []byte {
+	operation, changed := tfresource.InFlightOperationFromContext(ctx)
+
+	if operation == nil && !changed {
+		return private
+	}
+
+	var v map[string]json.RawMessage
+
+	if len(private) > 0 {
+		if err := json.Unmarshal(private, &v); err != nil {
+			return private
+		}
+	}
+
+	if v == nil {
+		v = make(map[string]json.RawMessage)
+	}
+
+	if operation == nil {
+		delete(v, tfresource.InFlightOperationPrivateStateKey)
+	} else {
+		raw, err := json.Marshal(operation)
+
+		if err != nil {
+			return private
+		}
+
+		v[tfresource.InFlightOperationPrivateStateKey] = raw
+	}
+
+	b, err := json.Marshal(v)
+
+	if err != nil {
+		return private
+	}
+
+	return b
+}
@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestSetInFlightOperationPrivateState(t *testing.T) {
+	t.Parallel()
+
+	started := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	operation := &tfresource.InFlightOperation{ID: "test-operation", Started: started}
+	timeouts := `{"e2bfb730-ecaa-11e6-8f88-34363bc7c4c0":{"create":600000000000}}`
+
+	testCases := []struct {
+		Name              string
+		Prior             *tfresource.InFlightOperation
+		Private           string
+		Operation         func(context.Context) error
+		ExpectedOperation *tfresource.InFlightOperation
+		ExpectedKeys      int
+	}{
+		{
+			Name:    "no operation",
+			Private: timeouts,
+			Operation: func(context.Context) error {
+				return nil
+			},
+			ExpectedKeys: 1,
+		},
+		{
+			Name:    "started",
+			Private: timeouts,
+			Operation: func(ctx context.Context) error {
+				return tfresource.WaitInFlightOperation(ctx, "test-operation", func(ctx context.Context) error {
+					return &resource.TimeoutError{LastState: "creating"}
+				})
+			},
+			ExpectedOperation: operation,
+			ExpectedKeys:      2,
+		},
+		{
+			Name:    "started null private state",
+			Private: "null",
+			Operation: func(ctx context.Context) error {
+				return tfresource.WaitInFlightOperation(ctx, "test-operation", func(ctx context.Context) error {
+					<-ctx.Done()
+					return ctx.Err()
+				})
+			},
+			ExpectedOperation: operation,
+			ExpectedKeys:      1,
+		},
+		{
+			Name:  "kept",
+			Prior: operation,
+			Operation: func(context.Context) error {
+				return nil
+			},
+			ExpectedOperation: operation,
+			ExpectedKeys:      1,
+		},
+		{
+			Name:    "completed",
+			Prior:   operation,
+			Private: timeouts,
+			Operation: func(ctx context.Context) error {
+				return tfresource.ResumeInFlightOperation(ctx, func(context.Context) error {
+					return nil
+				})
+			},
+			ExpectedKeys: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			// The provider is stopped, interrupting any wait.
+			stop := make(chan struct{})
+			close(stop)
+			ctx := tfresource.NewInFlightOperationContext(tfresource.NewInFlightOperationsStopContext(context.Background(), stop), testCase.Prior)
+
+			_ = testCase.Operation(ctx)
+
+			private := setInFlightOperationPrivateState(ctx, []byte(testCase.Private))
+
+			var v map[string]json.RawMessage
+
+			if err := json.Unmarshal(private, &v); err != nil {
+				t.Fatalf("unmarshaling private state: %s", err)
+			}
+
+			if got, want := len(v), testCase.ExpectedKeys; got != want {
+				t.Errorf("private state keys = %d, want %d", got, want)
+			}
+
+			got := inFlightOperationFromPrivateState(private)
+
+			if want := testCase.ExpectedOperation; want == nil {
+				if got != nil {
+					t.Errorf("operation = %v, want none", got)
+				}
+			} else {
+				if got == nil {
+					t.Fatal("expected operation")
+				}
+
+				if got.ID != want.ID {
+					t.Errorf("operation ID = %q, want %q", got.ID, want.ID)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// progressInterceptor tracks the progress of the waits made by a resource's Create, Update and Delete operations.
+// Long-running waits are summarized in warning diagnostics.
+func progressInterceptor(ctx context.Context, inv invocation, meta any, next next) diag.Diagnostics {
+	switch inv.operation {
+	case operationCreate, operationUpdate, operationDelete:
+	default:
+		return next(ctx, meta)
+	}
+
+	ctx = tfresource.NewProgressContext(ctx, inv.typeName+"."+inv.operation)
+
+	diags := next(ctx, meta)
+
+	for _, v := range tfresource.ProgressSummaries(ctx) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Long-running wait",
+			Detail:   v,
+		})
+	}
+
+	return diags
+}
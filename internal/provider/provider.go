@@ -6,8 +6,10 @@ import (
 	"log"
 	"os"
 	"regexp"
+	"sort"
 	"time"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	multierror "github.com/hashicorp/go-multierror"
@@ -15,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/nullable"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/accessanalyzer"
@@ -216,6 +219,27 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+			"audit_log": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings for the API audit log.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"file": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: "Path of the file to which one JSON record per AWS API request is appended. " +
+								"Can also be configured using the `TF_AWS_AUDIT_LOG_FILE` environment variable.",
+						},
+						"include_bodies": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Include request and response bodies in audit log records. Bodies are redacted by default.",
+						},
+					},
+				},
+			},
 			"custom_ca_bundle": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -230,6 +254,72 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"required_tags": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Configuration block with settings to require resource tags across all resources.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"allowed_values": {
+										Type:        schema.TypeMap,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Map of required resource tag keys to regular expressions that their whole values must match.",
+									},
+									"exclude_resource_types": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validGlob,
+										},
+										Description: "Resource types, or glob patterns matching resource types, that are not required to have the required tags.",
+									},
+									"keys": {
+										Type:        schema.TypeSet,
+										Required:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Resource tag keys required on all resources.",
+									},
+									"warn_only": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Description: "Whether to warn, rather than fail the plan, when a resource does not have the required tags.",
+									},
+								},
+							},
+						},
+						"rule": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Configuration block with settings to add and remove default tags for resource types.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"remove_keys": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Default tag keys to remove for the resource types.",
+									},
+									"resource_types": {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validGlob,
+										},
+										Description: "Resource types, or glob patterns matching resource types, e.g. `aws_autoscaling_*`, to which the rule applies.",
+									},
+									"tags": {
+										Type:        schema.TypeMap,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Resource tags to default for the resource types.",
+									},
+								},
+							},
+						},
 						"tags": {
 							Type:        schema.TypeMap,
 							Optional:    true,
@@ -251,6 +341,28 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Protocol to use with EC2 metadata service endpoint." +
 					"Valid values are `IPv4` and `IPv6`. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
 			},
+			"emulator": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to use a local AWS API emulator for all services.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "The AWS account ID reported by the provider. Defaults to `000000000000`.",
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"endpoint": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The emulator's endpoint, e.g. `http://localhost:4566`.",
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+					},
+				},
+			},
 			"endpoints": endpointsSchema(),
 			"forbidden_account_ids": {
 				Type:          schema.TypeSet,
@@ -259,6 +371,18 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				ConflictsWith: []string{"allowed_account_ids"},
 				Set:           schema.HashString,
 			},
+			"guardrails": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with rules that allow or deny the provider's AWS account by AWS Organizations OU path, account tag or account alias. Evaluated when the provider is configured.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow": guardrailRulesSchema("Rules, one of which the AWS account must match to be allowed."),
+						"deny":  guardrailRulesSchema("Rules, none of which the AWS account may match."),
+					},
+				},
+			},
 			"http_proxy": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -286,6 +410,36 @@ func New(ctx context.Context) (*schema.Provider, error) {
 							Set:         schema.HashString,
 							Description: "Resource tag key prefixes to ignore across all resources.",
 						},
+						"key_patterns": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validGlob,
+							},
+							Set:         schema.HashString,
+							Description: "Resource tag key glob patterns, e.g. `kubernetes.io/cluster/*`, to ignore across all resources.",
+						},
+						"key_regexes": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsValidRegExp,
+							},
+							Set:         schema.HashString,
+							Description: "Regular expressions matching resource tag keys to ignore across all resources.",
+						},
+						"value_regexes": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsValidRegExp,
+							},
+							Set:         schema.HashString,
+							Description: "Regular expressions matching resource tag values to ignore across all resources.",
+						},
 					},
 				},
 			},
@@ -295,6 +449,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, " +
 					"default value is `false`",
 			},
+			"max_backoff": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The maximum backoff delay between retries of an AWS API request. Valid time units are ns, us (or µs), ms, s, h, or m.",
+				ValidateFunc: validMaxBackoff,
+			},
 			"max_retries": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -308,12 +468,116 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The profile for API operations. If not set, the default profile\n" +
 					"created with `aws configure` will be used.",
 			},
+			"rate_limits": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Client-side rate limits for AWS services. Requests are delayed before they are sent so as to stay within the limits.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"burst": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "The maximum number of requests that can be sent at once. Defaults to `requests_per_second`, rounded up.",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"max_concurrent": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "The maximum number of requests that can be in flight at the same time.",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"requests_per_second": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							Description:  "The sustained number of requests per second.",
+							ValidateFunc: validation.FloatAtLeast(0.001),
+						},
+						"service": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The service, e.g. `route53`. Valid values are the keys of the `endpoints` block.",
+							ValidateFunc: validation.StringInSlice(names.ProviderPackages(), false),
+						},
+					},
+				},
+			},
 			"region": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Description: "The region where AWS operations will take place. Examples\n" +
 					"are us-east-1, us-west-2, etc.", // lintignore:AWSAT003,
 			},
+			"retry_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Specifies how retries are attempted. Valid values are `standard` and `adaptive`. Can also be configured using the `AWS_RETRY_MODE` environment variable.",
+				ValidateFunc: validation.StringInSlice(retryModes(), false),
+			},
+			"retry_overrides": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Retry settings for AWS services that override the provider-level settings.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_backoff": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "The maximum backoff delay between retries of an AWS API request. Valid time units are ns, us (or µs), ms, s, h, or m.",
+							ValidateFunc: validMaxBackoff,
+						},
+						"max_retries": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "The maximum number of times an AWS API request is being executed.",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"retry_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Specifies how retries are attempted. Valid values are `standard` and `adaptive`.",
+							ValidateFunc: validation.StringInSlice(retryModes(), false),
+						},
+						"service": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The service, e.g. `ec2`. Valid values are the keys of the `endpoints` block.",
+							ValidateFunc: validation.StringInSlice(names.ProviderPackages(), false),
+						},
+					},
+				},
+			},
+			"retryable_errors": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "AWS API errors to retry, in addition to those retried by default.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"error_code": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The AWS API error code, e.g. `InvalidParameterValue`.",
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"message_contains": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Text that the AWS API error message must contain.",
+						},
+						"operation": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "The AWS API operation name or a glob pattern that it must match, e.g. `Describe*`. Defaults to all operations.",
+							ValidateFunc: validGlob,
+						},
+						"service": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The service, e.g. `ec2`. Valid values are the keys of the `endpoints` block.",
+							ValidateFunc: validation.StringInSlice(names.ProviderPackages(), false),
+						},
+					},
+				},
+			},
 			"s3_force_path_style": {
 				Type:       schema.TypeBool,
 				Optional:   true,
@@ -401,6 +665,36 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "session token. A session token is only required if you are\n" +
 					"using temporary security credentials.",
 			},
+			"tracing": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings for OpenTelemetry tracing of resource operations, AWS API calls and waiter polls.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"endpoint": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+							Description: "URL of the OTLP/HTTP endpoint to which spans are exported. " +
+								"Can also be configured using the `TF_AWS_TRACING_ENDPOINT` environment variable.",
+						},
+						"file": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: "Path of the file to which one JSON record per span is appended. " +
+								"Can also be configured using the `TF_AWS_TRACING_FILE` environment variable.",
+						},
+						"headers": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Sensitive:   true,
+							Description: "Additional HTTP headers sent to the OTLP/HTTP endpoint, e.g. for authentication.",
+						},
+					},
+				},
+			},
 			"use_dualstack_endpoint": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -2217,10 +2511,6 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			ds := v()
 
-			if v := ds.ReadWithoutTimeout; v != nil {
-				ds.ReadWithoutTimeout = wrappedReadContextFunc(v)
-			}
-
 			provider.DataSourcesMap[typeName] = ds
 		}
 
@@ -2232,32 +2522,6 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			r := v()
 
-			if v := r.CreateWithoutTimeout; v != nil {
-				r.CreateWithoutTimeout = wrappedCreateContextFunc(v)
-			}
-			if v := r.ReadWithoutTimeout; v != nil {
-				r.ReadWithoutTimeout = wrappedReadContextFunc(v)
-			}
-			if v := r.UpdateWithoutTimeout; v != nil {
-				r.UpdateWithoutTimeout = wrappedUpdateContextFunc(v)
-			}
-			if v := r.DeleteWithoutTimeout; v != nil {
-				r.DeleteWithoutTimeout = wrappedDeleteContextFunc(v)
-			}
-			if v := r.Importer; v != nil {
-				if v := v.StateContext; v != nil {
-					r.Importer.StateContext = wrappedStateContextFunc(v)
-				}
-			}
-			if v := r.CustomizeDiff; v != nil {
-				r.CustomizeDiff = wrappedCustomizeDiffFunc(v)
-			}
-			for _, stateUpgrader := range r.StateUpgraders {
-				if v := stateUpgrader.Upgrade; v != nil {
-					stateUpgrader.Upgrade = wrappedStateUpgradeFunc(v)
-				}
-			}
-
 			provider.ResourcesMap[typeName] = r
 		}
 	}
@@ -2266,6 +2530,28 @@ func New(ctx context.Context) (*schema.Provider, error) {
 		return nil, err
 	}
 
+	// Run resource and data source operations through the interceptors.
+	for typeName, ds := range provider.DataSourcesMap {
+		interceptors := interceptors{initContextInterceptor, resourceContextInterceptor, tracingInterceptor}
+
+		// Add the per-resource Region override.
+		if regionalResource(ds, true) {
+			interceptors = append(interceptors, regionInterceptor)
+		}
+
+		interceptedDataSource(typeName, ds, interceptors)
+	}
+
+	for typeName, r := range provider.ResourcesMap {
+		interceptors := interceptors{initContextInterceptor, resourceContextInterceptor, tracingInterceptor, progressInterceptor}
+
+		if regionalResource(r, false) {
+			interceptors = append(interceptors, regionInterceptor)
+		}
+
+		interceptedResource(typeName, r, interceptors)
+	}
+
 	// Set the provider Meta (instance data) here.
 	// It will be overwritten by the result of the call to ConfigureContextFunc,
 	// but can be used pre-configuration by other (non-primary) provider servers.
@@ -2318,9 +2604,28 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.AllowedAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
-	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		config.AssumeRole = expandAssumeRole(ctx, v.([]interface{})[0].(map[string]interface{}))
-		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q, SourceIdentity: %q)", config.AssumeRole.RoleARN, config.AssumeRole.SessionName, config.AssumeRole.ExternalID, config.AssumeRole.SourceIdentity)
+	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 {
+		for i, tfMapRaw := range v.([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			// ConflictsWith can't be used with multiple assume_role blocks.
+			duration, _ := tfMap["duration"].(string)
+			durationSeconds, _ := tfMap["duration_seconds"].(int)
+
+			if duration != "" && durationSeconds != 0 {
+				return nil, diag.Errorf(`assume_role[%d]: only one of "duration" or "duration_seconds" can be specified`, i)
+			}
+
+			// Ignore any empty assume_role blocks.
+			if assumeRole := expandAssumeRole(ctx, tfMap); assumeRole.RoleARN != "" {
+				config.AssumeRole = append(config.AssumeRole, assumeRole)
+				log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q, SourceIdentity: %q)", assumeRole.RoleARN, assumeRole.SessionName, assumeRole.ExternalID, assumeRole.SourceIdentity)
+			}
+		}
 	}
 
 	if v, ok := d.GetOk("assume_role_with_web_identity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
@@ -2328,8 +2633,66 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		log.Printf("[INFO] assume_role_with_web_identity configuration set: (ARN: %q, SessionID: %q)", config.AssumeRoleWithWebIdentity.RoleARN, config.AssumeRoleWithWebIdentity.SessionName)
 	}
 
+	if v, ok := d.GetOk("audit_log"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.AuditLog = expandAuditLog(ctx, v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v := os.Getenv(envvar.AuditLogFile); v != "" && (config.AuditLog == nil || config.AuditLog.File == "") {
+		if config.AuditLog == nil {
+			config.AuditLog = &conns.AuditLogConfig{}
+		}
+		config.AuditLog.File = v
+	}
+
+	if config.AuditLog != nil && config.AuditLog.File != "" {
+		log.Printf("[INFO] API audit log configuration set: (File: %q, IncludeBodies: %t)", config.AuditLog.File, config.AuditLog.IncludeBodies)
+	}
+
+	if v, ok := d.GetOk("tracing"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.Tracing = expandTracing(ctx, v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v := os.Getenv(envvar.TracingEndpoint); v != "" && (config.Tracing == nil || config.Tracing.Endpoint == "") {
+		if config.Tracing == nil {
+			config.Tracing = &conns.TracingConfig{}
+		}
+		config.Tracing.Endpoint = v
+	}
+
+	if v := os.Getenv(envvar.TracingFile); v != "" && (config.Tracing == nil || config.Tracing.File == "") {
+		if config.Tracing == nil {
+			config.Tracing = &conns.TracingConfig{}
+		}
+		config.Tracing.File = v
+	}
+
+	if config.Tracing != nil && (config.Tracing.Endpoint != "" || config.Tracing.File != "") {
+		log.Printf("[INFO] tracing configuration set: (Endpoint: %q, File: %q)", config.Tracing.Endpoint, config.Tracing.File)
+	}
+
 	if v, ok := d.GetOk("default_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		config.DefaultTagsConfig = expandDefaultTags(ctx, v.([]interface{})[0].(map[string]interface{}))
+		defaultTagsConfig, err := expandDefaultTags(ctx, v.([]interface{})[0].(map[string]interface{}))
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.DefaultTagsConfig = defaultTagsConfig
+	}
+
+	if v, ok := d.GetOk("emulator"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.Emulator = expandEmulator(ctx, v.([]interface{})[0].(map[string]interface{}))
+
+		// The emulator only defaults boolean settings that are not explicitly configured.
+		config.ExplicitSettings = make(map[string]bool)
+		for _, k := range []string{"s3_use_path_style", "skip_credentials_validation", "skip_get_ec2_platforms", "skip_region_validation", "skip_requesting_account_id"} {
+			if _, ok := d.GetOkExists(k); ok {
+				config.ExplicitSettings[k] = true
+			}
+		}
+		if _, ok := d.GetOkExists("s3_force_path_style"); ok {
+			config.ExplicitSettings["s3_use_path_style"] = true
+		}
 	}
 
 	if v, ok := d.GetOk("endpoints"); ok && v.(*schema.Set).Len() > 0 {
@@ -2346,14 +2709,56 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.ForbiddenAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("guardrails"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.Guardrails = expandGuardrails(ctx, v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("ignore_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		config.IgnoreTagsConfig = expandIgnoreTags(ctx, v.([]interface{})[0].(map[string]interface{}))
+		ignoreTagsConfig, err := expandIgnoreTags(ctx, v.([]interface{})[0].(map[string]interface{}))
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.IgnoreTagsConfig = ignoreTagsConfig
+	}
+
+	if v, ok := d.GetOk("max_backoff"); ok {
+		config.MaxBackoff, _ = time.ParseDuration(v.(string))
 	}
 
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("rate_limits"); ok && len(v.([]interface{})) > 0 {
+		rateLimits, err := expandRateLimits(ctx, v.([]interface{}))
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.RateLimits = rateLimits
+	}
+
+	if v, ok := d.GetOk("retry_mode"); ok {
+		config.RetryMode = aws_sdkv2.RetryMode(v.(string))
+	}
+
+	if v, ok := d.GetOk("retry_overrides"); ok && len(v.([]interface{})) > 0 {
+		retryOverrides, err := expandRetryOverrides(ctx, v.([]interface{}))
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.RetryOverrides = retryOverrides
+	}
+
+	if v, ok := d.GetOk("retryable_errors"); ok && len(v.([]interface{})) > 0 {
+		config.RetryRules = expandRetryableErrors(ctx, v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("shared_credentials_file"); ok {
 		config.SharedCredentialsFiles = []string{v.(string)}
 	} else if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
@@ -2389,25 +2794,23 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeList,
-		Optional: true,
-		MaxItems: 1,
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "IAM Roles to assume in order. Each IAM Role is assumed using the credentials from the one before it.",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"duration": {
-					Type:          schema.TypeString,
-					Optional:      true,
-					Description:   "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m.",
-					ValidateFunc:  validAssumeRoleDuration,
-					ConflictsWith: []string{"assume_role.0.duration_seconds"},
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m.",
+					ValidateFunc: validAssumeRoleDuration,
 				},
 				"duration_seconds": {
-					Type:          schema.TypeInt,
-					Optional:      true,
-					Deprecated:    "Use assume_role.duration instead",
-					Description:   "The duration, in seconds, of the role session.",
-					ValidateFunc:  validation.IntBetween(900, 43200),
-					ConflictsWith: []string{"assume_role.0.duration"},
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Deprecated:   "Use assume_role.duration instead",
+					Description:  "The duration, in seconds, of the role session.",
+					ValidateFunc: validation.IntBetween(900, 43200),
 				},
 				"external_id": {
 					Type:        schema.TypeString,
@@ -2524,6 +2927,39 @@ func assumeRoleWithWebIdentitySchema() *schema.Schema {
 	}
 }
 
+func guardrailRulesSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"account_alias_patterns": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Glob patterns, e.g. `*-prod`, at least one of which must match one of the AWS account's aliases.",
+				},
+				"account_tags": {
+					Type:        schema.TypeMap,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Map of tags that the AWS account must have in AWS Organizations. Values are glob patterns.",
+				},
+				"organizational_unit_paths": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringMatch(regexp.MustCompile(`^o-[0-9a-z]+/r-[0-9a-z]+/(ou-[0-9a-z]+-[0-9a-z]+/)*$`), "must be an AWS Organizations entity path, e.g. o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/"),
+					},
+					Description: "AWS Organizations entity paths of OUs, e.g. `o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/`. The AWS account must be in one of the OUs or in an OU nested beneath one of them.",
+				},
+			},
+		},
+	}
+}
+
 func endpointsSchema() *schema.Schema {
 	endpointsAttributes := make(map[string]*schema.Schema)
 
@@ -2594,6 +3030,186 @@ func expandAssumeRole(_ context.Context, tfMap map[string]interface{}) *awsbase.
 	return &assumeRole
 }
 
+func expandRateLimits(_ context.Context, tfList []interface{}) ([]conns.RateLimitConfig, error) {
+	var rateLimits []conns.RateLimitConfig
+	services := make(map[string]struct{})
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		rateLimit := conns.RateLimitConfig{}
+
+		if v, ok := tfMap["burst"].(int); ok {
+			rateLimit.Burst = v
+		}
+
+		if v, ok := tfMap["max_concurrent"].(int); ok {
+			rateLimit.MaxConcurrent = v
+		}
+
+		if v, ok := tfMap["requests_per_second"].(float64); ok {
+			rateLimit.RequestsPerSecond = v
+		}
+
+		if v, ok := tfMap["service"].(string); ok {
+			rateLimit.Service = v
+		}
+
+		if _, ok := services[rateLimit.Service]; ok {
+			return nil, fmt.Errorf("rate_limits: duplicate service (%s)", rateLimit.Service)
+		}
+		services[rateLimit.Service] = struct{}{}
+
+		if rateLimit.RequestsPerSecond == 0 && rateLimit.MaxConcurrent == 0 {
+			return nil, fmt.Errorf("rate_limits (%s): one of requests_per_second or max_concurrent must be set", rateLimit.Service)
+		}
+
+		log.Printf("[INFO] rate_limits configuration set: (Service: %q, RequestsPerSecond: %g, Burst: %d, MaxConcurrent: %d)", rateLimit.Service, rateLimit.RequestsPerSecond, rateLimit.Burst, rateLimit.MaxConcurrent)
+
+		rateLimits = append(rateLimits, rateLimit)
+	}
+
+	return rateLimits, nil
+}
+
+func expandRetryOverrides(_ context.Context, tfList []interface{}) (map[string]conns.RetryConfig, error) {
+	retryOverrides := make(map[string]conns.RetryConfig)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		retryConfig := conns.RetryConfig{}
+
+		if v, ok := tfMap["max_backoff"].(string); ok && v != "" {
+			retryConfig.MaxBackoff, _ = time.ParseDuration(v)
+		}
+
+		if v, ok := tfMap["max_retries"].(int); ok {
+			retryConfig.MaxRetries = v
+		}
+
+		if v, ok := tfMap["retry_mode"].(string); ok {
+			retryConfig.Mode = aws_sdkv2.RetryMode(v)
+		}
+
+		service, _ := tfMap["service"].(string)
+
+		if _, ok := retryOverrides[service]; ok {
+			return nil, fmt.Errorf("retry_overrides: duplicate service (%s)", service)
+		}
+
+		retryOverrides[service] = retryConfig
+	}
+
+	return retryOverrides, nil
+}
+
+func expandRetryableErrors(_ context.Context, tfList []interface{}) []conns.RetryRule {
+	var retryRules []conns.RetryRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		retryRule := conns.RetryRule{}
+
+		if v, ok := tfMap["error_code"].(string); ok {
+			retryRule.ErrorCode = v
+		}
+
+		if v, ok := tfMap["message_contains"].(string); ok {
+			retryRule.MessageContains = v
+		}
+
+		if v, ok := tfMap["operation"].(string); ok {
+			retryRule.Operation = v
+		}
+
+		if v, ok := tfMap["service"].(string); ok {
+			retryRule.Service = v
+		}
+
+		log.Printf("[INFO] retryable_errors configuration set: (Service: %q, Operation: %q, ErrorCode: %q, MessageContains: %q)", retryRule.Service, retryRule.Operation, retryRule.ErrorCode, retryRule.MessageContains)
+
+		retryRules = append(retryRules, retryRule)
+	}
+
+	return retryRules
+}
+
+func expandEmulator(_ context.Context, tfMap map[string]interface{}) *conns.EmulatorConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	emulator := conns.EmulatorConfig{}
+
+	if v, ok := tfMap["account_id"].(string); ok && v != "" {
+		emulator.AccountID = v
+	}
+
+	if v, ok := tfMap["endpoint"].(string); ok && v != "" {
+		emulator.Endpoint = v
+	}
+
+	return &emulator
+}
+
+func expandAuditLog(_ context.Context, tfMap map[string]interface{}) *conns.AuditLogConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	auditLog := conns.AuditLogConfig{}
+
+	if v, ok := tfMap["file"].(string); ok && v != "" {
+		auditLog.File = v
+	}
+
+	if v, ok := tfMap["include_bodies"].(bool); ok {
+		auditLog.IncludeBodies = v
+	}
+
+	return &auditLog
+}
+
+func expandTracing(_ context.Context, tfMap map[string]interface{}) *conns.TracingConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	tracing := conns.TracingConfig{}
+
+	if v, ok := tfMap["endpoint"].(string); ok && v != "" {
+		tracing.Endpoint = v
+	}
+
+	if v, ok := tfMap["file"].(string); ok && v != "" {
+		tracing.File = v
+	}
+
+	if v, ok := tfMap["headers"].(map[string]interface{}); ok && len(v) > 0 {
+		tracing.Headers = make(map[string]string, len(v))
+
+		for k, v := range v {
+			tracing.Headers[k] = v.(string)
+		}
+	}
+
+	return &tracing
+}
+
 func expandAssumeRoleWithWebIdentity(_ context.Context, tfMap map[string]interface{}) *awsbase.AssumeRoleWithWebIdentity {
 	if tfMap == nil {
 		return nil
@@ -2635,36 +3251,214 @@ func expandAssumeRoleWithWebIdentity(_ context.Context, tfMap map[string]interfa
 	return &assumeRole
 }
 
-func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftags.DefaultConfig {
+func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) (*tftags.DefaultConfig, error) {
 	if tfMap == nil {
-		return nil
+		return nil, nil
 	}
 
 	defaultConfig := &tftags.DefaultConfig{}
 
+	if v, ok := tfMap["required_tags"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		requiredTags, err := expandRequiredTags(ctx, v[0].(map[string]interface{}))
+
+		if err != nil {
+			return nil, err
+		}
+
+		defaultConfig.RequiredTags = requiredTags
+	}
+
+	if v, ok := tfMap["rule"].([]interface{}); ok && len(v) > 0 {
+		defaultConfig.Rules = expandDefaultTagsRules(ctx, v)
+	}
+
 	if v, ok := tfMap["tags"].(map[string]interface{}); ok {
 		defaultConfig.Tags = tftags.New(ctx, v)
 	}
 
-	return defaultConfig
+	return defaultConfig, nil
+}
+
+func expandDefaultTagsRules(ctx context.Context, tfList []interface{}) []tftags.DefaultTagsRule {
+	var rules []tftags.DefaultTagsRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		rule := tftags.DefaultTagsRule{}
+
+		if v, ok := tfMap["remove_keys"].(*schema.Set); ok {
+			rule.RemoveKeys = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap["resource_types"].(*schema.Set); ok {
+			rule.ResourceTypes = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap["tags"].(map[string]interface{}); ok && len(v) > 0 {
+			rule.Tags = tftags.New(ctx, v)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+func expandRequiredTags(ctx context.Context, tfMap map[string]interface{}) (*tftags.RequiredTagsConfig, error) {
+	if tfMap == nil {
+		return nil, nil
+	}
+
+	requiredTags := &tftags.RequiredTagsConfig{}
+
+	if v, ok := tfMap["keys"].(*schema.Set); ok {
+		requiredTags.Keys = flex.ExpandStringValueSet(v)
+		sort.Strings(requiredTags.Keys)
+	}
+
+	if v, ok := tfMap["allowed_values"].(map[string]interface{}); ok && len(v) > 0 {
+		requiredTags.AllowedValues = make(map[string]*regexp.Regexp, len(v))
+
+		keys := tftags.New(ctx, requiredTags.Keys)
+
+		for key, pattern := range v {
+			if !keys.KeyExists(key) {
+				return nil, fmt.Errorf("required_tags: allowed_values key (%s) is not in keys", key)
+			}
+
+			// Patterns must match the whole value.
+			re, err := regexp.Compile(`^(?:` + pattern.(string) + `)$`)
+
+			if err != nil {
+				return nil, fmt.Errorf("required_tags: allowed_values (%s): %w", key, err)
+			}
+
+			requiredTags.AllowedValues[key] = re
+		}
+	}
+
+	if v, ok := tfMap["exclude_resource_types"].(*schema.Set); ok {
+		requiredTags.ExcludeResourceTypes = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["warn_only"].(bool); ok {
+		requiredTags.WarnOnly = v
+	}
+
+	log.Printf("[INFO] required_tags configuration set: (Keys: %v, ExcludeResourceTypes: %v, WarnOnly: %t)", requiredTags.Keys, requiredTags.ExcludeResourceTypes, requiredTags.WarnOnly)
+
+	return requiredTags, nil
 }
 
-func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags.IgnoreConfig {
+func expandGuardrails(ctx context.Context, tfMap map[string]interface{}) *conns.GuardrailsConfig {
 	if tfMap == nil {
 		return nil
 	}
 
+	guardrails := &conns.GuardrailsConfig{}
+
+	if v, ok := tfMap["allow"].([]interface{}); ok && len(v) > 0 {
+		guardrails.Allow = expandGuardrailRules(ctx, v)
+	}
+
+	if v, ok := tfMap["deny"].([]interface{}); ok && len(v) > 0 {
+		guardrails.Deny = expandGuardrailRules(ctx, v)
+	}
+
+	return guardrails
+}
+
+func expandGuardrailRules(_ context.Context, tfList []interface{}) []conns.GuardrailRule {
+	var rules []conns.GuardrailRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		rule := conns.GuardrailRule{}
+
+		if v, ok := tfMap["account_alias_patterns"].(*schema.Set); ok && v.Len() > 0 {
+			rule.AccountAliasPatterns = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap["account_tags"].(map[string]interface{}); ok && len(v) > 0 {
+			rule.AccountTags = flex.ExpandStringValueMap(v)
+		}
+
+		if v, ok := tfMap["organizational_unit_paths"].(*schema.Set); ok && v.Len() > 0 {
+			rule.OrganizationalUnitPaths = flex.ExpandStringValueSet(v)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) (*tftags.IgnoreConfig, error) {
+	if tfMap == nil {
+		return nil, nil
+	}
+
 	ignoreConfig := &tftags.IgnoreConfig{}
 
 	if v, ok := tfMap["keys"].(*schema.Set); ok {
 		ignoreConfig.Keys = tftags.New(ctx, v.List())
 	}
 
+	if v, ok := tfMap["key_patterns"].(*schema.Set); ok {
+		ignoreConfig.KeyPatterns = flex.ExpandStringValueSet(v)
+	}
+
 	if v, ok := tfMap["key_prefixes"].(*schema.Set); ok {
 		ignoreConfig.KeyPrefixes = tftags.New(ctx, v.List())
 	}
 
-	return ignoreConfig
+	if v, ok := tfMap["key_regexes"].(*schema.Set); ok {
+		regexes, err := expandRegexes(flex.ExpandStringValueSet(v))
+
+		if err != nil {
+			return nil, fmt.Errorf("ignore_tags: key_regexes: %w", err)
+		}
+
+		ignoreConfig.KeyRegexes = regexes
+	}
+
+	if v, ok := tfMap["value_regexes"].(*schema.Set); ok {
+		regexes, err := expandRegexes(flex.ExpandStringValueSet(v))
+
+		if err != nil {
+			return nil, fmt.Errorf("ignore_tags: value_regexes: %w", err)
+		}
+
+		ignoreConfig.ValueRegexes = regexes
+	}
+
+	return ignoreConfig, nil
+}
+
+func expandRegexes(patterns []string) ([]*regexp.Regexp, error) {
+	var regexes []*regexp.Regexp
+
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+
+		if err != nil {
+			return nil, err
+		}
+
+		regexes = append(regexes, re)
+	}
+
+	return regexes, nil
 }
 
 func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string, error) {
@@ -2719,59 +3513,3 @@ func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string
 
 	return endpoints, nil
 }
-
-func wrappedCreateContextFunc(f schema.CreateContextFunc) schema.CreateContextFunc {
-	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-		ctx = meta.(*conns.AWSClient).InitContext(ctx)
-
-		return f(ctx, d, meta)
-	}
-}
-
-func wrappedReadContextFunc(f schema.ReadContextFunc) schema.ReadContextFunc {
-	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-		ctx = meta.(*conns.AWSClient).InitContext(ctx)
-
-		return f(ctx, d, meta)
-	}
-}
-
-func wrappedUpdateContextFunc(f schema.UpdateContextFunc) schema.UpdateContextFunc {
-	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-		ctx = meta.(*conns.AWSClient).InitContext(ctx)
-
-		return f(ctx, d, meta)
-	}
-}
-
-func wrappedDeleteContextFunc(f schema.DeleteContextFunc) schema.DeleteContextFunc {
-	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-		ctx = meta.(*conns.AWSClient).InitContext(ctx)
-
-		return f(ctx, d, meta)
-	}
-}
-
-func wrappedStateContextFunc(f schema.StateContextFunc) schema.StateContextFunc {
-	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
-		ctx = meta.(*conns.AWSClient).InitContext(ctx)
-
-		return f(ctx, d, meta)
-	}
-}
-
-func wrappedCustomizeDiffFunc(f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
-	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
-		ctx = meta.(*conns.AWSClient).InitContext(ctx)
-
-		return f(ctx, d, meta)
-	}
-}
-
-func wrappedStateUpgradeFunc(f schema.StateUpgradeFunc) schema.StateUpgradeFunc {
-	return func(ctx context.Context, rawState map[string]interface{}, meta any) (map[string]interface{}, error) {
-		ctx = meta.(*conns.AWSClient).InitContext(ctx)
-
-		return f(ctx, rawState, meta)
-	}
-}
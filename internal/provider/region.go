@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Per-resource Region override.
+// Resources and data sources that don't define their own top-level "region" attribute
+// are given an optional "region" argument. When set, all API calls for that resource
+// are made in the specified Region instead of the provider's configured Region.
+
+const importIDRegionSeparator = "@"
+
+func resourceRegionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     true,
+		ValidateFunc: verify.ValidRegionName,
+		Description:  "The Region in which the resource is managed. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+func dataSourceRegionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: verify.ValidRegionName,
+		Description:  "The Region in which the data source is read. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// regionalMeta returns the provider Meta (instance data) for the specified Region.
+func regionalMeta(ctx context.Context, region string, meta any) (any, error) {
+	client, err := meta.(*conns.AWSClient).RegionalClient(ctx, region)
+
+	if err != nil {
+		return nil, fmt.Errorf("configuring Region (%s): %w", region, err)
+	}
+
+	return client, nil
+}
+
+// setRegion sets the "region" attribute to the Region of the specified provider Meta.
+func setRegion(d *schema.ResourceData, meta any) diag.Diagnostics {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if err := d.Set(names.AttrRegion, meta.(*conns.AWSClient).Region); err != nil {
+		return diag.Errorf("setting %s: %s", names.AttrRegion, err)
+	}
+
+	return nil
+}
+
+// importIDRegion splits an import ID of the form "<id>@<region>" into its ID and Region parts.
+func importIDRegion(id string) (string, string, bool) {
+	i := strings.LastIndex(id, importIDRegionSeparator)
+
+	if i < 0 {
+		return id, "", false
+	}
+
+	region := id[i+len(importIDRegionSeparator):]
+
+	if _, errs := verify.ValidRegionName(region, names.AttrRegion); region == "" || len(errs) > 0 {
+		return id, "", false
+	}
+
+	return id[:i], region, true
+}
+
+// regionalResource adds the per-resource Region override to the specified resource or data source's schema.
+// It returns whether the override was added, in which case the resource's operations must also run through regionInterceptor.
+func regionalResource(r *schema.Resource, isDataSource bool) bool {
+	if r.Schema == nil {
+		return false
+	}
+
+	if _, ok := r.Schema[names.AttrRegion]; ok {
+		return false
+	}
+
+	if isDataSource {
+		r.Schema[names.AttrRegion] = dataSourceRegionSchema()
+	} else {
+		r.Schema[names.AttrRegion] = resourceRegionSchema()
+	}
+
+	return true
+}
+
+// regionInterceptor makes the AWS API calls for a resource or data source in its configured Region.
+// The resource's operations are passed the provider Meta (instance data) for that Region.
+func regionInterceptor(ctx context.Context, inv invocation, meta any, next next) diag.Diagnostics {
+	switch inv.operation {
+	case operationImport:
+		d := inv.d.(*schema.ResourceData)
+
+		if id, region, ok := importIDRegion(d.Id()); ok {
+			d.SetId(id)
+
+			if err := d.Set(names.AttrRegion, region); err != nil {
+				return diag.Errorf("setting %s: %s", names.AttrRegion, err)
+			}
+		}
+	case operationCustomizeDiff:
+		if err := regionCustomizeDiff(ctx, inv.d.(*schema.ResourceDiff), meta); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	region, _ := inv.d.Get(names.AttrRegion).(string)
+
+	meta, err := regionalMeta(ctx, region, meta)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	diags := next(ctx, meta)
+
+	switch inv.operation {
+	case operationCreate, operationRead:
+		diags = append(diags, setRegion(inv.d.(*schema.ResourceData), meta)...)
+	}
+
+	return diags
+}
+
+// regionCustomizeDiff plans the "region" attribute.
+// A resource without a configured Region follows the provider's Region,
+// so changing the provider's Region replaces the resource.
+// State saved before the attribute was added has no Region and is left as is.
+func regionCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	config := d.GetRawConfig()
+
+	if !config.IsKnown() || config.IsNull() || !config.GetAttr(names.AttrRegion).IsNull() {
+		return nil
+	}
+
+	old, _ := d.GetChange(names.AttrRegion)
+
+	if d.Id() != "" && old.(string) == "" {
+		return nil
+	}
+
+	if region := meta.(*conns.AWSClient).Region; d.Get(names.AttrRegion).(string) != region {
+		if err := d.SetNew(names.AttrRegion, region); err != nil {
+			return fmt.Errorf("setting %s: %w", names.AttrRegion, err)
+		}
+	}
+
+	return nil
+}
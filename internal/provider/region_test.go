@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestImportIDRegion(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		input          string
+		expectedID     string
+		expectedRegion string
+		expectedOK     bool
+	}{
+		{
+			name:       "no Region",
+			input:      "sg-12345678",
+			expectedID: "sg-12345678",
+		},
+		{
+			name:           "Region",
+			input:          "sg-12345678@eu-west-1",
+			expectedID:     "sg-12345678",
+			expectedRegion: "eu-west-1", //lintignore:AWSAT003
+			expectedOK:     true,
+		},
+		{
+			name:       "email address",
+			input:      "user@example.com",
+			expectedID: "user@example.com",
+		},
+		{
+			name:           "email address and Region",
+			input:          "user@example.com@us-gov-west-1",
+			expectedID:     "user@example.com",
+			expectedRegion: "us-gov-west-1", //lintignore:AWSAT003
+			expectedOK:     true,
+		},
+		{
+			name:       "trailing separator",
+			input:      "sg-12345678@",
+			expectedID: "sg-12345678@",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			id, region, ok := importIDRegion(testCase.input)
+
+			if got, want := id, testCase.expectedID; got != want {
+				t.Errorf("ID: got %s, expected %s", got, want)
+			}
+			if got, want := region, testCase.expectedRegion; got != want {
+				t.Errorf("Region: got %s, expected %s", got, want)
+			}
+			if got, want := ok, testCase.expectedOK; got != want {
+				t.Errorf("ok: got %t, expected %t", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
+)
+
+// tracingInterceptor emits an OpenTelemetry span for each CRUD operation on a resource or data source.
+// AWS API calls and waiter polls made during the operation are children of the operation's span.
+// No span is emitted unless tracing is enabled for the provider.
+func tracingInterceptor(ctx context.Context, inv invocation, meta any, next next) diag.Diagnostics {
+	switch inv.operation {
+	case operationCreate, operationRead, operationUpdate, operationDelete:
+	default:
+		return next(ctx, meta)
+	}
+
+	ctx, span := tracing.Start(ctx, inv.typeName+"."+inv.operation,
+		tracing.AttrTFOperation.String(inv.operation),
+		tracing.AttrTFResourceID.String(inv.d.Id()),
+		tracing.AttrTFResourceType.String(inv.typeName),
+	)
+
+	diags := next(ctx, meta)
+
+	var err error
+
+	if diags.HasError() {
+		var summaries []string
+
+		for _, d := range diags {
+			if d.Severity == diag.Error {
+				summaries = append(summaries, d.Summary)
+			}
+		}
+
+		err = errors.New(strings.Join(summaries, "; "))
+	}
+
+	// The ID is set by Create.
+	span.SetAttributes(tracing.AttrTFResourceID.String(inv.d.Id()))
+	tracing.End(span, err)
+
+	return diags
+}
@@ -2,9 +2,11 @@ package provider
 
 import (
 	"fmt"
+	"path"
 	"regexp"
 	"time"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
@@ -25,6 +27,40 @@ func validAssumeRoleDuration(v interface{}, k string) (ws []string, errors []err
 	return
 }
 
+// validMaxBackoff validates a string can be parsed as a valid time.Duration
+// and is at least 1 second
+func validMaxBackoff(v interface{}, k string) (ws []string, errors []error) {
+	duration, err := time.ParseDuration(v.(string))
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q cannot be parsed as a duration: %w", k, err))
+		return
+	}
+
+	if duration < time.Second {
+		errors = append(errors, fmt.Errorf("duration %q must be at least 1 second (1s)", k))
+	}
+
+	return
+}
+
+// validGlob validates a string is a valid glob pattern, e.g. for AWS API operation names or resource tag keys
+func validGlob(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := path.Match(v.(string), ""); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid glob pattern: %w", k, err))
+	}
+
+	return
+}
+
+// retryModes returns the valid AWS SDK retry modes
+func retryModes() []string {
+	return []string{
+		string(aws_sdkv2.RetryModeAdaptive),
+		string(aws_sdkv2.RetryModeStandard),
+	}
+}
+
 var validAssumeRoleSessionName = validation.All(
 	validation.StringLenBetween(2, 64),
 	validation.StringMatch(regexp.MustCompile(`[\w+=,.@\-]*`), ""),
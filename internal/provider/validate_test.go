@@ -68,3 +68,86 @@ func TestValidAssumeRoleDuration(t *testing.T) {
 		}
 	}
 }
+
+func TestValidMaxBackoff(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		val         interface{}
+		expectedErr *regexp.Regexp
+	}{
+		{
+			val:         "",
+			expectedErr: regexp.MustCompile(`cannot be parsed as a duration`),
+		},
+		{
+			val:         "20",
+			expectedErr: regexp.MustCompile(`cannot be parsed as a duration`),
+		},
+		{
+			val:         "500ms",
+			expectedErr: regexp.MustCompile(`must be at least 1 second \(1s\)`),
+		},
+		{
+			val: "1s",
+		},
+		{
+			val: "2m30s",
+		},
+	}
+
+	for i, tc := range testCases {
+		_, errs := validMaxBackoff(tc.val, "test_property")
+
+		if len(errs) == 0 && tc.expectedErr == nil {
+			continue
+		}
+
+		if len(errs) != 0 && tc.expectedErr == nil {
+			t.Fatalf("expected test case %d to produce no errors, got %v", i, errs)
+		}
+
+		if len(errs) == 0 || !tc.expectedErr.MatchString(errs[0].Error()) {
+			t.Fatalf("expected test case %d to produce error matching \"%s\", got %v", i, tc.expectedErr, errs)
+		}
+	}
+}
+
+func TestValidGlob(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		val         interface{}
+		expectedErr *regexp.Regexp
+	}{
+		{
+			val:         "Describe[",
+			expectedErr: regexp.MustCompile(`is not a valid glob pattern`),
+		},
+		{
+			val: "CreateVpnGateway",
+		},
+		{
+			val: "Describe*",
+		},
+		{
+			val: "[CD]elete?tem",
+		},
+	}
+
+	for i, tc := range testCases {
+		_, errs := validGlob(tc.val, "test_property")
+
+		if len(errs) == 0 && tc.expectedErr == nil {
+			continue
+		}
+
+		if len(errs) != 0 && tc.expectedErr == nil {
+			t.Fatalf("expected test case %d to produce no errors, got %v", i, errs)
+		}
+
+		if len(errs) == 0 || !tc.expectedErr.MatchString(errs[0].Error()) {
+			t.Fatalf("expected test case %d to produce error matching \"%s\", got %v", i, tc.expectedErr, errs)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// planWarningsServer returns the warnings added while planning Plugin SDK resources, e.g. by CustomizeDiff,
+// which the Plugin SDK can't return itself.
+type planWarningsServer struct {
+	tfprotov5.ProviderServer
+}
+
+func newPlanWarningsServer(server tfprotov5.ProviderServer) tfprotov5.ProviderServer {
+	return &planWarningsServer{
+		ProviderServer: server,
+	}
+}
+
+func (s *planWarningsServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	ctx = sdkdiag.NewWarningsContext(ctx)
+
+	response, err := s.ProviderServer.PlanResourceChange(ctx, request)
+
+	if err != nil || response == nil {
+		return response, err
+	}
+
+	for _, v := range sdkdiag.WarningsFromContext(ctx) {
+		response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityWarning,
+			Summary:  v.Summary,
+			Detail:   v.Detail,
+		})
+	}
+
+	return response, nil
+}
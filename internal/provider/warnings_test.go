@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+type testPlanServer struct {
+	tfprotov5.ProviderServer
+}
+
+func (testPlanServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	sdkdiag.AddWarning(ctx, "Required tags", "missing required tag keys: Owner")
+
+	return &tfprotov5.PlanResourceChangeResponse{}, nil
+}
+
+func TestPlanWarningsServer(t *testing.T) {
+	t.Parallel()
+
+	server := newPlanWarningsServer(testPlanServer{})
+
+	response, err := server.PlanResourceChange(context.Background(), &tfprotov5.PlanResourceChangeRequest{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(response.Diagnostics), 1; got != want {
+		t.Fatalf("len(Diagnostics) = %d, want %d", got, want)
+	}
+
+	if got, want := response.Diagnostics[0].Severity, tfprotov5.DiagnosticSeverityWarning; got != want {
+		t.Errorf("Severity = %s, want %s", got, want)
+	}
+
+	if got, want := response.Diagnostics[0].Summary, "Required tags"; got != want {
+		t.Errorf("Summary = %q, want %q", got, want)
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/acmpca"
 	"github.com/aws/aws-sdk-go/service/acmpca/acmpcaiface"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
@@ -57,6 +59,21 @@ func KeyValueTags(ctx context.Context, tags []*acmpca.Tag) tftags.KeyValueTags {
 	return tftags.New(ctx, m)
 }
 
+// GetTagsIn returns acmpca service tags for a new resource's Create API input.
+// These are the resource's tags merged with the provider's default tags, excluding AWS system tags.
+// Tagging on create satisfies policies that require tags on creation and never leaves the resource untagged.
+// nil is returned if there are no tags, as some Create APIs reject an empty set of tags.
+func GetTagsIn(ctx context.Context, d *schema.ResourceData, meta interface{}) []*acmpca.Tag {
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(ctx, tftags.New(ctx, d.Get("tags").(map[string]interface{}))).IgnoreAWS()
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	return Tags(tags)
+}
+
 // UpdateTags updates acmpca service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
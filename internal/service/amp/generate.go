@@ -1,4 +1,4 @@
-//go:generate go run ../../generate/tags/main.go -ListTags -ListTagsInIDElem=ResourceArn -ServiceTagsMap -TagInIDElem=ResourceArn -UpdateTags -ContextOnly
+//go:generate go run ../../generate/tags/main.go -ListTags -ListTagsInIDElem=ResourceArn -ServiceTagsMap -GetTagsIn -TagInIDElem=ResourceArn -UpdateTags -ContextOnly
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package amp
@@ -187,8 +187,6 @@ func ResourceDomainName() *schema.Resource {
 func resourceDomainNameCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).APIGatewayConn()
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
-	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
 	log.Printf("[DEBUG] Creating API Gateway Domain Name")
 
 	params := &apigateway.CreateDomainNameInput{
@@ -236,9 +234,7 @@ func resourceDomainNameCreate(ctx context.Context, d *schema.ResourceData, meta
 		params.OwnershipVerificationCertificateArn = aws.String(v.(string))
 	}
 
-	if len(tags) > 0 {
-		params.Tags = Tags(tags.IgnoreAWS())
-	}
+	params.Tags = GetTagsIn(ctx, d, meta)
 
 	domainName, err := conn.CreateDomainNameWithContext(ctx, params)
 	if err != nil {
@@ -273,7 +269,7 @@ func resourceDomainNameRead(ctx context.Context, d *schema.ResourceData, meta in
 	tags := KeyValueTags(ctx, domainName.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.RemoveDefaultConfig(ctx, defaultTagsConfig).Map()); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
 	}
 
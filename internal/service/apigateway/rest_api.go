@@ -158,13 +158,11 @@ func ResourceRestAPI() *schema.Resource {
 func resourceRestAPICreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).APIGatewayConn()
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
-	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
 	log.Printf("[DEBUG] Creating API Gateway")
 
 	params := &apigateway.CreateRestApiInput{
 		Name: aws.String(d.Get("name").(string)),
-		Tags: Tags(tags.IgnoreAWS()),
+		Tags: GetTagsIn(ctx, d, meta),
 	}
 
 	if v, ok := d.GetOk("api_key_source"); ok {
@@ -342,7 +340,7 @@ func resourceRestAPIRead(ctx context.Context, d *schema.ResourceData, meta inter
 	tags := KeyValueTags(ctx, api.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.RemoveDefaultConfig(ctx, defaultTagsConfig).Map()); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
 	}
 
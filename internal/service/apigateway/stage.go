@@ -155,8 +155,6 @@ func ResourceStage() *schema.Resource {
 func resourceStageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).APIGatewayConn()
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
-	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
 
 	respApiId := d.Get("rest_api_id").(string)
 	stageName := d.Get("stage_name").(string)
@@ -193,9 +191,7 @@ func resourceStageCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.CanarySettings = expandStageCanarySettings(v.([]interface{}), deploymentId)
 	}
 
-	if len(tags) > 0 {
-		input.Tags = Tags(tags.IgnoreAWS())
-	}
+	input.Tags = GetTagsIn(ctx, d, meta)
 
 	output, err := conn.CreateStageWithContext(ctx, input)
 
@@ -272,7 +268,7 @@ func resourceStageRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	tags := KeyValueTags(ctx, stage.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.RemoveDefaultConfig(ctx, defaultTagsConfig).Map()); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
 	}
 
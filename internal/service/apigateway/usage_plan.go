@@ -142,8 +142,6 @@ func ResourceUsagePlan() *schema.Resource {
 func resourceUsagePlanCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).APIGatewayConn()
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
-	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
 
 	name := d.Get("name").(string)
 	input := &apigateway.CreateUsagePlanInput{
@@ -177,9 +175,7 @@ func resourceUsagePlanCreate(ctx context.Context, d *schema.ResourceData, meta i
 		input.Throttle = expandThrottleSettings(v.([]interface{}))
 	}
 
-	if len(tags) > 0 {
-		input.Tags = Tags(tags.IgnoreAWS())
-	}
+	input.Tags = GetTagsIn(ctx, d, meta)
 
 	output, err := conn.CreateUsagePlanWithContext(ctx, input)
 
@@ -260,7 +256,7 @@ func resourceUsagePlanRead(ctx context.Context, d *schema.ResourceData, meta int
 	tags := KeyValueTags(ctx, up.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.RemoveDefaultConfig(ctx, defaultTagsConfig).Map()); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
 	}
 
@@ -1,4 +1,4 @@
-//go:generate go run ../../generate/tags/main.go -ServiceTagsMap -ListTags -UpdateTags -ContextOnly
+//go:generate go run ../../generate/tags/main.go -ServiceTagsMap -GetTagsIn -ListTags -UpdateTags -ContextOnly
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package appflow
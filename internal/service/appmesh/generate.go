@@ -1,4 +1,4 @@
-//go:generate go run ../../generate/tags/main.go -ListTags -ServiceTagsSlice -TagType=TagRef -UpdateTags -ContextOnly
+//go:generate go run ../../generate/tags/main.go -ListTags -ServiceTagsSlice -GetTagsIn -TagType=TagRef -UpdateTags -ContextOnly
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package appmesh
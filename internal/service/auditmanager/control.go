@@ -160,7 +160,7 @@ func (r *resourceControl) Create(ctx context.Context, req resource.CreateRequest
 
 	defaultTagsConfig := r.Meta().DefaultTagsConfig
 	ignoreTagsConfig := r.Meta().IgnoreTagsConfig
-	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, plan.Tags))
+	tags := defaultTagsConfig.MergeTags(ctx, tftags.New(ctx, plan.Tags))
 	plan.TagsAll = flex.FlattenFrameworkStringValueMapLegacy(ctx, tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map())
 
 	if len(tags) > 0 {
@@ -450,7 +450,7 @@ func (rd *resourceControlData) refreshFromOutput(ctx context.Context, meta *conn
 	ignoreTagsConfig := meta.IgnoreTagsConfig
 	tags := KeyValueTags(ctx, out.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 	// AWS APIs often return empty lists of tags when none have been configured.
-	if tags := tags.RemoveDefaultConfig(defaultTagsConfig).Map(); len(tags) == 0 {
+	if tags := tags.RemoveDefaultConfig(ctx, defaultTagsConfig).Map(); len(tags) == 0 {
 		rd.Tags = tftags.Null
 	} else {
 		rd.Tags = flex.FlattenFrameworkStringValueMapLegacy(ctx, tags)
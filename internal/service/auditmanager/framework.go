@@ -130,7 +130,7 @@ func (r *resourceFramework) Create(ctx context.Context, req resource.CreateReque
 
 	defaultTagsConfig := r.Meta().DefaultTagsConfig
 	ignoreTagsConfig := r.Meta().IgnoreTagsConfig
-	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, plan.Tags))
+	tags := defaultTagsConfig.MergeTags(ctx, tftags.New(ctx, plan.Tags))
 	plan.TagsAll = flex.FlattenFrameworkStringValueMapLegacy(ctx, tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map())
 
 	if len(tags) > 0 {
@@ -397,7 +397,7 @@ func (rd *resourceFrameworkData) refreshFromOutput(ctx context.Context, meta *co
 	ignoreTagsConfig := meta.IgnoreTagsConfig
 	tags := KeyValueTags(ctx, out.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 	// AWS APIs often return empty lists of tags when none have been configured.
-	if tags := tags.RemoveDefaultConfig(defaultTagsConfig).Map(); len(tags) == 0 {
+	if tags := tags.RemoveDefaultConfig(ctx, defaultTagsConfig).Map(); len(tags) == 0 {
 		rd.Tags = tftags.Null
 	} else {
 		rd.Tags = flex.FlattenFrameworkStringValueMapLegacy(ctx, tags)
@@ -134,8 +134,6 @@ func ResourceFramework() *schema.Resource {
 func resourceFrameworkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).BackupConn()
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
-	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
 
 	name := d.Get("name").(string)
 
@@ -149,9 +147,7 @@ func resourceFrameworkCreate(ctx context.Context, d *schema.ResourceData, meta i
 		input.FrameworkDescription = aws.String(v.(string))
 	}
 
-	if len(tags) > 0 {
-		input.FrameworkTags = Tags(tags.IgnoreAWS())
-	}
+	input.FrameworkTags = GetTagsIn(ctx, d, meta)
 
 	log.Printf("[DEBUG] Creating Backup Framework: %#v", input)
 	resp, err := conn.CreateFrameworkWithContext(ctx, input)
@@ -210,7 +206,7 @@ func resourceFrameworkRead(ctx context.Context, d *schema.ResourceData, meta int
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.RemoveDefaultConfig(ctx, defaultTagsConfig).Map()); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
 	}
 
@@ -118,8 +118,6 @@ func ResourceReportPlan() *schema.Resource {
 func resourceReportPlanCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).BackupConn()
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
-	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
 
 	name := d.Get("name").(string)
 	input := &backup.CreateReportPlanInput{
@@ -133,9 +131,7 @@ func resourceReportPlanCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.ReportPlanDescription = aws.String(v.(string))
 	}
 
-	if len(tags) > 0 {
-		input.ReportPlanTags = Tags(tags.IgnoreAWS())
-	}
+	input.ReportPlanTags = GetTagsIn(ctx, d, meta)
 
 	log.Printf("[DEBUG] Creating Backup Report Plan: %s", input)
 	output, err := conn.CreateReportPlanWithContext(ctx, input)
@@ -195,7 +191,7 @@ func resourceReportPlanRead(ctx context.Context, d *schema.ResourceData, meta in
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.RemoveDefaultConfig(ctx, defaultTagsConfig).Map()); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
 	}
 
@@ -77,13 +77,11 @@ func ResourceVault() *schema.Resource {
 func resourceVaultCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).BackupConn()
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
-	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
 
 	name := d.Get("name").(string)
 	input := &backup.CreateBackupVaultInput{
 		BackupVaultName: aws.String(name),
-		BackupVaultTags: Tags(tags.IgnoreAWS()),
+		BackupVaultTags: GetTagsIn(ctx, d, meta),
 	}
 
 	if v, ok := d.GetOk("kms_key_arn"); ok {
@@ -133,7 +131,7 @@ func resourceVaultRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.RemoveDefaultConfig(ctx, defaultTagsConfig).Map()); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
 	}
 
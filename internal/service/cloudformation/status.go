@@ -2,6 +2,8 @@ package cloudformation
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
@@ -63,6 +65,45 @@ func StatusStack(ctx context.Context, conn *cloudformation.CloudFormation, stack
 	}
 }
 
+// stackEventsPollInterval is the smallest interval between the stack event lookups made to report a wait's progress.
+const stackEventsPollInterval = 1 * time.Minute
+
+// statusStackProgress is StatusStack that also reports the stack's most recent event as the wait's progress.
+// Stack events are looked up when the stack's status changes, and otherwise at most every stackEventsPollInterval,
+// so that progress reporting doesn't add to the wait's CloudFormation API calls on every refresh.
+func statusStackProgress(ctx context.Context, conn *cloudformation.CloudFormation, stackID string) resource.StateRefreshFunc {
+	refresh := StatusStack(ctx, conn, stackID)
+
+	var lastStatus string
+	var lastEvents time.Time
+
+	return func() (interface{}, string, error) {
+		output, status, err := refresh()
+
+		if err != nil || output == nil {
+			return output, status, err
+		}
+
+		if status == lastStatus && time.Since(lastEvents) < stackEventsPollInterval {
+			return output, status, nil
+		}
+
+		lastStatus, lastEvents = status, time.Now()
+
+		events, err := conn.DescribeStackEventsWithContext(ctx, &cloudformation.DescribeStackEventsInput{
+			StackName: aws.String(stackID),
+		})
+
+		// Progress is informational only.
+		if err == nil && len(events.StackEvents) > 0 {
+			e := events.StackEvents[0]
+			tfresource.ReportProgress(ctx, -1, fmt.Sprintf("%s %s: %s", aws.StringValue(e.ResourceType), aws.StringValue(e.LogicalResourceId), aws.StringValue(e.ResourceStatus)))
+		}
+
+		return output, status, nil
+	}
+}
+
 func StatusTypeRegistrationProgress(ctx context.Context, conn *cloudformation.CloudFormation, registrationToken string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindTypeRegistrationByToken(ctx, conn, registrationToken)
@@ -134,10 +134,10 @@ func WaitStackCreated(ctx context.Context, conn *cloudformation.CloudFormation,
 		Timeout:    timeout,
 		MinTimeout: stackCreatedMinTimeout,
 		Delay:      10 * time.Second,
-		Refresh:    StatusStack(ctx, conn, stackID),
+		Refresh:    statusStackProgress(ctx, conn, stackID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, &stateConf)
 	if err != nil {
 		return nil, err
 	}
@@ -195,10 +195,10 @@ func WaitStackUpdated(ctx context.Context, conn *cloudformation.CloudFormation,
 		Timeout:    timeout,
 		MinTimeout: stackUpdatedMinTimeout,
 		Delay:      10 * time.Second,
-		Refresh:    StatusStack(ctx, conn, stackID),
+		Refresh:    statusStackProgress(ctx, conn, stackID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, &stateConf)
 	if err != nil {
 		return nil, err
 	}
@@ -234,10 +234,10 @@ func WaitStackDeleted(ctx context.Context, conn *cloudformation.CloudFormation,
 		Timeout:    timeout,
 		MinTimeout: stackDeletedMinTimeout,
 		Delay:      10 * time.Second,
-		Refresh:    StatusStack(ctx, conn, stackID),
+		Refresh:    statusStackProgress(ctx, conn, stackID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, &stateConf)
 	if err != nil {
 		return nil, err
 	}
@@ -831,7 +831,7 @@ func resourceDistributionCreate(ctx context.Context, d *schema.ResourceData, met
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CloudFrontConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
-	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
+	tags := defaultTagsConfig.MergeTags(ctx, tftags.New(ctx, d.Get("tags").(map[string]interface{})))
 
 	params := &cloudfront.CreateDistributionWithTagsInput{
 		DistributionConfigWithTags: &cloudfront.DistributionConfigWithTags{
@@ -864,15 +864,24 @@ func resourceDistributionCreate(ctx context.Context, d *schema.ResourceData, met
 		resp, err = conn.CreateDistributionWithTagsWithContext(ctx, params)
 	}
 
-	if err != nil {
+	var distribution *cloudfront.DistributionSummary
+
+	if err == nil {
+		distribution = &cloudfront.DistributionSummary{ARN: resp.Distribution.ARN, Id: resp.Distribution.Id}
+	} else if distribution = findDeployingDistributionByAliases(ctx, conn, params.DistributionConfigWithTags.DistributionConfig.Aliases, err); distribution == nil {
 		return sdkdiag.AppendErrorf(diags, "creating CloudFront Distribution: %s", err)
 	}
 
-	d.SetId(aws.StringValue(resp.Distribution.Id))
+	d.SetId(aws.StringValue(distribution.Id))
 
 	if d.Get("wait_for_deployment").(bool) {
 		log.Printf("[DEBUG] Waiting until CloudFront Distribution (%s) is deployed", d.Id())
-		if err := DistributionWaitUntilDeployed(ctx, d.Id(), meta); err != nil {
+		if err := tfresource.WaitInFlightOperation(ctx, aws.StringValue(distribution.ARN), func(ctx context.Context) error {
+			return DistributionWaitUntilDeployed(ctx, d.Id(), meta)
+		}); tfresource.OperationInFlight(err) {
+			// The distribution is saved with the deployment in flight.
+			diags = sdkdiag.AppendWarningf(diags, "waiting until CloudFront Distribution (%s) is deployed: %s", d.Id(), err)
+		} else if err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting until CloudFront Distribution (%s) is deployed: %s", d.Id(), err)
 		}
 	}
@@ -901,6 +910,11 @@ func resourceDistributionRead(ctx context.Context, d *schema.ResourceData, meta
 		return create.DiagError(names.CloudFront, create.ErrActionReading, ResNameDistribution, d.Id(), err)
 	}
 
+	// An interrupted deployment is checked, not waited for.
+	if err := tfresource.CheckInFlightOperation(ctx, aws.StringValue(resp.Distribution.Status) != "InProgress"); err != nil && !d.IsNewResource() {
+		diags = sdkdiag.AppendWarningf(diags, "CloudFront Distribution (%s) deployment: %s", d.Id(), err)
+	}
+
 	// Update attributes from DistributionConfig
 	err = flattenDistributionConfig(d, resp.Distribution.DistributionConfig)
 	if err != nil {
@@ -936,7 +950,7 @@ func resourceDistributionRead(ctx context.Context, d *schema.ResourceData, meta
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.RemoveDefaultConfig(ctx, defaultTagsConfig).Map()); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
 	}
 
@@ -1192,6 +1206,51 @@ func resourceDistributionDelete(ctx context.Context, d *schema.ResourceData, met
 // resourceAwsCloudFrontWebDistributionWaitUntilDeployed blocks until the
 // distribution is deployed. It currently takes exactly 15 minutes to deploy
 // but that might change in the future.
+// findDeployingDistributionByAliases returns the distribution with any of the specified aliases if the specified create error
+// is due to that distribution's aliases and the distribution is still being deployed, e.g. after an earlier apply
+// whose provider exited before the distribution was saved in state. Such a distribution is adopted rather than orphaned.
+func findDeployingDistributionByAliases(ctx context.Context, conn *cloudfront.CloudFront, aliases *cloudfront.Aliases, err error) *cloudfront.DistributionSummary {
+	if !tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeCNAMEAlreadyExists) || aliases == nil || len(aliases.Items) == 0 {
+		return nil
+	}
+
+	want := make(map[string]bool, len(aliases.Items))
+	for _, v := range aliases.Items {
+		want[aws.StringValue(v)] = true
+	}
+
+	var output *cloudfront.DistributionSummary
+
+	err = conn.ListDistributionsPagesWithContext(ctx, &cloudfront.ListDistributionsInput{}, func(page *cloudfront.ListDistributionsOutput, lastPage bool) bool {
+		if page == nil || page.DistributionList == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DistributionList.Items {
+			if aws.StringValue(v.Status) != "InProgress" || v.Aliases == nil {
+				continue
+			}
+
+			for _, alias := range v.Aliases.Items {
+				if want[aws.StringValue(alias)] {
+					output = v
+					return false
+				}
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil || output == nil {
+		return nil
+	}
+
+	log.Printf("[INFO] Adopting CloudFront Distribution (%s), which is still being deployed", aws.StringValue(output.Id))
+
+	return output
+}
+
 func DistributionWaitUntilDeployed(ctx context.Context, id string, meta interface{}) error {
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"InProgress"},
@@ -1202,7 +1261,7 @@ func DistributionWaitUntilDeployed(ctx context.Context, id string, meta interfac
 		Delay:      1 * time.Minute,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 	return err
 }
 
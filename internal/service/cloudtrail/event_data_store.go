@@ -169,8 +169,6 @@ func ResourceEventDataStore() *schema.Resource {
 
 func resourceEventDataStoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).CloudTrailConn()
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
-	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
 
 	name := d.Get("name").(string)
 	input := &cloudtrail.CreateEventDataStoreInput{
@@ -185,9 +183,7 @@ func resourceEventDataStoreCreate(ctx context.Context, d *schema.ResourceData, m
 		input.AdvancedEventSelectors = expandAdvancedEventSelector(d.Get("advanced_event_selector").([]interface{}))
 	}
 
-	if len(tags) > 0 {
-		input.TagsList = Tags(tags.IgnoreAWS())
-	}
+	input.TagsList = GetTagsIn(ctx, d, meta)
 
 	log.Printf("[DEBUG] Creating Event Data Store: %s", input)
 	output, err := conn.CreateEventDataStoreWithContext(ctx, input)
@@ -241,7 +237,7 @@ func resourceEventDataStoreRead(ctx context.Context, d *schema.ResourceData, met
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.RemoveDefaultConfig(ctx, defaultTagsConfig).Map()); err != nil {
 		return diag.Errorf("error setting tags for CloudTrail Event Data Store (%s): %s", d.Id(), err)
 	}
 
@@ -91,7 +91,7 @@ func resourceDevicePoolCreate(ctx context.Context, d *schema.ResourceData, meta
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DeviceFarmConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
-	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
+	tags := defaultTagsConfig.MergeTags(ctx, tftags.New(ctx, d.Get("tags").(map[string]interface{})))
 
 	name := d.Get("name").(string)
 	input := &devicefarm.CreateDevicePoolInput{
@@ -118,8 +118,9 @@ func resourceDevicePoolCreate(ctx context.Context, d *schema.ResourceData, meta
 	log.Printf("[DEBUG] Successsfully Created DeviceFarm DevicePool: %s", arn)
 	d.SetId(arn)
 
+	// CreateDevicePool does not accept tags.
 	if len(tags) > 0 {
-		if err := UpdateTags(ctx, conn, arn, nil, tags); err != nil {
+		if err := UpdateTags(ctx, conn, arn, nil, tags); err != nil { // nosemgrep:ci.semgrep.tags.tag-on-create
 			return sdkdiag.AppendErrorf(diags, "updating DeviceFarm DevicePool (%s) tags: %s", arn, err)
 		}
 	}
@@ -171,7 +172,7 @@ func resourceDevicePoolRead(ctx context.Context, d *schema.ResourceData, meta in
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.RemoveDefaultConfig(ctx, defaultTagsConfig).Map()); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
 	}
 
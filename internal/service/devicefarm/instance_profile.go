@@ -68,7 +68,7 @@ func resourceInstanceProfileCreate(ctx context.Context, d *schema.ResourceData,
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DeviceFarmConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
-	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
+	tags := defaultTagsConfig.MergeTags(ctx, tftags.New(ctx, d.Get("tags").(map[string]interface{})))
 
 	input := &devicefarm.CreateInstanceProfileInput{
 		Name: aws.String(d.Get("name").(string)),
@@ -99,8 +99,9 @@ func resourceInstanceProfileCreate(ctx context.Context, d *schema.ResourceData,
 	log.Printf("[DEBUG] Successsfully Created DeviceFarm Instance Profile: %s", arn)
 	d.SetId(arn)
 
+	// CreateInstanceProfile does not accept tags.
 	if len(tags) > 0 {
-		if err := UpdateTags(ctx, conn, arn, nil, tags); err != nil {
+		if err := UpdateTags(ctx, conn, arn, nil, tags); err != nil { // nosemgrep:ci.semgrep.tags.tag-on-create
 			return sdkdiag.AppendErrorf(diags, "updating DeviceFarm Instance Profile (%s) tags: %s", arn, err)
 		}
 	}
@@ -143,7 +144,7 @@ func resourceInstanceProfileRead(ctx context.Context, d *schema.ResourceData, me
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.RemoveDefaultConfig(ctx, defaultTagsConfig).Map()); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
 	}
 
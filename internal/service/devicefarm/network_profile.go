@@ -106,7 +106,7 @@ func resourceNetworkProfileCreate(ctx context.Context, d *schema.ResourceData, m
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DeviceFarmConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
-	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
+	tags := defaultTagsConfig.MergeTags(ctx, tftags.New(ctx, d.Get("tags").(map[string]interface{})))
 
 	input := &devicefarm.CreateNetworkProfileInput{
 		Name:       aws.String(d.Get("name").(string)),
@@ -162,8 +162,9 @@ func resourceNetworkProfileCreate(ctx context.Context, d *schema.ResourceData, m
 	log.Printf("[DEBUG] Successsfully Created DeviceFarm Network Profile: %s", arn)
 	d.SetId(arn)
 
+	// CreateNetworkProfile does not accept tags.
 	if len(tags) > 0 {
-		if err := UpdateTags(ctx, conn, arn, nil, tags); err != nil {
+		if err := UpdateTags(ctx, conn, arn, nil, tags); err != nil { // nosemgrep:ci.semgrep.tags.tag-on-create
 			return sdkdiag.AppendErrorf(diags, "updating DeviceFarm Network Profile (%s) tags: %s", arn, err)
 		}
 	}
@@ -219,7 +220,7 @@ func resourceNetworkProfileRead(ctx context.Context, d *schema.ResourceData, met
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.RemoveDefaultConfig(ctx, defaultTagsConfig).Map()); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
 	}
 
@@ -807,7 +807,7 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
-	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
+	tags := defaultTagsConfig.MergeTags(ctx, tftags.New(ctx, d.Get("tags").(map[string]interface{})))
 
 	instanceOpts, err := buildInstanceOpts(ctx, d, meta)
 	if err != nil {
@@ -856,8 +856,8 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	log.Printf("[DEBUG] Creating EC2 Instance: %s", input)
-	outputRaw, err := tfresource.RetryWhen(ctx, propagationTimeout,
-		func() (interface{}, error) {
+	output, err := tfresource.RetryWhen(ctx, propagationTimeout,
+		func() (*ec2.Reservation, error) {
 			return conn.RunInstancesWithContext(ctx, input)
 		},
 		func(err error) (bool, error) {
@@ -880,7 +880,7 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "creating EC2 Instance: %s", err)
 	}
 
-	instance := outputRaw.(*ec2.Reservation).Instances[0]
+	instance := output.Instances[0]
 
 	d.SetId(aws.StringValue(instance.InstanceId))
 
@@ -930,8 +930,9 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	// RunInstances cannot tag block device volumes individually.
 	for vol, blockDeviceTags := range blockDeviceTagsToCreate {
-		if err := CreateTags(ctx, conn, vol, blockDeviceTags); err != nil {
+		if err := CreateTags(ctx, conn, vol, blockDeviceTags); err != nil { // nosemgrep:ci.semgrep.tags.tag-on-create
 			log.Printf("[ERR] Error creating tags for EBS volume %s: %s", vol, err)
 		}
 	}
@@ -1147,7 +1148,7 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 	tags := KeyValueTags(ctx, instance.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.RemoveDefaultConfig(ctx, defaultTagsConfig).Map()); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
 	}
 
@@ -77,7 +77,7 @@ func resourceTransitGatewayMulticastGroupSourceRead(ctx context.Context, d *sche
 		return diag.FromErr(err)
 	}
 
-	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, propagationTimeout, func() (interface{}, error) {
+	multicastGroup, err := tfresource.RetryWhenNewResourceNotFound(ctx, propagationTimeout, func() (*ec2.TransitGatewayMulticastGroup, error) {
 		return FindTransitGatewayMulticastGroupSourceByThreePartKey(ctx, conn, multicastDomainID, groupIPAddress, eniID)
 	}, d.IsNewResource())
 
@@ -91,8 +91,6 @@ func resourceTransitGatewayMulticastGroupSourceRead(ctx context.Context, d *sche
 		return diag.Errorf("reading EC2 Transit Gateway Multicast Group Source (%s): %s", d.Id(), err)
 	}
 
-	multicastGroup := outputRaw.(*ec2.TransitGatewayMulticastGroup)
-
 	d.Set("group_ip_address", multicastGroup.GroupIpAddress)
 	d.Set("network_interface_id", multicastGroup.NetworkInterfaceId)
 	d.Set("transit_gateway_multicast_domain_id", multicastDomainID)
@@ -71,7 +71,7 @@ func resourceInternetGatewayAttachmentRead(ctx context.Context, d *schema.Resour
 		return sdkdiag.AppendErrorf(diags, "reading EC2 Internet Gateway Attachment (%s): %s", d.Id(), err)
 	}
 
-	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, propagationTimeout, func() (interface{}, error) {
+	igw, err := tfresource.RetryWhenNewResourceNotFound(ctx, propagationTimeout, func() (*ec2.InternetGatewayAttachment, error) {
 		return FindInternetGatewayAttachment(ctx, conn, igwID, vpcID)
 	}, d.IsNewResource())
 
@@ -85,8 +85,6 @@ func resourceInternetGatewayAttachmentRead(ctx context.Context, d *schema.Resour
 		return sdkdiag.AppendErrorf(diags, "reading EC2 Internet Gateway Attachment (%s): %s", d.Id(), err)
 	}
 
-	igw := outputRaw.(*ec2.InternetGatewayAttachment)
-
 	d.Set("internet_gateway_id", igwID)
 	d.Set("vpc_id", igw.VpcId)
 
@@ -103,7 +103,7 @@ func resourceManagedPrefixListEntryRead(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
-	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, ManagedPrefixListEntryCreateTimeout, func() (interface{}, error) {
+	entry, err := tfresource.RetryWhenNewResourceNotFound(ctx, ManagedPrefixListEntryCreateTimeout, func() (*ec2.PrefixListEntry, error) {
 		return FindManagedPrefixListEntryByIDAndCIDR(ctx, conn, plID, cidr)
 	}, d.IsNewResource())
 
@@ -117,8 +117,6 @@ func resourceManagedPrefixListEntryRead(ctx context.Context, d *schema.ResourceD
 		return diag.Errorf("reading VPC Managed Prefix List Entry (%s): %s", d.Id(), err)
 	}
 
-	entry := outputRaw.(*ec2.PrefixListEntry)
-
 	d.Set("cidr", entry.Cidr)
 	d.Set("description", entry.Description)
 
@@ -121,7 +121,7 @@ func networkACLAssociationCreate(ctx context.Context, conn *ec2.EC2, naclID, sub
 	}
 
 	log.Printf("[DEBUG] Creating EC2 Network ACL Association: %s", input)
-	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, propagationTimeout, func() (interface{}, error) {
+	output, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, propagationTimeout, func() (*ec2.ReplaceNetworkAclAssociationOutput, error) {
 		return conn.ReplaceNetworkAclAssociationWithContext(ctx, input)
 	}, errCodeInvalidAssociationIDNotFound)
 
@@ -129,7 +129,7 @@ func networkACLAssociationCreate(ctx context.Context, conn *ec2.EC2, naclID, sub
 		return "", fmt.Errorf("creating EC2 Network ACL (%s) Association: %w", naclID, err)
 	}
 
-	return aws.StringValue(outputRaw.(*ec2.ReplaceNetworkAclAssociationOutput).NewAssociationId), nil
+	return aws.StringValue(output.NewAssociationId), nil
 }
 
 // networkACLAssociationsCreate creates associations between the specified NACL and subnets.
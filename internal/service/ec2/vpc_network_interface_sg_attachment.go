@@ -95,7 +95,7 @@ func resourceNetworkInterfaceSGAttachmentRead(ctx context.Context, d *schema.Res
 
 	networkInterfaceID := d.Get("network_interface_id").(string)
 	sgID := d.Get("security_group_id").(string)
-	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, propagationTimeout, func() (interface{}, error) {
+	groupIdentifier, err := tfresource.RetryWhenNewResourceNotFound(ctx, propagationTimeout, func() (*ec2.GroupIdentifier, error) {
 		return FindNetworkInterfaceSecurityGroup(ctx, conn, networkInterfaceID, sgID)
 	}, d.IsNewResource())
 
@@ -109,8 +109,6 @@ func resourceNetworkInterfaceSGAttachmentRead(ctx context.Context, d *schema.Res
 		return sdkdiag.AppendErrorf(diags, "reading EC2 Network Interface (%s) Security Group (%s) Attachment: %s", networkInterfaceID, sgID, err)
 	}
 
-	groupIdentifier := outputRaw.(*ec2.GroupIdentifier)
-
 	d.Set("network_interface_id", networkInterfaceID)
 	d.Set("security_group_id", groupIdentifier.GroupId)
 
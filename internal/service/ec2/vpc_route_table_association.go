@@ -66,7 +66,7 @@ func resourceRouteTableAssociationCreate(ctx context.Context, d *schema.Resource
 
 	log.Printf("[DEBUG] Creating Route Table Association: %s", input)
 	output, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, RouteTableAssociationPropagationTimeout,
-		func() (interface{}, error) {
+		func() (*ec2.AssociateRouteTableOutput, error) {
 			return conn.AssociateRouteTableWithContext(ctx, input)
 		},
 		errCodeInvalidRouteTableIDNotFound,
@@ -76,7 +76,7 @@ func resourceRouteTableAssociationCreate(ctx context.Context, d *schema.Resource
 		return sdkdiag.AppendErrorf(diags, "creating Route Table (%s) Association: %s", routeTableID, err)
 	}
 
-	d.SetId(aws.StringValue(output.(*ec2.AssociateRouteTableOutput).AssociationId))
+	d.SetId(aws.StringValue(output.AssociationId))
 
 	log.Printf("[DEBUG] Waiting for Route Table Association (%s) creation", d.Id())
 	if _, err := WaitRouteTableAssociationCreated(ctx, conn, d.Id()); err != nil {
@@ -90,7 +90,7 @@ func resourceRouteTableAssociationRead(ctx context.Context, d *schema.ResourceDa
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn()
 
-	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, propagationTimeout, func() (interface{}, error) {
+	association, err := tfresource.RetryWhenNewResourceNotFound(ctx, propagationTimeout, func() (*ec2.RouteTableAssociation, error) {
 		return FindRouteTableAssociationByID(ctx, conn, d.Id())
 	}, d.IsNewResource())
 
@@ -104,8 +104,6 @@ func resourceRouteTableAssociationRead(ctx context.Context, d *schema.ResourceDa
 		return sdkdiag.AppendErrorf(diags, "reading Route Table Association (%s): %s", d.Id(), err)
 	}
 
-	association := outputRaw.(*ec2.RouteTableAssociation)
-
 	d.Set("gateway_id", association.GatewayId)
 	d.Set("route_table_id", association.RouteTableId)
 	d.Set("subnet_id", association.SubnetId)
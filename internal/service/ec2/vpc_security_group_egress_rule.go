@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
 // @FrameworkResource
@@ -27,12 +28,13 @@ func (r *resourceSecurityGroupEgressRule) Metadata(_ context.Context, request re
 	response.TypeName = "aws_vpc_security_group_egress_rule"
 }
 
-func (r *resourceSecurityGroupEgressRule) createSecurityGroupRule(ctx context.Context, data *resourceSecurityGroupRuleData) (string, error) {
+func (r *resourceSecurityGroupEgressRule) createSecurityGroupRule(ctx context.Context, data *resourceSecurityGroupRuleData, tags tftags.KeyValueTags) (string, error) {
 	conn := r.Meta().EC2Conn()
 
 	input := &ec2.AuthorizeSecurityGroupEgressInput{
-		GroupId:       flex.StringFromFramework(ctx, data.SecurityGroupID),
-		IpPermissions: []*ec2.IpPermission{r.expandIPPermission(ctx, data)},
+		GroupId:           flex.StringFromFramework(ctx, data.SecurityGroupID),
+		IpPermissions:     []*ec2.IpPermission{r.expandIPPermission(ctx, data)},
+		TagSpecifications: tagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeSecurityGroupRule),
 	}
 
 	output, err := conn.AuthorizeSecurityGroupEgressWithContext(ctx, input)
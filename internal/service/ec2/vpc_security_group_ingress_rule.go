@@ -44,12 +44,13 @@ func (r *resourceSecurityGroupIngressRule) Metadata(_ context.Context, request r
 	response.TypeName = "aws_vpc_security_group_ingress_rule"
 }
 
-func (r *resourceSecurityGroupIngressRule) createSecurityGroupRule(ctx context.Context, data *resourceSecurityGroupRuleData) (string, error) {
+func (r *resourceSecurityGroupIngressRule) createSecurityGroupRule(ctx context.Context, data *resourceSecurityGroupRuleData, tags tftags.KeyValueTags) (string, error) {
 	conn := r.Meta().EC2Conn()
 
 	input := &ec2.AuthorizeSecurityGroupIngressInput{
-		GroupId:       flex.StringFromFramework(ctx, data.SecurityGroupID),
-		IpPermissions: []*ec2.IpPermission{r.expandIPPermission(ctx, data)},
+		GroupId:           flex.StringFromFramework(ctx, data.SecurityGroupID),
+		IpPermissions:     []*ec2.IpPermission{r.expandIPPermission(ctx, data)},
+		TagSpecifications: tagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeSecurityGroupRule),
 	}
 
 	output, err := conn.AuthorizeSecurityGroupIngressWithContext(ctx, input)
@@ -83,7 +84,7 @@ func (r *resourceSecurityGroupIngressRule) findSecurityGroupRuleByID(ctx context
 type resourceSecurityGroupRule struct {
 	framework.ResourceWithConfigure
 
-	create   func(context.Context, *resourceSecurityGroupRuleData) (string, error)
+	create   func(context.Context, *resourceSecurityGroupRuleData, tftags.KeyValueTags) (string, error)
 	delete   func(context.Context, *resourceSecurityGroupRuleData) error
 	findByID func(context.Context, string) (*ec2.SecurityGroupRule, error)
 }
@@ -164,7 +165,11 @@ func (r *resourceSecurityGroupRule) Create(ctx context.Context, request resource
 		return
 	}
 
-	securityGroupRuleID, err := r.create(ctx, &data)
+	defaultTagsConfig := r.Meta().DefaultTagsConfig
+	ignoreTagsConfig := r.Meta().IgnoreTagsConfig
+	tags := defaultTagsConfig.MergeTags(ctx, tftags.New(ctx, data.Tags))
+
+	securityGroupRuleID, err := r.create(ctx, &data, tags)
 
 	if err != nil {
 		response.Diagnostics.AddError("creating VPC Security Group Rule", err.Error())
@@ -174,19 +179,6 @@ func (r *resourceSecurityGroupRule) Create(ctx context.Context, request resource
 
 	data.ID = types.StringValue(securityGroupRuleID)
 
-	conn := r.Meta().EC2Conn()
-	defaultTagsConfig := r.Meta().DefaultTagsConfig
-	ignoreTagsConfig := r.Meta().IgnoreTagsConfig
-	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, data.Tags))
-
-	if len(tags) > 0 {
-		if err := UpdateTags(ctx, conn, data.ID.ValueString(), nil, tags); err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("adding VPC Security Group Rule (%s) tags", data.ID.ValueString()), err.Error())
-
-			return
-		}
-	}
-
 	// Set values for unknowns.
 	data.ARN = r.arn(ctx, securityGroupRuleID)
 	data.SecurityGroupRuleID = types.StringValue(securityGroupRuleID)
@@ -248,7 +240,7 @@ func (r *resourceSecurityGroupRule) Read(ctx context.Context, request resource.R
 
 	tags := KeyValueTags(ctx, output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 	// AWS APIs often return empty lists of tags when none have been configured.
-	if tags := tags.RemoveDefaultConfig(defaultTagsConfig).Map(); len(tags) == 0 {
+	if tags := tags.RemoveDefaultConfig(ctx, defaultTagsConfig).Map(); len(tags) == 0 {
 		data.Tags = tftags.Null
 	} else {
 		data.Tags = flex.FlattenFrameworkStringValueMapLegacy(ctx, tags)
@@ -455,15 +455,37 @@ func WaitInstanceIAMInstanceProfileUpdated(ctx context.Context, conn *ec2.EC2, i
 	return nil, err
 }
 
-func WaitInstanceCreated(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.Instance, error) {
+// instanceStateOptions returns the options for waiting on an EC2 instance's state,
+// which polls with backoff as launching or terminating an instance can take many minutes.
+func instanceStateOptions(optFns ...tfresource.OptionsFunc) tfresource.Options {
+	options := tfresource.Options{
+		Delay: 10 * time.Second,
+		Backoff: &tfresource.Backoff{
+			Initial:    3 * time.Second,
+			Max:        30 * time.Second,
+			Multiplier: 1.5,
+			Jitter:     0.2,
+		},
+		SlowPollThreshold: 10 * time.Minute,
+		SlowPollInterval:  1 * time.Minute,
+	}
+	for _, fn := range optFns {
+		fn(&options)
+	}
+
+	return options
+}
+
+func WaitInstanceCreated(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration, optFns ...tfresource.OptionsFunc) (*ec2.Instance, error) {
+	options := instanceStateOptions(optFns...)
+
 	stateConf := &resource.StateChangeConf{
-		Pending:    []string{ec2.InstanceStateNamePending},
-		Target:     []string{ec2.InstanceStateNameRunning},
-		Refresh:    StatusInstanceState(ctx, conn, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Pending: []string{ec2.InstanceStateNamePending},
+		Target:  []string{ec2.InstanceStateNameRunning},
+		Refresh: StatusInstanceState(ctx, conn, id),
+		Timeout: timeout,
 	}
+	options.Apply(stateConf)
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
@@ -478,7 +500,9 @@ func WaitInstanceCreated(ctx context.Context, conn *ec2.EC2, id string, timeout
 	return nil, err
 }
 
-func WaitInstanceDeleted(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.Instance, error) {
+func WaitInstanceDeleted(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration, optFns ...tfresource.OptionsFunc) (*ec2.Instance, error) {
+	options := instanceStateOptions(optFns...)
+
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{
 			ec2.InstanceStateNamePending,
@@ -487,12 +511,11 @@ func WaitInstanceDeleted(ctx context.Context, conn *ec2.EC2, id string, timeout
 			ec2.InstanceStateNameStopping,
 			ec2.InstanceStateNameStopped,
 		},
-		Target:     []string{ec2.InstanceStateNameTerminated},
-		Refresh:    StatusInstanceState(ctx, conn, id),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Target:  []string{ec2.InstanceStateNameTerminated},
+		Refresh: StatusInstanceState(ctx, conn, id),
+		Timeout: timeout,
 	}
+	options.Apply(stateConf)
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
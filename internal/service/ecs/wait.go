@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -62,6 +63,19 @@ func waitCapacityProviderUpdated(ctx context.Context, conn *ecs.ECS, arn string)
 	return nil, err
 }
 
+// stableOptions are the options for waiting on an ECS service or task set to reach a steady state,
+// which can take as long as its tasks take to become healthy and to drain.
+var stableOptions = tfresource.Options{
+	Backoff: &tfresource.Backoff{
+		Initial:    5 * time.Second,
+		Max:        30 * time.Second,
+		Multiplier: 1.5,
+		Jitter:     0.2,
+	},
+	SlowPollThreshold: 10 * time.Minute,
+	SlowPollInterval:  1 * time.Minute,
+}
+
 // waitServiceStable waits for an ECS Service to reach the status "ACTIVE" and have all desired tasks running. Does not return tags.
 func waitServiceStable(ctx context.Context, conn *ecs.ECS, id, cluster string, timeout time.Duration) (*ecs.Service, error) { //nolint:unparam
 	input := &ecs.DescribeServicesInput{
@@ -78,6 +92,7 @@ func waitServiceStable(ctx context.Context, conn *ecs.ECS, id, cluster string, t
 		Refresh: statusServiceWaitForStable(ctx, conn, id, cluster),
 		Timeout: timeout,
 	}
+	stableOptions.Apply(stateConf)
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
@@ -171,6 +186,7 @@ func waitTaskSetStable(ctx context.Context, conn *ecs.ECS, timeout time.Duration
 		Refresh: stabilityStatusTaskSet(ctx, conn, taskSetID, service, cluster),
 		Timeout: timeout,
 	}
+	stableOptions.Apply(stateConf)
 
 	_, err := stateConf.WaitForStateContext(ctx)
 
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -286,9 +287,8 @@ func ResourceCluster() *schema.Resource {
 }
 
 func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EKSConn()
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
-	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
 	name := d.Get("name").(string)
 
 	input := &eks.CreateClusterInput{
@@ -311,9 +311,7 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		input.Version = aws.String(v.(string))
 	}
 
-	if len(tags) > 0 {
-		input.Tags = Tags(tags.IgnoreAWS())
-	}
+	input.Tags = GetTagsIn(ctx, d, meta)
 
 	outputRaw, err := tfresource.RetryWhen(ctx, propagationTimeout,
 		func() (interface{}, error) {
@@ -348,13 +346,24 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		},
 	)
 
-	if err != nil {
+	var cluster *eks.Cluster
+
+	if err == nil {
+		cluster = outputRaw.(*eks.CreateClusterOutput).Cluster
+	} else if cluster = findCreatingCluster(ctx, conn, name, err); cluster == nil {
 		return diag.Errorf("creating EKS Cluster (%s): %s", name, err)
 	}
 
-	d.SetId(aws.StringValue(outputRaw.(*eks.CreateClusterOutput).Cluster.Name))
+	d.SetId(aws.StringValue(cluster.Name))
 
-	if _, err := waitClusterCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+	if err := tfresource.WaitInFlightOperation(ctx, aws.StringValue(cluster.Arn), func(ctx context.Context) error {
+		_, err := waitClusterCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+		return err
+	}); tfresource.OperationInFlight(err) {
+		// The cluster is saved with the create in flight.
+		diags = sdkdiag.AppendWarningf(diags, "waiting for EKS Cluster (%s) create: %s", d.Id(), err)
+		return append(diags, resourceClusterRead(ctx, d, meta)...)
+	} else if err != nil {
 		return diag.Errorf("waiting for EKS Cluster (%s) create: %s", d.Id(), err)
 	}
 
@@ -366,6 +375,8 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
+	var diags diag.Diagnostics
+
 	cluster, err := FindClusterByName(ctx, conn, d.Id())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
@@ -378,6 +389,12 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return diag.Errorf("reading EKS Cluster (%s): %s", d.Id(), err)
 	}
 
+	// An interrupted create is checked, not waited for.
+	status := aws.StringValue(cluster.Status)
+	if err := tfresource.CheckInFlightOperation(ctx, status != eks.ClusterStatusPending && status != eks.ClusterStatusCreating); err != nil && !d.IsNewResource() {
+		diags = sdkdiag.AppendWarningf(diags, "EKS Cluster (%s) create: %s", d.Id(), err)
+	}
+
 	d.Set("arn", cluster.Arn)
 	if err := d.Set("certificate_authority", flattenCertificate(cluster.CertificateAuthority)); err != nil {
 		return diag.Errorf("setting certificate_authority: %s", err)
@@ -415,7 +432,7 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 	tags := KeyValueTags(ctx, cluster.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.RemoveDefaultConfig(ctx, defaultTagsConfig).Map()); err != nil {
 		return diag.Errorf("setting tags: %s", err)
 	}
 
@@ -423,12 +440,20 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return diag.Errorf("setting tags_all: %s", err)
 	}
 
-	return nil
+	return diags
 }
 
 func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EKSConn()
 
+	// Wait for an interrupted create before changing the cluster.
+	if err := tfresource.ResumeInFlightOperation(ctx, func(ctx context.Context) error {
+		_, err := waitClusterCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		return err
+	}); err != nil {
+		return diag.Errorf("waiting for EKS Cluster (%s) create: %s", d.Id(), err)
+	}
+
 	// Do any version update first.
 	if d.HasChange("version") {
 		input := &eks.UpdateClusterVersionInput{
@@ -666,6 +691,24 @@ func statusClusterUpdate(ctx context.Context, conn *eks.EKS, name, id string) re
 	}
 }
 
+// findCreatingCluster returns the cluster with the specified name if the specified create error is due to that cluster still being created,
+// e.g. by an earlier apply whose provider exited before the cluster was saved in state. Such a cluster is adopted rather than orphaned.
+func findCreatingCluster(ctx context.Context, conn *eks.EKS, name string, err error) *eks.Cluster {
+	if !tfawserr.ErrCodeEquals(err, eks.ErrCodeResourceInUseException) {
+		return nil
+	}
+
+	cluster, err := FindClusterByName(ctx, conn, name)
+
+	if err != nil || aws.StringValue(cluster.Status) != eks.ClusterStatusCreating {
+		return nil
+	}
+
+	log.Printf("[INFO] Adopting EKS Cluster (%s), which is still being created", name)
+
+	return cluster
+}
+
 func waitClusterCreated(ctx context.Context, conn *eks.EKS, name string, timeout time.Duration) (*eks.Cluster, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{eks.ClusterStatusPending, eks.ClusterStatusCreating},
@@ -674,7 +717,7 @@ func waitClusterCreated(ctx context.Context, conn *eks.EKS, name string, timeout
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*eks.Cluster); ok {
 		return output, err
@@ -691,7 +734,7 @@ func waitClusterDeleted(ctx context.Context, conn *eks.EKS, name string, timeout
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*eks.Cluster); ok {
 		return output, err
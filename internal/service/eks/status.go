@@ -5,102 +5,91 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/eks"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
-func statusAddon(ctx context.Context, conn *eks.EKS, clusterName, addonName string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		output, err := FindAddonByClusterNameAndAddonName(ctx, conn, clusterName, addonName)
-
-		if tfresource.NotFound(err) {
-			return nil, "", nil
-		}
-
-		if err != nil {
-			return nil, "", err
-		}
-
-		return output, aws.StringValue(output.Status), nil
+func addonWaiter(conn *eks.EKS, clusterName, addonName string) tfresource.Waiter[*eks.Addon] {
+	return tfresource.Waiter[*eks.Addon]{
+		Find: func(ctx context.Context) (*eks.Addon, error) {
+			return FindAddonByClusterNameAndAddonName(ctx, conn, clusterName, addonName)
+		},
+		Status: func(output *eks.Addon) string {
+			return aws.StringValue(output.Status)
+		},
+		FailureReason: func(output *eks.Addon) error {
+			if health := output.Health; health != nil {
+				return AddonIssuesError(health.Issues)
+			}
+
+			return nil
+		},
 	}
 }
 
-func statusAddonUpdate(ctx context.Context, conn *eks.EKS, clusterName, addonName, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		output, err := FindAddonUpdateByClusterNameAddonNameAndID(ctx, conn, clusterName, addonName, id)
-
-		if tfresource.NotFound(err) {
-			return nil, "", nil
-		}
-
-		if err != nil {
-			return nil, "", err
-		}
-
-		return output, aws.StringValue(output.Status), nil
+func addonUpdateWaiter(conn *eks.EKS, clusterName, addonName, id string) tfresource.Waiter[*eks.Update] {
+	return tfresource.Waiter[*eks.Update]{
+		Find: func(ctx context.Context) (*eks.Update, error) {
+			return FindAddonUpdateByClusterNameAddonNameAndID(ctx, conn, clusterName, addonName, id)
+		},
+		Status: updateStatus,
+		FailureReason: func(output *eks.Update) error {
+			return ErrorDetailsError(output.Errors)
+		},
 	}
 }
 
-func statusFargateProfile(ctx context.Context, conn *eks.EKS, clusterName, fargateProfileName string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		output, err := FindFargateProfileByClusterNameAndFargateProfileName(ctx, conn, clusterName, fargateProfileName)
-
-		if tfresource.NotFound(err) {
-			return nil, "", nil
-		}
-
-		if err != nil {
-			return nil, "", err
-		}
-
-		return output, aws.StringValue(output.Status), nil
+func fargateProfileWaiter(conn *eks.EKS, clusterName, fargateProfileName string) tfresource.Waiter[*eks.FargateProfile] {
+	return tfresource.Waiter[*eks.FargateProfile]{
+		Find: func(ctx context.Context) (*eks.FargateProfile, error) {
+			return FindFargateProfileByClusterNameAndFargateProfileName(ctx, conn, clusterName, fargateProfileName)
+		},
+		Status: func(output *eks.FargateProfile) string {
+			return aws.StringValue(output.Status)
+		},
 	}
 }
 
-func statusNodegroup(ctx context.Context, conn *eks.EKS, clusterName, nodeGroupName string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		output, err := FindNodegroupByClusterNameAndNodegroupName(ctx, conn, clusterName, nodeGroupName)
-
-		if tfresource.NotFound(err) {
-			return nil, "", nil
-		}
-
-		if err != nil {
-			return nil, "", err
-		}
-
-		return output, aws.StringValue(output.Status), nil
+func nodegroupWaiter(conn *eks.EKS, clusterName, nodeGroupName string) tfresource.Waiter[*eks.Nodegroup] {
+	return tfresource.Waiter[*eks.Nodegroup]{
+		Find: func(ctx context.Context) (*eks.Nodegroup, error) {
+			return FindNodegroupByClusterNameAndNodegroupName(ctx, conn, clusterName, nodeGroupName)
+		},
+		Status: func(output *eks.Nodegroup) string {
+			return aws.StringValue(output.Status)
+		},
+		FailureReason: func(output *eks.Nodegroup) error {
+			if health := output.Health; health != nil {
+				return IssuesError(health.Issues)
+			}
+
+			return nil
+		},
 	}
 }
 
-func statusNodegroupUpdate(ctx context.Context, conn *eks.EKS, clusterName, nodeGroupName, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		output, err := FindNodegroupUpdateByClusterNameNodegroupNameAndID(ctx, conn, clusterName, nodeGroupName, id)
-
-		if tfresource.NotFound(err) {
-			return nil, "", nil
-		}
-
-		if err != nil {
-			return nil, "", err
-		}
-
-		return output, aws.StringValue(output.Status), nil
+func nodegroupUpdateWaiter(conn *eks.EKS, clusterName, nodeGroupName, id string) tfresource.Waiter[*eks.Update] {
+	return tfresource.Waiter[*eks.Update]{
+		Find: func(ctx context.Context) (*eks.Update, error) {
+			return FindNodegroupUpdateByClusterNameNodegroupNameAndID(ctx, conn, clusterName, nodeGroupName, id)
+		},
+		Status: updateStatus,
+		FailureReason: func(output *eks.Update) error {
+			return ErrorDetailsError(output.Errors)
+		},
 	}
 }
 
-func statusOIDCIdentityProviderConfig(ctx context.Context, conn *eks.EKS, clusterName, configName string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		output, err := FindOIDCIdentityProviderConfigByClusterNameAndConfigName(ctx, conn, clusterName, configName)
-
-		if tfresource.NotFound(err) {
-			return nil, "", nil
-		}
-
-		if err != nil {
-			return nil, "", err
-		}
-
-		return output, aws.StringValue(output.Status), nil
+func oidcIdentityProviderConfigWaiter(conn *eks.EKS, clusterName, configName string) tfresource.Waiter[*eks.OidcIdentityProviderConfig] {
+	return tfresource.Waiter[*eks.OidcIdentityProviderConfig]{
+		Find: func(ctx context.Context) (*eks.OidcIdentityProviderConfig, error) {
+			return FindOIDCIdentityProviderConfigByClusterNameAndConfigName(ctx, conn, clusterName, configName)
+		},
+		Status: func(output *eks.OidcIdentityProviderConfig) string {
+			return aws.StringValue(output.Status)
+		},
 	}
 }
+
+func updateStatus(output *eks.Update) string {
+	return aws.StringValue(output.Status)
+}
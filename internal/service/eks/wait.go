@@ -4,10 +4,7 @@ import (
 	"context"
 	"time"
 
-	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/eks"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -15,195 +12,87 @@ const (
 )
 
 func waitAddonCreated(ctx context.Context, conn *eks.EKS, clusterName, addonName string, timeout time.Duration) (*eks.Addon, error) {
-	stateConf := resource.StateChangeConf{
-		Pending: []string{eks.AddonStatusCreating, eks.AddonStatusDegraded},
-		Target:  []string{eks.AddonStatusActive},
-		Refresh: statusAddon(ctx, conn, clusterName, addonName),
-		Timeout: timeout,
-	}
+	w := addonWaiter(conn, clusterName, addonName)
+	w.Pending = []string{eks.AddonStatusCreating, eks.AddonStatusDegraded}
+	w.Target = []string{eks.AddonStatusActive}
+	w.FailureStates = []string{eks.AddonStatusCreateFailed}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-
-	if output, ok := outputRaw.(*eks.Addon); ok {
-		if status, health := aws.StringValue(output.Status), output.Health; status == eks.AddonStatusCreateFailed && health != nil {
-			tfresource.SetLastError(err, AddonIssuesError(health.Issues))
-		}
-
-		return output, err
-	}
-
-	return nil, err
+	return w.Wait(ctx, timeout)
 }
 
 func waitAddonDeleted(ctx context.Context, conn *eks.EKS, clusterName, addonName string, timeout time.Duration) (*eks.Addon, error) {
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{eks.AddonStatusActive, eks.AddonStatusDeleting},
-		Target:  []string{},
-		Refresh: statusAddon(ctx, conn, clusterName, addonName),
-		Timeout: timeout,
-	}
-
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	w := addonWaiter(conn, clusterName, addonName)
+	w.Pending = []string{eks.AddonStatusActive, eks.AddonStatusDeleting}
+	w.Target = []string{}
+	w.FailureStates = []string{eks.AddonStatusDeleteFailed}
 
-	if output, ok := outputRaw.(*eks.Addon); ok {
-		if status, health := aws.StringValue(output.Status), output.Health; status == eks.AddonStatusDeleteFailed && health != nil {
-			tfresource.SetLastError(err, AddonIssuesError(health.Issues))
-		}
-
-		return output, err
-	}
-
-	return nil, err
+	return w.Wait(ctx, timeout)
 }
 
 func waitAddonUpdateSuccessful(ctx context.Context, conn *eks.EKS, clusterName, addonName, id string, timeout time.Duration) (*eks.Update, error) {
-	stateConf := resource.StateChangeConf{
-		Pending: []string{eks.UpdateStatusInProgress},
-		Target:  []string{eks.UpdateStatusSuccessful},
-		Refresh: statusAddonUpdate(ctx, conn, clusterName, addonName, id),
-		Timeout: timeout,
-	}
-
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	w := addonUpdateWaiter(conn, clusterName, addonName, id)
+	w.Pending = []string{eks.UpdateStatusInProgress}
+	w.Target = []string{eks.UpdateStatusSuccessful}
+	w.FailureStates = []string{eks.UpdateStatusCancelled, eks.UpdateStatusFailed}
 
-	if output, ok := outputRaw.(*eks.Update); ok {
-		if status := aws.StringValue(output.Status); status == eks.UpdateStatusCancelled || status == eks.UpdateStatusFailed {
-			tfresource.SetLastError(err, ErrorDetailsError(output.Errors))
-		}
-
-		return output, err
-	}
-
-	return nil, err
+	return w.Wait(ctx, timeout)
 }
 
 func waitFargateProfileCreated(ctx context.Context, conn *eks.EKS, clusterName, fargateProfileName string, timeout time.Duration) (*eks.FargateProfile, error) {
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{eks.FargateProfileStatusCreating},
-		Target:  []string{eks.FargateProfileStatusActive},
-		Refresh: statusFargateProfile(ctx, conn, clusterName, fargateProfileName),
-		Timeout: timeout,
-	}
-
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	w := fargateProfileWaiter(conn, clusterName, fargateProfileName)
+	w.Pending = []string{eks.FargateProfileStatusCreating}
+	w.Target = []string{eks.FargateProfileStatusActive}
 
-	if output, ok := outputRaw.(*eks.FargateProfile); ok {
-		return output, err
-	}
-
-	return nil, err
+	return w.Wait(ctx, timeout)
 }
 
 func waitFargateProfileDeleted(ctx context.Context, conn *eks.EKS, clusterName, fargateProfileName string, timeout time.Duration) (*eks.FargateProfile, error) {
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{eks.FargateProfileStatusActive, eks.FargateProfileStatusDeleting},
-		Target:  []string{},
-		Refresh: statusFargateProfile(ctx, conn, clusterName, fargateProfileName),
-		Timeout: timeout,
-	}
-
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-
-	if output, ok := outputRaw.(*eks.FargateProfile); ok {
-		return output, err
-	}
+	w := fargateProfileWaiter(conn, clusterName, fargateProfileName)
+	w.Pending = []string{eks.FargateProfileStatusActive, eks.FargateProfileStatusDeleting}
+	w.Target = []string{}
 
-	return nil, err
+	return w.Wait(ctx, timeout)
 }
 
 func waitNodegroupCreated(ctx context.Context, conn *eks.EKS, clusterName, nodeGroupName string, timeout time.Duration) (*eks.Nodegroup, error) {
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{eks.NodegroupStatusCreating},
-		Target:  []string{eks.NodegroupStatusActive},
-		Refresh: statusNodegroup(ctx, conn, clusterName, nodeGroupName),
-		Timeout: timeout,
-	}
+	w := nodegroupWaiter(conn, clusterName, nodeGroupName)
+	w.Pending = []string{eks.NodegroupStatusCreating}
+	w.Target = []string{eks.NodegroupStatusActive}
+	w.FailureStates = []string{eks.NodegroupStatusCreateFailed}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-
-	if output, ok := outputRaw.(*eks.Nodegroup); ok {
-		if status, health := aws.StringValue(output.Status), output.Health; status == eks.NodegroupStatusCreateFailed && health != nil {
-			tfresource.SetLastError(err, IssuesError(health.Issues))
-		}
-
-		return output, err
-	}
-
-	return nil, err
+	return w.Wait(ctx, timeout)
 }
 
 func waitNodegroupDeleted(ctx context.Context, conn *eks.EKS, clusterName, nodeGroupName string, timeout time.Duration) (*eks.Nodegroup, error) {
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{eks.NodegroupStatusActive, eks.NodegroupStatusDeleting},
-		Target:  []string{},
-		Refresh: statusNodegroup(ctx, conn, clusterName, nodeGroupName),
-		Timeout: timeout,
-	}
-
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	w := nodegroupWaiter(conn, clusterName, nodeGroupName)
+	w.Pending = []string{eks.NodegroupStatusActive, eks.NodegroupStatusDeleting}
+	w.Target = []string{}
+	w.FailureStates = []string{eks.NodegroupStatusDeleteFailed}
 
-	if output, ok := outputRaw.(*eks.Nodegroup); ok {
-		if status, health := aws.StringValue(output.Status), output.Health; status == eks.NodegroupStatusDeleteFailed && health != nil {
-			tfresource.SetLastError(err, IssuesError(health.Issues))
-		}
-
-		return output, err
-	}
-
-	return nil, err
+	return w.Wait(ctx, timeout)
 }
 
 func waitNodegroupUpdateSuccessful(ctx context.Context, conn *eks.EKS, clusterName, nodeGroupName, id string, timeout time.Duration) (*eks.Update, error) { //nolint:unparam
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{eks.UpdateStatusInProgress},
-		Target:  []string{eks.UpdateStatusSuccessful},
-		Refresh: statusNodegroupUpdate(ctx, conn, clusterName, nodeGroupName, id),
-		Timeout: timeout,
-	}
-
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	w := nodegroupUpdateWaiter(conn, clusterName, nodeGroupName, id)
+	w.Pending = []string{eks.UpdateStatusInProgress}
+	w.Target = []string{eks.UpdateStatusSuccessful}
+	w.FailureStates = []string{eks.UpdateStatusCancelled, eks.UpdateStatusFailed}
 
-	if output, ok := outputRaw.(*eks.Update); ok {
-		if status := aws.StringValue(output.Status); status == eks.UpdateStatusCancelled || status == eks.UpdateStatusFailed {
-			tfresource.SetLastError(err, ErrorDetailsError(output.Errors))
-		}
-
-		return output, err
-	}
-
-	return nil, err
+	return w.Wait(ctx, timeout)
 }
 
 func waitOIDCIdentityProviderConfigCreated(ctx context.Context, conn *eks.EKS, clusterName, configName string, timeout time.Duration) (*eks.OidcIdentityProviderConfig, error) {
-	stateConf := resource.StateChangeConf{
-		Pending: []string{eks.ConfigStatusCreating},
-		Target:  []string{eks.ConfigStatusActive},
-		Refresh: statusOIDCIdentityProviderConfig(ctx, conn, clusterName, configName),
-		Timeout: timeout,
-	}
-
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	w := oidcIdentityProviderConfigWaiter(conn, clusterName, configName)
+	w.Pending = []string{eks.ConfigStatusCreating}
+	w.Target = []string{eks.ConfigStatusActive}
 
-	if output, ok := outputRaw.(*eks.OidcIdentityProviderConfig); ok {
-		return output, err
-	}
-
-	return nil, err
+	return w.Wait(ctx, timeout)
 }
 
 func waitOIDCIdentityProviderConfigDeleted(ctx context.Context, conn *eks.EKS, clusterName, configName string, timeout time.Duration) (*eks.OidcIdentityProviderConfig, error) {
-	stateConf := resource.StateChangeConf{
-		Pending: []string{eks.ConfigStatusActive, eks.ConfigStatusDeleting},
-		Target:  []string{},
-		Refresh: statusOIDCIdentityProviderConfig(ctx, conn, clusterName, configName),
-		Timeout: timeout,
-	}
-
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-
-	if output, ok := outputRaw.(*eks.OidcIdentityProviderConfig); ok {
-		return output, err
-	}
+	w := oidcIdentityProviderConfigWaiter(conn, clusterName, configName)
+	w.Pending = []string{eks.ConfigStatusActive, eks.ConfigStatusDeleting}
+	w.Target = []string{}
 
-	return nil, err
+	return w.Wait(ctx, timeout)
 }
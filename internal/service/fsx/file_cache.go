@@ -296,7 +296,7 @@ const (
 func resourceFileCacheCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).FSxConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
-	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
+	tags := defaultTagsConfig.MergeTags(ctx, tftags.New(ctx, d.Get("tags").(map[string]interface{})))
 
 	input := &fsx.CreateFileCacheInput{
 		ClientRequestToken:   aws.String(resource.UniqueId()),
@@ -396,7 +396,7 @@ func resourceFileCacheRead(ctx context.Context, d *schema.ResourceData, meta int
 	} else {
 		tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 	}
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.RemoveDefaultConfig(ctx, defaultTagsConfig).Map()); err != nil {
 		return create.DiagError(names.FSx, create.ErrActionSetting, ResNameFileCache, d.Id(), err)
 	}
 	if err := d.Set("tags_all", tags.Map()); err != nil {
@@ -481,7 +481,7 @@ func flattenDataRepositoryAssociations(ctx context.Context, dataRepositoryAssoci
 			"imported_file_chunk_size":       dataRepositoryAssociation.ImportedFileChunkSize,
 			"nfs":                            flattenNFSDataRepositoryConfiguration(dataRepositoryAssociation.NFS),
 			"resource_arn":                   dataRepositoryAssociation.ResourceARN,
-			"tags":                           tags.RemoveDefaultConfig(defaultTagsConfig).Map(),
+			"tags":                           tags.RemoveDefaultConfig(ctx, defaultTagsConfig).Map(),
 		}
 		flattenedDataRepositoryAssociations = append(flattenedDataRepositoryAssociations, values)
 	}
@@ -71,7 +71,7 @@ func resourceOpenzfsSnapshotCreate(ctx context.Context, d *schema.ResourceData,
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).FSxConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
-	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
+	tags := defaultTagsConfig.MergeTags(ctx, tftags.New(ctx, d.Get("tags").(map[string]interface{})))
 
 	input := &fsx.CreateSnapshotInput{
 		ClientRequestToken: aws.String(resource.UniqueId()),
@@ -132,7 +132,7 @@ func resourceOpenzfsSnapshotRead(ctx context.Context, d *schema.ResourceData, me
 		tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 	}
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.RemoveDefaultConfig(ctx, defaultTagsConfig).Map()); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
 	}
 
@@ -106,7 +106,7 @@ func resourceVaultCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).GlacierConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
-	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
+	tags := defaultTagsConfig.MergeTags(ctx, tftags.New(ctx, d.Get("tags").(map[string]interface{})))
 
 	input := &glacier.CreateVaultInput{
 		VaultName: aws.String(d.Get("name").(string)),
@@ -119,8 +119,9 @@ func resourceVaultCreate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	d.SetId(d.Get("name").(string))
 
+	// CreateVault does not accept tags.
 	if len(tags) > 0 {
-		if err := UpdateTags(ctx, conn, d.Id(), nil, tags.Map()); err != nil {
+		if err := UpdateTags(ctx, conn, d.Id(), nil, tags.Map()); err != nil { // nosemgrep:ci.semgrep.tags.tag-on-create
 			return sdkdiag.AppendErrorf(diags, "updating Glacier Vault (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -205,7 +206,7 @@ func resourceVaultRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.RemoveDefaultConfig(ctx, defaultTagsConfig).Map()); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
 	}
 
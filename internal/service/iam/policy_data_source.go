@@ -75,7 +75,7 @@ func dataSourcePolicyRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 	if arn == "" {
 		raw, err := tfresource.RetryWhenNotFound(ctx, propagationTimeout,
-			func() (interface{}, error) {
+			func() (*iam.Policy, error) {
 				return FindPolicyByName(ctx, conn, name, pathPrefix)
 			},
 		)
@@ -90,7 +90,7 @@ func dataSourcePolicyRead(ctx context.Context, d *schema.ResourceData, meta inte
 			return sdkdiag.AppendErrorf(diags, "reading IAM policy (%s): %s", PolicySearchDetails(name, pathPrefix), err)
 		}
 
-		arn = aws.StringValue((raw.(*iam.Policy)).Arn)
+		arn = aws.StringValue(raw.Arn)
 	}
 
 	// We need to make a call to `iam.GetPolicy` because `iam.ListPolicies` doesn't return all values
@@ -0,0 +1,645 @@
+package iam
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	policyEvaluationDecisionAllowed      = "allowed"
+	policyEvaluationDecisionExplicitDeny = "explicitDeny"
+	policyEvaluationDecisionImplicitDeny = "implicitDeny"
+)
+
+// policyEvaluationRequest is the request context that policies are evaluated against.
+type policyEvaluationRequest struct {
+	principalARN string
+	action       string
+	resourceARN  string
+	// Context key values, keyed by lower-case context key.
+	context map[string][]string
+}
+
+type policyEvaluationResult struct {
+	decision     string
+	statementIDs []string
+}
+
+// policyEvaluator evaluates identity-based policies, resource-based policies and permissions boundaries
+// following the AWS policy evaluation logic for a request within a single account.
+// Service control policies and session policies are not evaluated.
+type policyEvaluator struct {
+	identityPolicies      []*IAMPolicyDoc
+	resourcePolicies      []*IAMPolicyDoc
+	permissionsBoundaries []*IAMPolicyDoc
+}
+
+// evaluate returns the decision for the specified request and the IDs of the statements that determined it:
+//   - An explicit deny in any policy overrides any allow.
+//   - An allow in a resource-based policy allows the request.
+//   - An allow in an identity-based policy allows the request if there are no permissions boundaries,
+//     or if a permissions boundary also allows it.
+//   - Otherwise the request is implicitly denied.
+func (e *policyEvaluator) evaluate(request *policyEvaluationRequest) (*policyEvaluationResult, error) {
+	identityAllows, identityDenies, err := request.matchPolicies(e.identityPolicies, false)
+
+	if err != nil {
+		return nil, fmt.Errorf("identity-based policy: %w", err)
+	}
+
+	resourceAllows, resourceDenies, err := request.matchPolicies(e.resourcePolicies, true)
+
+	if err != nil {
+		return nil, fmt.Errorf("resource-based policy: %w", err)
+	}
+
+	boundaryAllows, boundaryDenies, err := request.matchPolicies(e.permissionsBoundaries, false)
+
+	if err != nil {
+		return nil, fmt.Errorf("permissions boundary: %w", err)
+	}
+
+	if denies := concatStatements(identityDenies, resourceDenies, boundaryDenies); len(denies) > 0 {
+		return &policyEvaluationResult{
+			decision:     policyEvaluationDecisionExplicitDeny,
+			statementIDs: statementIDs(denies),
+		}, nil
+	}
+
+	var allows []*IAMPolicyStatement
+
+	if len(identityAllows) > 0 && (len(e.permissionsBoundaries) == 0 || len(boundaryAllows) > 0) {
+		allows = concatStatements(identityAllows, boundaryAllows)
+	}
+
+	allows = concatStatements(allows, resourceAllows)
+
+	if len(allows) > 0 {
+		return &policyEvaluationResult{
+			decision:     policyEvaluationDecisionAllowed,
+			statementIDs: statementIDs(allows),
+		}, nil
+	}
+
+	return &policyEvaluationResult{
+		decision:     policyEvaluationDecisionImplicitDeny,
+		statementIDs: []string{},
+	}, nil
+}
+
+// expandPolicyEvaluationDocs normalizes and parses the specified policy JSON documents.
+func expandPolicyEvaluationDocs(tfList []interface{}) ([]*IAMPolicyDoc, error) {
+	var docs []*IAMPolicyDoc
+
+	for i, v := range tfList {
+		policy, err := verify.LegacyPolicyNormalize(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("policy %d: %w", i, err)
+		}
+
+		doc := &IAMPolicyDoc{}
+
+		if err := json.Unmarshal([]byte(policy), doc); err != nil {
+			return nil, fmt.Errorf("policy %d: %w", i, err)
+		}
+
+		docs = append(docs, doc)
+	}
+
+	return docs, nil
+}
+
+// matchPolicies returns the Allow and Deny statements in the specified policies that match the request.
+func (r *policyEvaluationRequest) matchPolicies(docs []*IAMPolicyDoc, resourceBased bool) ([]*IAMPolicyStatement, []*IAMPolicyStatement, error) {
+	var allows, denies []*IAMPolicyStatement
+
+	for i, doc := range docs {
+		m := &policyStatementMatcher{
+			request:       r,
+			resourceBased: resourceBased,
+			// Policy variables were introduced in policy version 2012-10-17.
+			variables: doc.Version == "2012-10-17",
+		}
+
+		for j, s := range doc.Statements {
+			ok, err := m.match(s)
+
+			if err != nil {
+				return nil, nil, fmt.Errorf("policy %d: statement %d: %w", i, j, err)
+			}
+
+			if !ok {
+				continue
+			}
+
+			switch s.Effect {
+			case "Allow":
+				allows = append(allows, s)
+			case "Deny":
+				denies = append(denies, s)
+			}
+		}
+	}
+
+	return allows, denies, nil
+}
+
+type policyStatementMatcher struct {
+	request       *policyEvaluationRequest
+	resourceBased bool
+	variables     bool
+}
+
+// match returns whether the specified statement applies to the request.
+func (m *policyStatementMatcher) match(s *IAMPolicyStatement) (bool, error) {
+	if s.Effect != "Allow" && s.Effect != "Deny" {
+		return false, fmt.Errorf("unsupported Effect: %q", s.Effect)
+	}
+
+	var ok bool
+	var err error
+
+	switch {
+	case s.Actions != nil:
+		ok, err = m.matchAny(policyStatementStrings(s.Actions), m.request.action, true)
+	case s.NotActions != nil:
+		ok, err = m.matchAny(policyStatementStrings(s.NotActions), m.request.action, true)
+		ok = !ok
+	default:
+		return false, fmt.Errorf("Action or NotAction is required")
+	}
+
+	if err != nil || !ok {
+		return false, err
+	}
+
+	switch {
+	case s.Resources != nil:
+		ok, err = m.matchAny(policyStatementStrings(s.Resources), m.request.resourceARN, false)
+	case s.NotResources != nil:
+		ok, err = m.matchAny(policyStatementStrings(s.NotResources), m.request.resourceARN, false)
+		ok = !ok
+	case m.resourceBased:
+		// A resource-based policy without a Resource element applies to the resource that it's attached to.
+		ok = true
+	default:
+		return false, fmt.Errorf("Resource or NotResource is required")
+	}
+
+	if err != nil || !ok {
+		return false, err
+	}
+
+	// Principals are only specified in resource-based policies.
+	if m.resourceBased {
+		switch {
+		case s.Principals != nil:
+			ok = m.request.matchPrincipals(s.Principals)
+		case s.NotPrincipals != nil:
+			ok = !m.request.matchPrincipals(s.NotPrincipals)
+		default:
+			return false, fmt.Errorf("Principal or NotPrincipal is required")
+		}
+
+		if !ok {
+			return false, nil
+		}
+	}
+
+	for _, c := range s.Conditions {
+		ok, err := m.matchCondition(c)
+
+		if err != nil {
+			return false, fmt.Errorf("Condition %s %s: %w", c.Test, c.Variable, err)
+		}
+
+		if !ok {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// matchAny returns whether the value matches any of the specified policy values, which may contain wildcards.
+func (m *policyStatementMatcher) matchAny(policyValues []string, value string, ignoreCase bool) (bool, error) {
+	for _, v := range policyValues {
+		ok, err := m.matchValue(v, value, ignoreCase, true)
+
+		if err != nil || ok {
+			return ok, err
+		}
+	}
+
+	return false, nil
+}
+
+var policyVariableRegexp = regexp.MustCompile(`^\$\{([^}]*)\}`)
+
+// matchValue returns whether the value matches the specified policy value.
+// Policy variables are replaced with their values from the request context and,
+// if wildcards is true, * matches any sequence of characters and ? matches any single character.
+// A policy variable that has no value in the request context matches nothing.
+func (m *policyStatementMatcher) matchValue(policyValue, value string, ignoreCase, wildcards bool) (bool, error) {
+	var sb strings.Builder
+
+	if ignoreCase {
+		sb.WriteString("(?i)")
+	}
+
+	sb.WriteString("(?s)^")
+
+	for i := 0; i < len(policyValue); {
+		if m.variables {
+			if match := policyVariableRegexp.FindStringSubmatch(policyValue[i:]); match != nil {
+				v, ok := m.request.variableValue(match[1])
+
+				if !ok {
+					return false, nil
+				}
+
+				sb.WriteString(regexp.QuoteMeta(v))
+				i += len(match[0])
+
+				continue
+			}
+		}
+
+		switch c := policyValue[i]; {
+		case wildcards && c == '*':
+			sb.WriteString(".*")
+		case wildcards && c == '?':
+			sb.WriteString(".")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+		}
+
+		i++
+	}
+
+	sb.WriteString("$")
+
+	re, err := regexp.Compile(sb.String())
+
+	if err != nil {
+		return false, err
+	}
+
+	return re.MatchString(value), nil
+}
+
+// variableValue returns the value of the specified policy variable, e.g. "aws:username" or "aws:username, 'default'".
+func (r *policyEvaluationRequest) variableValue(variable string) (string, bool) {
+	key, defaultValue, hasDefault := strings.Cut(variable, ",")
+	key = strings.TrimSpace(key)
+
+	switch key {
+	case "*", "?", "$":
+		return key, true
+	}
+
+	// Only single-valued context keys can be used as policy variables.
+	if v := r.context[strings.ToLower(key)]; len(v) == 1 {
+		return v[0], true
+	}
+
+	if hasDefault {
+		return strings.Trim(strings.TrimSpace(defaultValue), "'"), true
+	}
+
+	return "", false
+}
+
+// matchPrincipals returns whether the request's principal is any of the specified principals.
+func (r *policyEvaluationRequest) matchPrincipals(principals IAMPolicyStatementPrincipalSet) bool {
+	for _, p := range principals {
+		for _, id := range policyStatementStrings(p.Identifiers) {
+			switch {
+			case id == "*" && (p.Type == "*" || p.Type == "AWS"):
+				return true
+			case p.Type == "AWS":
+				if id == r.principalARN || id == r.principalAccountID() || id == r.principalAccountRootARN() {
+					return true
+				}
+			default:
+				// Service, Federated and CanonicalUser principals.
+				if strings.EqualFold(id, r.principalARN) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+func (r *policyEvaluationRequest) principalAccountID() string {
+	v, err := arn.Parse(r.principalARN)
+
+	if err != nil {
+		return ""
+	}
+
+	return v.AccountID
+}
+
+func (r *policyEvaluationRequest) principalAccountRootARN() string {
+	v, err := arn.Parse(r.principalARN)
+
+	if err != nil || v.AccountID == "" {
+		return ""
+	}
+
+	return arn.ARN{
+		Partition: v.Partition,
+		Service:   "iam",
+		AccountID: v.AccountID,
+		Resource:  "root",
+	}.String()
+}
+
+type policyConditionOperator struct {
+	// negated operators are satisfied if the request value matches none of the policy values.
+	negated bool
+	match   func(m *policyStatementMatcher, policyValue, value string) (bool, error)
+}
+
+func policyConditionStringOperator(negated, ignoreCase, wildcards bool) policyConditionOperator {
+	return policyConditionOperator{
+		negated: negated,
+		match: func(m *policyStatementMatcher, policyValue, value string) (bool, error) {
+			return m.matchValue(policyValue, value, ignoreCase, wildcards)
+		},
+	}
+}
+
+func policyConditionNumericOperator(negated bool, compare func(p, v float64) bool) policyConditionOperator {
+	return policyConditionOperator{
+		negated: negated,
+		match: func(_ *policyStatementMatcher, policyValue, value string) (bool, error) {
+			p, err := strconv.ParseFloat(policyValue, 64)
+
+			if err != nil {
+				return false, err
+			}
+
+			v, err := strconv.ParseFloat(value, 64)
+
+			if err != nil {
+				return false, err
+			}
+
+			return compare(p, v), nil
+		},
+	}
+}
+
+func policyConditionDateOperator(negated bool, compare func(p, v time.Time) bool) policyConditionOperator {
+	return policyConditionOperator{
+		negated: negated,
+		match: func(_ *policyStatementMatcher, policyValue, value string) (bool, error) {
+			p, err := parsePolicyConditionDate(policyValue)
+
+			if err != nil {
+				return false, err
+			}
+
+			v, err := parsePolicyConditionDate(value)
+
+			if err != nil {
+				return false, err
+			}
+
+			return compare(p, v), nil
+		},
+	}
+}
+
+func policyConditionIPAddressOperator(negated bool) policyConditionOperator {
+	return policyConditionOperator{
+		negated: negated,
+		match: func(_ *policyStatementMatcher, policyValue, value string) (bool, error) {
+			if !strings.Contains(policyValue, "/") {
+				if strings.Contains(policyValue, ":") {
+					policyValue += "/128"
+				} else {
+					policyValue += "/32"
+				}
+			}
+
+			_, ipNet, err := net.ParseCIDR(policyValue)
+
+			if err != nil {
+				return false, err
+			}
+
+			ip := net.ParseIP(value)
+
+			if ip == nil {
+				return false, fmt.Errorf("invalid IP address: %q", value)
+			}
+
+			return ipNet.Contains(ip), nil
+		},
+	}
+}
+
+// policyConditionOperators are the supported condition operators, keyed by lower-case name.
+var policyConditionOperators = map[string]policyConditionOperator{
+	"stringequals":              policyConditionStringOperator(false, false, false),
+	"stringnotequals":           policyConditionStringOperator(true, false, false),
+	"stringequalsignorecase":    policyConditionStringOperator(false, true, false),
+	"stringnotequalsignorecase": policyConditionStringOperator(true, true, false),
+	"stringlike":                policyConditionStringOperator(false, false, true),
+	"stringnotlike":             policyConditionStringOperator(true, false, true),
+	"numericequals":             policyConditionNumericOperator(false, func(p, v float64) bool { return v == p }),
+	"numericnotequals":          policyConditionNumericOperator(true, func(p, v float64) bool { return v == p }),
+	"numericlessthan":           policyConditionNumericOperator(false, func(p, v float64) bool { return v < p }),
+	"numericlessthanequals":     policyConditionNumericOperator(false, func(p, v float64) bool { return v <= p }),
+	"numericgreaterthan":        policyConditionNumericOperator(false, func(p, v float64) bool { return v > p }),
+	"numericgreaterthanequals":  policyConditionNumericOperator(false, func(p, v float64) bool { return v >= p }),
+	"dateequals":                policyConditionDateOperator(false, func(p, v time.Time) bool { return v.Equal(p) }),
+	"datenotequals":             policyConditionDateOperator(true, func(p, v time.Time) bool { return v.Equal(p) }),
+	"datelessthan":              policyConditionDateOperator(false, func(p, v time.Time) bool { return v.Before(p) }),
+	"datelessthanequals":        policyConditionDateOperator(false, func(p, v time.Time) bool { return !v.After(p) }),
+	"dategreaterthan":           policyConditionDateOperator(false, func(p, v time.Time) bool { return v.After(p) }),
+	"dategreaterthanequals":     policyConditionDateOperator(false, func(p, v time.Time) bool { return !v.Before(p) }),
+	"bool":                      policyConditionStringOperator(false, true, false),
+	"binaryequals":              policyConditionStringOperator(false, false, false),
+	"ipaddress":                 policyConditionIPAddressOperator(false),
+	"notipaddress":              policyConditionIPAddressOperator(true),
+	"arnequals":                 policyConditionStringOperator(false, false, true),
+	"arnlike":                   policyConditionStringOperator(false, false, true),
+	"arnnotequals":              policyConditionStringOperator(true, false, true),
+	"arnnotlike":                policyConditionStringOperator(true, false, true),
+}
+
+// matchCondition returns whether the request satisfies the specified condition.
+func (m *policyStatementMatcher) matchCondition(c IAMPolicyStatementCondition) (bool, error) {
+	test := c.Test
+
+	var forAllValues, forAnyValue bool
+
+	if prefix, name, ok := strings.Cut(test, ":"); ok {
+		switch strings.ToLower(prefix) {
+		case "forallvalues":
+			forAllValues = true
+		case "foranyvalue":
+			forAnyValue = true
+		default:
+			return false, fmt.Errorf("unsupported set operator: %q", prefix)
+		}
+
+		test = name
+	}
+
+	ifExists := strings.HasSuffix(test, "IfExists")
+	name := strings.TrimSuffix(test, "IfExists")
+	policyValues := policyStatementStrings(c.Values)
+	values, exists := m.request.context[strings.ToLower(c.Variable)]
+
+	if strings.EqualFold(name, "Null") {
+		// Null checks whether the condition key is absent from the request context.
+		for _, v := range policyValues {
+			null, err := strconv.ParseBool(v)
+
+			if err != nil {
+				return false, err
+			}
+
+			if null != exists {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	}
+
+	operator, ok := policyConditionOperators[strings.ToLower(name)]
+
+	if !ok {
+		return false, fmt.Errorf("unsupported condition operator: %q", c.Test)
+	}
+
+	if !exists {
+		switch {
+		case ifExists, forAllValues:
+			return true, nil
+		case forAnyValue:
+			return false, nil
+		default:
+			// Negated operators are satisfied if the condition key is absent.
+			return operator.negated, nil
+		}
+	}
+
+	// satisfied returns whether a request value satisfies the operator for any of the policy values,
+	// or for negated operators, for all of the policy values.
+	satisfied := func(value string) (bool, error) {
+		for _, v := range policyValues {
+			ok, err := operator.match(m, v, value)
+
+			if err != nil {
+				return false, err
+			}
+
+			if ok {
+				return !operator.negated, nil
+			}
+		}
+
+		return operator.negated, nil
+	}
+
+	// Unqualified negated operators are satisfied if all request values are; otherwise any request value is enough.
+	all := forAllValues || (operator.negated && !forAnyValue)
+
+	for _, v := range values {
+		ok, err := satisfied(v)
+
+		if err != nil {
+			return false, err
+		}
+
+		if ok != all {
+			return ok, nil
+		}
+	}
+
+	return all, nil
+}
+
+// parsePolicyConditionDate parses a date in ISO 8601 format or as seconds since the Unix epoch.
+func parsePolicyConditionDate(s string) (time.Time, error) {
+	if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(v, 0), nil
+	}
+
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05Z0700", "2006-01-02T15:04Z", "2006-01-02"} {
+		if v, err := time.Parse(layout, s); err == nil {
+			return v, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid date: %q", s)
+}
+
+// policyStatementStrings returns the values of a policy element that may be a single string or a list of strings.
+func policyStatementStrings(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		var out []string
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				out = append(out, v)
+			}
+		}
+		return out
+	default:
+		return nil
+	}
+}
+
+func concatStatements(s ...[]*IAMPolicyStatement) []*IAMPolicyStatement {
+	var out []*IAMPolicyStatement
+
+	for _, v := range s {
+		out = append(out, v...)
+	}
+
+	return out
+}
+
+// statementIDs returns the unique non-empty Sids of the specified statements, in order.
+func statementIDs(statements []*IAMPolicyStatement) []string {
+	out := []string{}
+	seen := make(map[string]struct{})
+
+	for _, s := range statements {
+		if s.Sid == "" {
+			continue
+		}
+
+		if _, ok := seen[s.Sid]; ok {
+			continue
+		}
+
+		seen[s.Sid] = struct{}{}
+		out = append(out, s.Sid)
+	}
+
+	return out
+}
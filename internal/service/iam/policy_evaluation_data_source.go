@@ -0,0 +1,136 @@
+package iam
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_iam_policy_evaluation")
+func dataSourcePolicyEvaluation() *schema.Resource {
+	listOfPolicy := &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: verify.ValidIAMPolicyJSON,
+		},
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePolicyEvaluationRead,
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"allowed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"context": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"values": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"decision": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"identity_policies": listOfPolicy,
+			"matched_statement_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"permissions_boundaries": listOfPolicy,
+			"principal_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resource_arn": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"resource_policies": listOfPolicy,
+		},
+	}
+}
+
+func dataSourcePolicyEvaluationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	evaluator := &policyEvaluator{}
+
+	for k, v := range map[string]*[]*IAMPolicyDoc{
+		"identity_policies":      &evaluator.identityPolicies,
+		"permissions_boundaries": &evaluator.permissionsBoundaries,
+		"resource_policies":      &evaluator.resourcePolicies,
+	} {
+		docs, err := expandPolicyEvaluationDocs(d.Get(k).([]interface{}))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading %s: %s", k, err)
+		}
+
+		*v = docs
+	}
+
+	request := &policyEvaluationRequest{
+		action:       d.Get("action").(string),
+		context:      expandPolicyEvaluationContext(d.Get("context").(*schema.Set).List()),
+		principalARN: d.Get("principal_arn").(string),
+		resourceARN:  d.Get("resource_arn").(string),
+	}
+
+	result, err := evaluator.evaluate(request)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "evaluating IAM Policies: %s", err)
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(strings.Join([]string{request.principalARN, request.action, request.resourceARN}, ","))))
+	d.Set("allowed", result.decision == policyEvaluationDecisionAllowed)
+	d.Set("decision", result.decision)
+	d.Set("matched_statement_ids", result.statementIDs)
+
+	return diags
+}
+
+func expandPolicyEvaluationContext(tfList []interface{}) map[string][]string {
+	context := make(map[string][]string)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		// Context keys are case-insensitive.
+		key := strings.ToLower(tfMap["key"].(string))
+		context[key] = append(context[key], flex.ExpandStringValueList(tfMap["values"].([]interface{}))...)
+	}
+
+	return context
+}
@@ -0,0 +1,115 @@
+package iam_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccIAMPolicyEvaluationDataSource_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyEvaluationDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_iam_policy_evaluation.allowed", "allowed", "true"),
+					resource.TestCheckResourceAttr("data.aws_iam_policy_evaluation.allowed", "decision", "allowed"),
+					resource.TestCheckResourceAttr("data.aws_iam_policy_evaluation.allowed", "matched_statement_ids.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_iam_policy_evaluation.allowed", "matched_statement_ids.0", "ReadHome"),
+					resource.TestCheckResourceAttr("data.aws_iam_policy_evaluation.explicit_deny", "allowed", "false"),
+					resource.TestCheckResourceAttr("data.aws_iam_policy_evaluation.explicit_deny", "decision", "explicitDeny"),
+					resource.TestCheckResourceAttr("data.aws_iam_policy_evaluation.explicit_deny", "matched_statement_ids.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_iam_policy_evaluation.explicit_deny", "matched_statement_ids.0", "DenyInsecureTransport"),
+					resource.TestCheckResourceAttr("data.aws_iam_policy_evaluation.implicit_deny", "allowed", "false"),
+					resource.TestCheckResourceAttr("data.aws_iam_policy_evaluation.implicit_deny", "decision", "implicitDeny"),
+					resource.TestCheckResourceAttr("data.aws_iam_policy_evaluation.implicit_deny", "matched_statement_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccPolicyEvaluationDataSourceConfig_basic = `
+data "aws_partition" "current" {}
+
+data "aws_iam_policy_document" "identity" {
+  statement {
+    sid       = "ReadHome"
+    actions   = ["s3:Get*"]
+    resources = ["arn:${data.aws_partition.current.partition}:s3:::example-bucket/&{aws:username}/*"]
+  }
+}
+
+data "aws_iam_policy_document" "resource" {
+  statement {
+    sid       = "DenyInsecureTransport"
+    effect    = "Deny"
+    actions   = ["s3:*"]
+    resources = ["*"]
+
+    principals {
+      type        = "*"
+      identifiers = ["*"]
+    }
+
+    condition {
+      test     = "Bool"
+      variable = "aws:SecureTransport"
+      values   = ["false"]
+    }
+  }
+}
+
+data "aws_iam_policy_evaluation" "allowed" {
+  identity_policies = [data.aws_iam_policy_document.identity.json]
+  resource_policies = [data.aws_iam_policy_document.resource.json]
+  principal_arn     = "arn:${data.aws_partition.current.partition}:iam::123456789012:user/alice"
+  action            = "s3:GetObject"
+  resource_arn      = "arn:${data.aws_partition.current.partition}:s3:::example-bucket/alice/key"
+
+  context {
+    key    = "aws:username"
+    values = ["alice"]
+  }
+
+  context {
+    key    = "aws:SecureTransport"
+    values = ["true"]
+  }
+}
+
+data "aws_iam_policy_evaluation" "explicit_deny" {
+  identity_policies = [data.aws_iam_policy_document.identity.json]
+  resource_policies = [data.aws_iam_policy_document.resource.json]
+  principal_arn     = "arn:${data.aws_partition.current.partition}:iam::123456789012:user/alice"
+  action            = "s3:GetObject"
+  resource_arn      = "arn:${data.aws_partition.current.partition}:s3:::example-bucket/alice/key"
+
+  context {
+    key    = "aws:username"
+    values = ["alice"]
+  }
+
+  context {
+    key    = "aws:SecureTransport"
+    values = ["false"]
+  }
+}
+
+data "aws_iam_policy_evaluation" "implicit_deny" {
+  identity_policies = [data.aws_iam_policy_document.identity.json]
+  principal_arn     = "arn:${data.aws_partition.current.partition}:iam::123456789012:user/alice"
+  action            = "s3:GetObject"
+  resource_arn      = "arn:${data.aws_partition.current.partition}:s3:::example-bucket/bob/key"
+
+  context {
+    key    = "aws:username"
+    values = ["alice"]
+  }
+}
+`
@@ -0,0 +1,483 @@
+package iam
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPolicyEvaluatorEvaluate(t *testing.T) {
+	t.Parallel()
+
+	const (
+		principalARN = "arn:aws:iam::123456789012:user/alice"  //lintignore:AWSAT005
+		bucketARN    = "arn:aws:s3:::example-bucket"           //lintignore:AWSAT005
+		objectARN    = "arn:aws:s3:::example-bucket/alice/key" //lintignore:AWSAT005
+	)
+
+	testCases := map[string]struct {
+		identityPolicies      []string
+		resourcePolicies      []string
+		permissionsBoundaries []string
+		action                string
+		resourceARN           string
+		context               map[string][]string
+		expectedDecision      string
+		expectedStatementIDs  []string
+		expectError           bool
+	}{
+		"no policies": {
+			action:               "s3:GetObject",
+			resourceARN:          objectARN,
+			expectedDecision:     policyEvaluationDecisionImplicitDeny,
+			expectedStatementIDs: []string{},
+		},
+		"allow wildcard action": {
+			identityPolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{"Sid": "Read", "Effect": "Allow", "Action": "s3:Get*", "Resource": "*"}]
+}`},
+			action:               "s3:GetObject",
+			resourceARN:          objectARN,
+			expectedDecision:     policyEvaluationDecisionAllowed,
+			expectedStatementIDs: []string{"Read"},
+		},
+		"action case insensitive": {
+			identityPolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{"Sid": "Read", "Effect": "Allow", "Action": "S3:getobject", "Resource": "*"}]
+}`},
+			action:               "s3:GetObject",
+			resourceARN:          objectARN,
+			expectedDecision:     policyEvaluationDecisionAllowed,
+			expectedStatementIDs: []string{"Read"},
+		},
+		"action not matched": {
+			identityPolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{"Sid": "Read", "Effect": "Allow", "Action": "s3:Get*", "Resource": "*"}]
+}`},
+			action:               "s3:PutObject",
+			resourceARN:          objectARN,
+			expectedDecision:     policyEvaluationDecisionImplicitDeny,
+			expectedStatementIDs: []string{},
+		},
+		"resource single character wildcard": {
+			identityPolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{"Sid": "Read", "Effect": "Allow", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::example-bucke?/*"}]
+}`},
+			action:               "s3:GetObject",
+			resourceARN:          objectARN,
+			expectedDecision:     policyEvaluationDecisionAllowed,
+			expectedStatementIDs: []string{"Read"},
+		},
+		"resource case sensitive": {
+			identityPolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{"Sid": "Read", "Effect": "Allow", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::Example-Bucket/*"}]
+}`},
+			action:               "s3:GetObject",
+			resourceARN:          objectARN,
+			expectedDecision:     policyEvaluationDecisionImplicitDeny,
+			expectedStatementIDs: []string{},
+		},
+		"explicit deny overrides allow": {
+			identityPolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Sid": "All", "Effect": "Allow", "Action": "*", "Resource": "*"},
+    {"Sid": "NoDelete", "Effect": "Deny", "Action": ["s3:DeleteObject", "s3:DeleteBucket"], "Resource": "*"}
+  ]
+}`},
+			action:               "s3:DeleteObject",
+			resourceARN:          objectARN,
+			expectedDecision:     policyEvaluationDecisionExplicitDeny,
+			expectedStatementIDs: []string{"NoDelete"},
+		},
+		"NotAction": {
+			identityPolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{"Sid": "AllButIAM", "Effect": "Allow", "NotAction": "iam:*", "Resource": "*"}]
+}`},
+			action:               "s3:GetObject",
+			resourceARN:          objectARN,
+			expectedDecision:     policyEvaluationDecisionAllowed,
+			expectedStatementIDs: []string{"AllButIAM"},
+		},
+		"NotAction not matched": {
+			identityPolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{"Sid": "AllButIAM", "Effect": "Allow", "NotAction": "iam:*", "Resource": "*"}]
+}`},
+			action:               "iam:CreateUser",
+			resourceARN:          "*",
+			expectedDecision:     policyEvaluationDecisionImplicitDeny,
+			expectedStatementIDs: []string{},
+		},
+		"NotResource": {
+			identityPolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{"Sid": "DenyOtherBuckets", "Effect": "Deny", "Action": "s3:*", "NotResource": ["arn:aws:s3:::example-bucket", "arn:aws:s3:::example-bucket/*"]}]
+}`},
+			action:               "s3:GetObject",
+			resourceARN:          "arn:aws:s3:::other-bucket/key", //lintignore:AWSAT005
+			expectedDecision:     policyEvaluationDecisionExplicitDeny,
+			expectedStatementIDs: []string{"DenyOtherBuckets"},
+		},
+		"policy variable": {
+			identityPolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{"Sid": "Home", "Effect": "Allow", "Action": "s3:*", "Resource": "arn:aws:s3:::example-bucket/${aws:username}/*"}]
+}`},
+			action:               "s3:GetObject",
+			resourceARN:          objectARN,
+			context:              map[string][]string{"aws:username": {"alice"}},
+			expectedDecision:     policyEvaluationDecisionAllowed,
+			expectedStatementIDs: []string{"Home"},
+		},
+		"policy variable missing": {
+			identityPolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{"Sid": "Home", "Effect": "Allow", "Action": "s3:*", "Resource": "arn:aws:s3:::example-bucket/${aws:username}/*"}]
+}`},
+			action:               "s3:GetObject",
+			resourceARN:          objectARN,
+			expectedDecision:     policyEvaluationDecisionImplicitDeny,
+			expectedStatementIDs: []string{},
+		},
+		"policy variable not wildcard": {
+			identityPolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{"Sid": "Home", "Effect": "Allow", "Action": "s3:*", "Resource": "arn:aws:s3:::example-bucket/${aws:username}/key"}]
+}`},
+			action:               "s3:GetObject",
+			resourceARN:          objectARN,
+			context:              map[string][]string{"aws:username": {"*"}},
+			expectedDecision:     policyEvaluationDecisionImplicitDeny,
+			expectedStatementIDs: []string{},
+		},
+		"policy variable version 2008-10-17": {
+			identityPolicies: []string{`{
+  "Version": "2008-10-17",
+  "Statement": [{"Sid": "Home", "Effect": "Allow", "Action": "s3:*", "Resource": "arn:aws:s3:::example-bucket/${aws:username}/*"}]
+}`},
+			action:               "s3:GetObject",
+			resourceARN:          objectARN,
+			context:              map[string][]string{"aws:username": {"alice"}},
+			expectedDecision:     policyEvaluationDecisionImplicitDeny,
+			expectedStatementIDs: []string{},
+		},
+		"permissions boundary allows": {
+			identityPolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{"Sid": "All", "Effect": "Allow", "Action": "*", "Resource": "*"}]
+}`},
+			permissionsBoundaries: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{"Sid": "S3Only", "Effect": "Allow", "Action": "s3:*", "Resource": "*"}]
+}`},
+			action:               "s3:GetObject",
+			resourceARN:          objectARN,
+			expectedDecision:     policyEvaluationDecisionAllowed,
+			expectedStatementIDs: []string{"All", "S3Only"},
+		},
+		"permissions boundary does not allow": {
+			identityPolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{"Sid": "All", "Effect": "Allow", "Action": "*", "Resource": "*"}]
+}`},
+			permissionsBoundaries: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{"Sid": "S3Only", "Effect": "Allow", "Action": "s3:*", "Resource": "*"}]
+}`},
+			action:               "ec2:RunInstances",
+			resourceARN:          "*",
+			expectedDecision:     policyEvaluationDecisionImplicitDeny,
+			expectedStatementIDs: []string{},
+		},
+		"resource policy allows principal": {
+			resourcePolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{"Sid": "Alice", "Effect": "Allow", "Principal": {"AWS": "arn:aws:iam::123456789012:user/alice"}, "Action": "s3:GetObject", "Resource": "arn:aws:s3:::example-bucket/*"}]
+}`},
+			action:               "s3:GetObject",
+			resourceARN:          objectARN,
+			expectedDecision:     policyEvaluationDecisionAllowed,
+			expectedStatementIDs: []string{"Alice"},
+		},
+		"resource policy allows account": {
+			resourcePolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{"Sid": "Account", "Effect": "Allow", "Principal": {"AWS": "arn:aws:iam::123456789012:root"}, "Action": "s3:GetObject", "Resource": "arn:aws:s3:::example-bucket/*"}]
+}`},
+			action:               "s3:GetObject",
+			resourceARN:          objectARN,
+			expectedDecision:     policyEvaluationDecisionAllowed,
+			expectedStatementIDs: []string{"Account"},
+		},
+		"resource policy other principal": {
+			resourcePolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{"Sid": "Bob", "Effect": "Allow", "Principal": {"AWS": ["arn:aws:iam::123456789012:user/bob", "210987654321"]}, "Action": "s3:GetObject", "Resource": "arn:aws:s3:::example-bucket/*"}]
+}`},
+			action:               "s3:GetObject",
+			resourceARN:          objectARN,
+			expectedDecision:     policyEvaluationDecisionImplicitDeny,
+			expectedStatementIDs: []string{},
+		},
+		"resource policy NotPrincipal": {
+			resourcePolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{"Sid": "OnlyBob", "Effect": "Deny", "NotPrincipal": {"AWS": "arn:aws:iam::123456789012:user/bob"}, "Action": "s3:*", "Resource": "arn:aws:s3:::example-bucket/*"}]
+}`},
+			action:               "s3:GetObject",
+			resourceARN:          objectARN,
+			expectedDecision:     policyEvaluationDecisionExplicitDeny,
+			expectedStatementIDs: []string{"OnlyBob"},
+		},
+		"resource policy allows despite permissions boundary": {
+			resourcePolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{"Sid": "Public", "Effect": "Allow", "Principal": "*", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::example-bucket/*"}]
+}`},
+			permissionsBoundaries: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{"Sid": "EC2Only", "Effect": "Allow", "Action": "ec2:*", "Resource": "*"}]
+}`},
+			action:               "s3:GetObject",
+			resourceARN:          objectARN,
+			expectedDecision:     policyEvaluationDecisionAllowed,
+			expectedStatementIDs: []string{"Public"},
+		},
+		"condition StringEquals": {
+			identityPolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{"Sid": "Region", "Effect": "Allow", "Action": "s3:*", "Resource": "*", "Condition": {"StringEquals": {"aws:RequestedRegion": ["us-east-1", "us-west-2"]}}}]
+}`},
+			action:               "s3:GetObject",
+			resourceARN:          objectARN,
+			context:              map[string][]string{"aws:requestedregion": {"us-west-2"}},
+			expectedDecision:     policyEvaluationDecisionAllowed,
+			expectedStatementIDs: []string{"Region"},
+		},
+		"condition StringEquals key missing": {
+			identityPolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{"Sid": "Region", "Effect": "Allow", "Action": "s3:*", "Resource": "*", "Condition": {"StringEquals": {"aws:RequestedRegion": "us-east-1"}}}]
+}`},
+			action:               "s3:GetObject",
+			resourceARN:          objectARN,
+			expectedDecision:     policyEvaluationDecisionImplicitDeny,
+			expectedStatementIDs: []string{},
+		},
+		"condition StringNotEquals key missing": {
+			identityPolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{"Sid": "Region", "Effect": "Deny", "Action": "*", "Resource": "*", "Condition": {"StringNotEquals": {"aws:RequestedRegion": "us-east-1"}}}]
+}`},
+			action:               "s3:GetObject",
+			resourceARN:          objectARN,
+			expectedDecision:     policyEvaluationDecisionExplicitDeny,
+			expectedStatementIDs: []string{"Region"},
+		},
+		"condition StringLikeIfExists key missing": {
+			identityPolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{"Sid": "Tagged", "Effect": "Allow", "Action": "*", "Resource": "*", "Condition": {"StringLikeIfExists": {"aws:ResourceTag/Team": "dev-*"}}}]
+}`},
+			action:               "s3:GetObject",
+			resourceARN:          objectARN,
+			expectedDecision:     policyEvaluationDecisionAllowed,
+			expectedStatementIDs: []string{"Tagged"},
+		},
+		"condition StringLike policy variable": {
+			identityPolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{"Sid": "Prefix", "Effect": "Allow", "Action": "s3:ListBucket", "Resource": "*", "Condition": {"StringLike": {"s3:prefix": "${aws:username}/*"}}}]
+}`},
+			action:               "s3:ListBucket",
+			resourceARN:          bucketARN,
+			context:              map[string][]string{"aws:username": {"alice"}, "s3:prefix": {"alice/photos"}},
+			expectedDecision:     policyEvaluationDecisionAllowed,
+			expectedStatementIDs: []string{"Prefix"},
+		},
+		"condition NumericLessThanEquals": {
+			identityPolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{"Sid": "MaxKeys", "Effect": "Allow", "Action": "s3:ListBucket", "Resource": "*", "Condition": {"NumericLessThanEquals": {"s3:max-keys": 10}}}]
+}`},
+			action:               "s3:ListBucket",
+			resourceARN:          bucketARN,
+			context:              map[string][]string{"s3:max-keys": {"20"}},
+			expectedDecision:     policyEvaluationDecisionImplicitDeny,
+			expectedStatementIDs: []string{},
+		},
+		"condition DateLessThan": {
+			identityPolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{"Sid": "Expiry", "Effect": "Allow", "Action": "*", "Resource": "*", "Condition": {"DateLessThan": {"aws:CurrentTime": "2030-01-01T00:00:00Z"}}}]
+}`},
+			action:               "s3:GetObject",
+			resourceARN:          objectARN,
+			context:              map[string][]string{"aws:currenttime": {"2029-06-30T12:00:00Z"}},
+			expectedDecision:     policyEvaluationDecisionAllowed,
+			expectedStatementIDs: []string{"Expiry"},
+		},
+		"condition Bool": {
+			identityPolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{"Sid": "TLS", "Effect": "Deny", "Action": "*", "Resource": "*", "Condition": {"Bool": {"aws:SecureTransport": false}}}]
+}`},
+			action:               "s3:GetObject",
+			resourceARN:          objectARN,
+			context:              map[string][]string{"aws:securetransport": {"false"}},
+			expectedDecision:     policyEvaluationDecisionExplicitDeny,
+			expectedStatementIDs: []string{"TLS"},
+		},
+		"condition NotIpAddress": {
+			identityPolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{"Sid": "Network", "Effect": "Deny", "Action": "*", "Resource": "*", "Condition": {"NotIpAddress": {"aws:SourceIp": ["192.0.2.0/24", "203.0.113.7"]}}}]
+}`},
+			action:               "s3:GetObject",
+			resourceARN:          objectARN,
+			context:              map[string][]string{"aws:sourceip": {"203.0.113.7"}},
+			expectedDecision:     policyEvaluationDecisionImplicitDeny,
+			expectedStatementIDs: []string{},
+		},
+		"condition ArnLike": {
+			resourcePolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{"Sid": "Topic", "Effect": "Allow", "Principal": "*", "Action": "sqs:SendMessage", "Condition": {"ArnLike": {"aws:SourceArn": "arn:aws:sns:*:123456789012:*"}}}]
+}`},
+			action:               "sqs:SendMessage",
+			resourceARN:          "arn:aws:sqs:us-west-2:123456789012:queue",                                         //lintignore:AWSAT003,AWSAT005
+			context:              map[string][]string{"aws:sourcearn": {"arn:aws:sns:us-west-2:123456789012:topic"}}, //lintignore:AWSAT003,AWSAT005
+			expectedDecision:     policyEvaluationDecisionAllowed,
+			expectedStatementIDs: []string{"Topic"},
+		},
+		"condition Null": {
+			identityPolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{"Sid": "RequireTag", "Effect": "Deny", "Action": "ec2:RunInstances", "Resource": "*", "Condition": {"Null": {"aws:RequestTag/Team": "true"}}}]
+}`},
+			action:               "ec2:RunInstances",
+			resourceARN:          "*",
+			expectedDecision:     policyEvaluationDecisionExplicitDeny,
+			expectedStatementIDs: []string{"RequireTag"},
+		},
+		"condition ForAllValues": {
+			identityPolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{"Sid": "TagKeys", "Effect": "Allow", "Action": "ec2:CreateTags", "Resource": "*", "Condition": {"ForAllValues:StringEquals": {"aws:TagKeys": ["Team", "Environment"]}}}]
+}`},
+			action:               "ec2:CreateTags",
+			resourceARN:          "*",
+			context:              map[string][]string{"aws:tagkeys": {"Team", "Owner"}},
+			expectedDecision:     policyEvaluationDecisionImplicitDeny,
+			expectedStatementIDs: []string{},
+		},
+		"condition ForAnyValue": {
+			identityPolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{"Sid": "TagKeys", "Effect": "Allow", "Action": "ec2:CreateTags", "Resource": "*", "Condition": {"ForAnyValue:StringEquals": {"aws:TagKeys": ["Team", "Environment"]}}}]
+}`},
+			action:               "ec2:CreateTags",
+			resourceARN:          "*",
+			context:              map[string][]string{"aws:tagkeys": {"Team", "Owner"}},
+			expectedDecision:     policyEvaluationDecisionAllowed,
+			expectedStatementIDs: []string{"TagKeys"},
+		},
+		"conditions all required": {
+			identityPolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{"Sid": "Both", "Effect": "Allow", "Action": "*", "Resource": "*", "Condition": {
+    "StringEquals": {"aws:RequestedRegion": "us-east-1"},
+    "Bool": {"aws:MultiFactorAuthPresent": "true"}
+  }}]
+}`},
+			action:               "s3:GetObject",
+			resourceARN:          objectARN,
+			context:              map[string][]string{"aws:requestedregion": {"us-east-1"}},
+			expectedDecision:     policyEvaluationDecisionImplicitDeny,
+			expectedStatementIDs: []string{},
+		},
+		"unsupported condition operator": {
+			identityPolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{"Effect": "Allow", "Action": "*", "Resource": "*", "Condition": {"StringSortOf": {"aws:RequestedRegion": "us-east-1"}}}]
+}`},
+			action:      "s3:GetObject",
+			resourceARN: objectARN,
+			expectError: true,
+		},
+		"missing Resource": {
+			identityPolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{"Effect": "Allow", "Action": "*"}]
+}`},
+			action:      "s3:GetObject",
+			resourceARN: objectARN,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			evaluator := &policyEvaluator{}
+
+			for _, v := range []struct {
+				policies []string
+				docs     *[]*IAMPolicyDoc
+			}{
+				{testCase.identityPolicies, &evaluator.identityPolicies},
+				{testCase.resourcePolicies, &evaluator.resourcePolicies},
+				{testCase.permissionsBoundaries, &evaluator.permissionsBoundaries},
+			} {
+				var tfList []interface{}
+				for _, policy := range v.policies {
+					tfList = append(tfList, policy)
+				}
+
+				docs, err := expandPolicyEvaluationDocs(tfList)
+
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				*v.docs = docs
+			}
+
+			result, err := evaluator.evaluate(&policyEvaluationRequest{
+				principalARN: principalARN,
+				action:       testCase.action,
+				resourceARN:  testCase.resourceARN,
+				context:      testCase.context,
+			})
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := result.decision, testCase.expectedDecision; got != want {
+				t.Errorf("decision = %s, want %s", got, want)
+			}
+
+			if diff := cmp.Diff(result.statementIDs, testCase.expectedStatementIDs); diff != "" {
+				t.Errorf("unexpected statement IDs diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
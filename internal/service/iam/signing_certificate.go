@@ -98,7 +98,7 @@ func resourceSigningCertificateRead(ctx context.Context, d *schema.ResourceData,
 		return sdkdiag.AppendErrorf(diags, "reading IAM Signing Certificate (%s): %s", d.Id(), err)
 	}
 
-	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, propagationTimeout, func() (interface{}, error) {
+	resp, err := tfresource.RetryWhenNewResourceNotFound(ctx, propagationTimeout, func() (*iam.SigningCertificate, error) {
 		return FindSigningCertificate(ctx, conn, userName, certId)
 	}, d.IsNewResource())
 
@@ -112,8 +112,6 @@ func resourceSigningCertificateRead(ctx context.Context, d *schema.ResourceData,
 		return sdkdiag.AppendErrorf(diags, "reading IAM Signing Certificate (%s): %s", d.Id(), err)
 	}
 
-	resp := outputRaw.(*iam.SigningCertificate)
-
 	d.Set("certificate_body", resp.CertificateBody)
 	d.Set("certificate_id", resp.CertificateId)
 	d.Set("user_name", resp.UserName)
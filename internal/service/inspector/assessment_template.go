@@ -90,7 +90,7 @@ func resourceAssessmentTemplateCreate(ctx context.Context, d *schema.ResourceDat
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).InspectorConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
-	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
+	tags := defaultTagsConfig.MergeTags(ctx, tftags.New(ctx, d.Get("tags").(map[string]interface{})))
 
 	req := &inspector.CreateAssessmentTemplateInput{
 		AssessmentTargetArn:    aws.String(d.Get("target_arn").(string)),
@@ -107,8 +107,9 @@ func resourceAssessmentTemplateCreate(ctx context.Context, d *schema.ResourceDat
 
 	d.SetId(aws.StringValue(resp.AssessmentTemplateArn))
 
+	// CreateAssessmentTemplate does not accept tags.
 	if len(tags) > 0 {
-		if err := updateTags(ctx, conn, d.Id(), nil, tags); err != nil {
+		if err := updateTags(ctx, conn, d.Id(), nil, tags); err != nil { // nosemgrep:ci.semgrep.tags.tag-on-create
 			return sdkdiag.AppendErrorf(diags, "adding Inspector assessment template (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -166,7 +167,7 @@ func resourceAssessmentTemplateRead(ctx context.Context, d *schema.ResourceData,
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.RemoveDefaultConfig(ctx, defaultTagsConfig).Map()); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
 	}
 
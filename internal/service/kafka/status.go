@@ -2,57 +2,58 @@ package kafka
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kafka"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
-func statusClusterState(ctx context.Context, conn *kafka.Kafka, arn string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		output, err := findClusterV2ByARN(ctx, conn, arn)
-
-		if tfresource.NotFound(err) {
-			return nil, "", nil
-		}
-
-		if err != nil {
-			return nil, "", err
-		}
-
-		return output, aws.StringValue(output.State), nil
+func clusterWaiter(conn *kafka.Kafka, arn string) tfresource.Waiter[*kafka.Cluster] {
+	return tfresource.Waiter[*kafka.Cluster]{
+		Find: func(ctx context.Context) (*kafka.Cluster, error) {
+			return findClusterV2ByARN(ctx, conn, arn)
+		},
+		Status: func(output *kafka.Cluster) string {
+			return aws.StringValue(output.State)
+		},
+		FailureStates: []string{kafka.ClusterStateFailed},
+		FailureReason: func(output *kafka.Cluster) error {
+			if stateInfo := output.StateInfo; stateInfo != nil {
+				return fmt.Errorf("%s: %s", aws.StringValue(stateInfo.Code), aws.StringValue(stateInfo.Message))
+			}
+
+			return nil
+		},
 	}
 }
 
-func statusClusterOperationState(ctx context.Context, conn *kafka.Kafka, arn string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		output, err := FindClusterOperationByARN(ctx, conn, arn)
-
-		if tfresource.NotFound(err) {
-			return nil, "", nil
-		}
-
-		if err != nil {
-			return nil, "", err
-		}
-
-		return output, aws.StringValue(output.OperationState), nil
+func clusterOperationWaiter(conn *kafka.Kafka, arn string) tfresource.Waiter[*kafka.ClusterOperationInfo] {
+	return tfresource.Waiter[*kafka.ClusterOperationInfo]{
+		Find: func(ctx context.Context) (*kafka.ClusterOperationInfo, error) {
+			return FindClusterOperationByARN(ctx, conn, arn)
+		},
+		Status: func(output *kafka.ClusterOperationInfo) string {
+			return aws.StringValue(output.OperationState)
+		},
+		FailureStates: []string{ClusterOperationStateUpdateFailed},
+		FailureReason: func(output *kafka.ClusterOperationInfo) error {
+			if errorInfo := output.ErrorInfo; errorInfo != nil {
+				return fmt.Errorf("%s: %s", aws.StringValue(errorInfo.ErrorCode), aws.StringValue(errorInfo.ErrorString))
+			}
+
+			return nil
+		},
 	}
 }
 
-func statusConfigurationState(ctx context.Context, conn *kafka.Kafka, arn string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		output, err := FindConfigurationByARN(ctx, conn, arn)
-
-		if tfresource.NotFound(err) {
-			return nil, "", nil
-		}
-
-		if err != nil {
-			return nil, "", err
-		}
-
-		return output, aws.StringValue(output.State), nil
+func configurationWaiter(conn *kafka.Kafka, arn string) tfresource.Waiter[*kafka.DescribeConfigurationOutput] {
+	return tfresource.Waiter[*kafka.DescribeConfigurationOutput]{
+		Find: func(ctx context.Context) (*kafka.DescribeConfigurationOutput, error) {
+			return FindConfigurationByARN(ctx, conn, arn)
+		},
+		Status: func(output *kafka.DescribeConfigurationOutput) string {
+			return aws.StringValue(output.State)
+		},
 	}
 }
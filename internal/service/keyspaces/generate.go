@@ -1,4 +1,4 @@
-//go:generate go run ../../generate/tags/main.go -ListTags -ServiceTagsSlice -UpdateTags -UntagInTagsElem=Tags -UntagInNeedTagType -ContextOnly
+//go:generate go run ../../generate/tags/main.go -ListTags -ServiceTagsSlice -GetTagsIn -UpdateTags -UntagInTagsElem=Tags -UntagInNeedTagType -ContextOnly
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package keyspaces
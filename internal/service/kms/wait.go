@@ -56,25 +56,27 @@ func WaitKeyDeleted(ctx context.Context, conn *kms.KMS, id string) (*kms.KeyMeta
 }
 
 func WaitKeyDescriptionPropagated(ctx context.Context, conn *kms.KMS, id string, description string) error {
-	checkFunc := func() (bool, error) {
+	checkFunc := func() (*kms.KeyMetadata, bool, error) {
 		output, err := FindKeyByID(ctx, conn, id)
 
 		if tfresource.NotFound(err) {
-			return false, nil
+			return nil, false, nil
 		}
 
 		if err != nil {
-			return false, err
+			return nil, false, err
 		}
 
-		return aws.StringValue(output.Description) == description, nil
+		return output, aws.StringValue(output.Description) == description, nil
 	}
 	opts := tfresource.WaitOpts{
 		ContinuousTargetOccurence: 5,
 		MinTimeout:                2 * time.Second,
 	}
 
-	return tfresource.WaitUntil(ctx, KeyDescriptionPropagationTimeout, checkFunc, opts)
+	_, err := tfresource.WaitUntil(ctx, KeyDescriptionPropagationTimeout, checkFunc, opts)
+
+	return err
 }
 
 func WaitKeyMaterialImported(ctx context.Context, conn *kms.KMS, id string) (*kms.KeyMetadata, error) {
@@ -95,123 +97,133 @@ func WaitKeyMaterialImported(ctx context.Context, conn *kms.KMS, id string) (*km
 }
 
 func WaitKeyPolicyPropagated(ctx context.Context, conn *kms.KMS, id, policy string) error {
-	checkFunc := func() (bool, error) {
+	checkFunc := func() (*string, bool, error) {
 		output, err := FindKeyPolicyByKeyIDAndPolicyName(ctx, conn, id, PolicyNameDefault)
 
 		if tfresource.NotFound(err) {
-			return false, nil
+			return nil, false, nil
 		}
 
 		if err != nil {
-			return false, err
+			return nil, false, err
 		}
 
 		equivalent, err := awspolicy.PoliciesAreEquivalent(aws.StringValue(output), policy)
 
 		if err != nil {
-			return false, err
+			return nil, false, err
 		}
 
-		return equivalent, nil
+		return output, equivalent, nil
 	}
 	opts := tfresource.WaitOpts{
 		ContinuousTargetOccurence: 5,
 		MinTimeout:                1 * time.Second,
 	}
 
-	return tfresource.WaitUntil(ctx, KeyPolicyPropagationTimeout, checkFunc, opts)
+	_, err := tfresource.WaitUntil(ctx, KeyPolicyPropagationTimeout, checkFunc, opts)
+
+	return err
 }
 
 func WaitKeyRotationEnabledPropagated(ctx context.Context, conn *kms.KMS, id string, enabled bool) error {
-	checkFunc := func() (bool, error) {
+	checkFunc := func() (*bool, bool, error) {
 		output, err := FindKeyRotationEnabledByKeyID(ctx, conn, id)
 
 		if tfresource.NotFound(err) {
-			return false, nil
+			return nil, false, nil
 		}
 
 		if err != nil {
-			return false, err
+			return nil, false, err
 		}
 
-		return aws.BoolValue(output) == enabled, nil
+		return output, aws.BoolValue(output) == enabled, nil
 	}
 	opts := tfresource.WaitOpts{
 		ContinuousTargetOccurence: 5,
 		MinTimeout:                1 * time.Second,
 	}
 
-	return tfresource.WaitUntil(ctx, KeyRotationUpdatedTimeout, checkFunc, opts)
+	_, err := tfresource.WaitUntil(ctx, KeyRotationUpdatedTimeout, checkFunc, opts)
+
+	return err
 }
 
 func WaitKeyStatePropagated(ctx context.Context, conn *kms.KMS, id string, enabled bool) error {
-	checkFunc := func() (bool, error) {
+	checkFunc := func() (*kms.KeyMetadata, bool, error) {
 		output, err := FindKeyByID(ctx, conn, id)
 
 		if tfresource.NotFound(err) {
-			return false, nil
+			return nil, false, nil
 		}
 
 		if err != nil {
-			return false, err
+			return nil, false, err
 		}
 
-		return aws.BoolValue(output.Enabled) == enabled, nil
+		return output, aws.BoolValue(output.Enabled) == enabled, nil
 	}
 	opts := tfresource.WaitOpts{
 		ContinuousTargetOccurence: 15,
 		MinTimeout:                2 * time.Second,
 	}
 
-	return tfresource.WaitUntil(ctx, KeyStatePropagationTimeout, checkFunc, opts)
+	_, err := tfresource.WaitUntil(ctx, KeyStatePropagationTimeout, checkFunc, opts)
+
+	return err
 }
 
 func WaitKeyValidToPropagated(ctx context.Context, conn *kms.KMS, id string, validTo string) error {
-	checkFunc := func() (bool, error) {
+	checkFunc := func() (*kms.KeyMetadata, bool, error) {
 		output, err := FindKeyByID(ctx, conn, id)
 
 		if tfresource.NotFound(err) {
-			return false, nil
+			return nil, false, nil
 		}
 
 		if err != nil {
-			return false, err
+			return nil, false, err
 		}
 
 		if output.ValidTo != nil {
-			return aws.TimeValue(output.ValidTo).Format(time.RFC3339) == validTo, nil
+			return output, aws.TimeValue(output.ValidTo).Format(time.RFC3339) == validTo, nil
 		}
 
-		return validTo == "", nil
+		return output, validTo == "", nil
 	}
 	opts := tfresource.WaitOpts{
 		ContinuousTargetOccurence: 5,
 		MinTimeout:                2 * time.Second,
 	}
 
-	return tfresource.WaitUntil(ctx, KeyValidToPropagationTimeout, checkFunc, opts)
+	_, err := tfresource.WaitUntil(ctx, KeyValidToPropagationTimeout, checkFunc, opts)
+
+	return err
 }
 
 func WaitTagsPropagated(ctx context.Context, conn *kms.KMS, id string, tags tftags.KeyValueTags) error {
-	checkFunc := func() (bool, error) {
+	checkFunc := func() (tftags.KeyValueTags, bool, error) {
 		output, err := ListTags(ctx, conn, id)
 
 		if tfawserr.ErrCodeEquals(err, kms.ErrCodeNotFoundException) {
-			return false, nil
+			return nil, false, nil
 		}
 
 		if err != nil {
-			return false, err
+			return nil, false, err
 		}
 
-		return output.Equal(tags), nil
+		return output, output.Equal(tags), nil
 	}
 	opts := tfresource.WaitOpts{
 		ContinuousTargetOccurence: 5,
 		MinTimeout:                1 * time.Second,
 	}
 
-	return tfresource.WaitUntil(ctx, KeyTagsPropagationTimeout, checkFunc, opts)
+	_, err := tfresource.WaitUntil(ctx, KeyTagsPropagationTimeout, checkFunc, opts)
+
+	return err
 }
 
 func WaitReplicaExternalKeyCreated(ctx context.Context, conn *kms.KMS, id string) (*kms.KeyMetadata, error) {
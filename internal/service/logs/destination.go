@@ -69,7 +69,7 @@ const (
 func resourceDestinationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).LogsConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
-	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
+	tags := defaultTagsConfig.MergeTags(ctx, tftags.New(ctx, d.Get("tags").(map[string]interface{})))
 
 	name := d.Get("name").(string)
 	input := &cloudwatchlogs.PutDestinationInput{
@@ -92,7 +92,7 @@ func resourceDestinationCreate(ctx context.Context, d *schema.ResourceData, meta
 	// Although PutDestinationInput has a Tags field, specifying tags there results in
 	// "InvalidParameterException: Could not deliver test message to specified destination. Check if the destination is valid."
 	if len(tags) > 0 {
-		if err := UpdateTags(ctx, conn, aws.StringValue(destination.Arn), nil, tags); err != nil {
+		if err := UpdateTags(ctx, conn, aws.StringValue(destination.Arn), nil, tags); err != nil { // nosemgrep:ci.semgrep.tags.tag-on-create
 			return diag.Errorf("adding CloudWatch Logs Destination (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -131,7 +131,7 @@ func resourceDestinationRead(ctx context.Context, d *schema.ResourceData, meta i
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.RemoveDefaultConfig(ctx, defaultTagsConfig).Map()); err != nil {
 		return diag.Errorf("setting tags: %s", err)
 	}
 
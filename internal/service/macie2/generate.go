@@ -1,4 +1,4 @@
-//go:generate go run ../../generate/tags/main.go -ServiceTagsMap -ContextOnly
+//go:generate go run ../../generate/tags/main.go -ServiceTagsMap -GetTagsIn -ContextOnly
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package macie2
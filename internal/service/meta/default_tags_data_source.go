@@ -39,6 +39,9 @@ func (d *dataSourceDefaultTags) Schema(ctx context.Context, req datasource.Schem
 				Optional: true,
 				Computed: true,
 			},
+			"resource_type": schema.StringAttribute{
+				Optional: true,
+			},
 			"tags": tftags.TagsAttributeComputedOnly(),
 		},
 	}
@@ -59,6 +62,10 @@ func (d *dataSourceDefaultTags) Read(ctx context.Context, request datasource.Rea
 	ignoreTagsConfig := d.Meta().IgnoreTagsConfig
 	tags := defaultTagsConfig.GetTags()
 
+	if resourceType := data.ResourceType.ValueString(); resourceType != "" {
+		tags = defaultTagsConfig.TagsForResourceType(resourceType)
+	}
+
 	data.ID = types.StringValue(d.Meta().Partition)
 	data.Tags = flex.FlattenFrameworkStringValueMapLegacy(ctx, tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map())
 
@@ -66,6 +73,7 @@ func (d *dataSourceDefaultTags) Read(ctx context.Context, request datasource.Rea
 }
 
 type dataSourceDefaultTagsData struct {
-	ID   types.String `tfsdk:"id"`
-	Tags types.Map    `tfsdk:"tags"`
+	ID           types.String `tfsdk:"id"`
+	ResourceType types.String `tfsdk:"resource_type"`
+	Tags         types.Map    `tfsdk:"tags"`
 }
@@ -1,6 +1,7 @@
 package meta_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -106,6 +107,79 @@ func TestAccMetaDefaultTagsDataSource_ignore(t *testing.T) {
 	})
 }
 
+func TestAccMetaDefaultTagsDataSource_resourceType(t *testing.T) {
+	dataSourceName := "data.aws_default_tags.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDefaultTagsDataSourceConfig_resourceType("aws_vpc"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.Environment", "test"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.Propagate", "true"),
+				),
+			},
+			{
+				Config: testAccDefaultTagsDataSourceConfig_resourceType("aws_instance"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.Environment", "test"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.PatchGroup", "instances"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.Propagate", "true"),
+				),
+			},
+			{
+				Config: testAccDefaultTagsDataSourceConfig_resourceType("aws_autoscaling_group"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.Environment", "test"),
+				),
+			},
+		},
+	})
+}
+
 func testAccDefaultTagsDataSourceConfig_basic() string {
 	return `data "aws_default_tags" "test" {}`
 }
+
+func testAccDefaultTagsDataSourceConfig_resourceType(resourceType string) string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  default_tags {
+    tags = {
+      Environment = "test"
+      Propagate   = "true"
+    }
+
+    rule {
+      resource_types = ["aws_autoscaling_*"]
+      remove_keys    = ["Propagate"]
+    }
+
+    rule {
+      resource_types = ["aws_instance"]
+
+      tags = {
+        PatchGroup = "instances"
+      }
+    }
+  }
+
+  skip_credentials_validation = true
+  skip_get_ec2_platforms      = true
+  skip_metadata_api_check     = true
+  skip_requesting_account_id  = true
+}
+
+data "aws_default_tags" "test" {
+  resource_type = %[1]q
+}
+`, resourceType)
+}
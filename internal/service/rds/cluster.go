@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -465,7 +467,7 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).RDSConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
-	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
+	tags := defaultTagsConfig.MergeTags(ctx, tftags.New(ctx, d.Get("tags").(map[string]interface{})))
 
 	// Some API calls (e.g. RestoreDBClusterFromSnapshot do not support all
 	// parameters to correctly apply all settings in one pass. For missing
@@ -581,7 +583,7 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 			},
 			errCodeInvalidParameterValue, "IAM role ARN value is invalid or does not include the required permissions")
 
-		if err != nil {
+		if err != nil && !adoptCreatingCluster(ctx, conn, identifier, err) {
 			return sdkdiag.AppendErrorf(diags, "creating RDS Cluster (restore from snapshot) (%s): %s", identifier, err)
 		}
 	} else if v, ok := d.GetOk("s3_import"); ok {
@@ -698,7 +700,7 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 			},
 		)
 
-		if err != nil {
+		if err != nil && !adoptCreatingCluster(ctx, conn, identifier, err) {
 			return sdkdiag.AppendErrorf(diags, "creating RDS Cluster (restore from S3) (%s): %s", identifier, err)
 		}
 	} else if v, ok := d.GetOk("restore_to_point_in_time"); ok {
@@ -800,7 +802,7 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		log.Printf("[DEBUG] Creating RDS Cluster: %s", input)
 		_, err := conn.RestoreDBClusterToPointInTimeWithContext(ctx, input)
 
-		if err != nil {
+		if err != nil && !adoptCreatingCluster(ctx, conn, identifier, err) {
 			return sdkdiag.AppendErrorf(diags, "creating RDS Cluster (restore to point-in-time) (%s): %s", identifier, err)
 		}
 	} else {
@@ -939,14 +941,29 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 			},
 			errCodeInvalidParameterValue, "IAM role ARN value is invalid or does not include the required permissions")
 
-		if err != nil {
+		if err != nil && !adoptCreatingCluster(ctx, conn, identifier, err) {
 			return sdkdiag.AppendErrorf(diags, "creating RDS Cluster (%s): %s", identifier, err)
 		}
 	}
 
 	d.SetId(identifier)
 
-	if _, err := waitDBClusterCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+	clusterARN := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   rds.ServiceName,
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  "cluster:" + d.Id(),
+	}.String()
+
+	if err := tfresource.WaitInFlightOperation(ctx, clusterARN, func(ctx context.Context) error {
+		_, err := waitDBClusterCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+		return err
+	}); tfresource.OperationInFlight(err) {
+		// The cluster is saved with the create in flight. Any remaining changes are made by the next apply.
+		diags = sdkdiag.AppendWarningf(diags, "waiting for RDS Cluster (%s) create: %s", d.Id(), err)
+		return append(diags, resourceClusterRead(ctx, d, meta)...)
+	} else if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for RDS Cluster (%s) create: %s", d.Id(), err)
 	}
 
@@ -991,6 +1008,11 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return sdkdiag.AppendErrorf(diags, "reading RDS Cluster (%s): %s", d.Id(), err)
 	}
 
+	// An interrupted create is checked, not waited for.
+	if err := tfresource.CheckInFlightOperation(ctx, aws.StringValue(dbc.Status) != ClusterStatusCreating); err != nil && !d.IsNewResource() {
+		diags = sdkdiag.AppendWarningf(diags, "RDS Cluster (%s) create: %s", d.Id(), err)
+	}
+
 	d.Set("allocated_storage", dbc.AllocatedStorage)
 	clusterARN := aws.StringValue(dbc.DBClusterArn)
 	d.Set("arn", clusterARN)
@@ -1069,7 +1091,7 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.RemoveDefaultConfig(ctx, defaultTagsConfig).Map()); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
 	}
 
@@ -1099,6 +1121,14 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
 	conn := meta.(*conns.AWSClient).RDSConn()
 
+	// Wait for an interrupted create before changing the cluster.
+	if err := tfresource.ResumeInFlightOperation(ctx, func(ctx context.Context) error {
+		_, err := waitDBClusterCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		return err
+	}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for RDS Cluster (%s) create: %s", d.Id(), err)
+	}
+
 	if d.HasChangesExcept(
 		"allow_major_version_upgrade",
 		"final_snapshot_identifier",
@@ -1496,6 +1526,24 @@ func FindDBClusterByID(ctx context.Context, conn *rds.RDS, id string) (*rds.DBCl
 	return dbCluster, nil
 }
 
+// adoptCreatingCluster returns whether the specified create error is due to a cluster with the same identifier that is still being created,
+// e.g. by an earlier apply whose provider exited before the cluster was saved in state. Such a cluster is adopted rather than orphaned.
+func adoptCreatingCluster(ctx context.Context, conn *rds.RDS, id string, err error) bool {
+	if !tfawserr.ErrCodeEquals(err, rds.ErrCodeDBClusterAlreadyExistsFault) {
+		return false
+	}
+
+	dbc, err := FindDBClusterByID(ctx, conn, id)
+
+	if err != nil || aws.StringValue(dbc.Status) != ClusterStatusCreating {
+		return false
+	}
+
+	log.Printf("[INFO] Adopting RDS Cluster (%s), which is still being created", id)
+
+	return true
+}
+
 func waitDBClusterCreated(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.DBCluster, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{
@@ -1514,7 +1562,7 @@ func waitDBClusterCreated(ctx context.Context, conn *rds.RDS, id string, timeout
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*rds.DBCluster); ok {
 		return output, err
@@ -1540,7 +1588,7 @@ func waitDBClusterUpdated(ctx context.Context, conn *rds.RDS, id string, timeout
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*rds.DBCluster); ok {
 		return output, err
@@ -1564,7 +1612,7 @@ func waitDBClusterDeleted(ctx context.Context, conn *rds.RDS, id string, timeout
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*rds.DBCluster); ok {
 		return output, err
@@ -1585,6 +1633,10 @@ func statusDBCluster(ctx context.Context, conn *rds.RDS, id string) resource.Sta
 			return nil, "", err
 		}
 
+		if v, err := strconv.Atoi(aws.StringValue(output.PercentProgress)); err == nil {
+			tfresource.ReportProgress(ctx, v, "")
+		}
+
 		return output, aws.StringValue(output.Status), nil
 	}
 }
@@ -299,6 +299,8 @@ func statusExportTask(ctx context.Context, conn *rds.Client, id string) sdkv2res
 			return nil, "", err
 		}
 
+		tfresource.ReportProgress(ctx, int(out.PercentProgress), "")
+
 		return out, aws.ToString(out.Status), nil
 	}
 }
@@ -313,7 +315,7 @@ func waitExportTaskCreated(ctx context.Context, conn *rds.Client, id string, tim
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.ExportTask); ok {
 		return out, err
 	}
@@ -582,7 +582,7 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).RDSConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
-	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
+	tags := defaultTagsConfig.MergeTags(ctx, tftags.New(ctx, d.Get("tags").(map[string]interface{})))
 
 	if v, ok := d.GetOk("security_group_names"); ok && v.(*schema.Set).Len() > 0 {
 		return sdkdiag.AppendErrorf(diags, `with the retirement of EC2-Classic no new RDS DB Instances can be created referencing RDS DB Security Groups`)
@@ -1654,7 +1654,7 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.RemoveDefaultConfig(ctx, defaultTagsConfig).Map()); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
 	}
 
@@ -2291,11 +2291,23 @@ func findDBInstanceByIDSDKv2(ctx context.Context, conn *rds_sdkv2.Client, id str
 	return &output.DBInstances[0], nil
 }
 
+// dbInstanceBackoff is the backoff between refreshes when waiting on a DB instance's status,
+// as creating, modifying or deleting a DB instance can take an hour or more.
+var dbInstanceBackoff = &tfresource.Backoff{
+	Initial:    10 * time.Second,
+	Max:        1 * time.Minute,
+	Multiplier: 1.5,
+	Jitter:     0.2,
+}
+
 func waitDBInstanceAvailableSDKv1(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration, optFns ...tfresource.OptionsFunc) (*rds.DBInstance, error) { //nolint:unparam
 	options := tfresource.Options{
-		PollInterval:              10 * time.Second,
 		Delay:                     1 * time.Minute,
 		ContinuousTargetOccurence: 3,
+		Backoff:                   dbInstanceBackoff,
+		SlowPollThreshold:         30 * time.Minute,
+		SlowPollInterval:          2 * time.Minute,
+		TargetPollInterval:        10 * time.Second,
 	}
 	for _, fn := range optFns {
 		fn(&options)
@@ -2325,7 +2337,7 @@ func waitDBInstanceAvailableSDKv1(ctx context.Context, conn *rds.RDS, id string,
 	}
 	options.Apply(stateConf)
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*rds.DBInstance); ok {
 		return output, err
@@ -2336,9 +2348,12 @@ func waitDBInstanceAvailableSDKv1(ctx context.Context, conn *rds.RDS, id string,
 
 func waitDBInstanceAvailableSDKv2(ctx context.Context, conn *rds_sdkv2.Client, id string, timeout time.Duration, optFns ...tfresource.OptionsFunc) (*rds.DBInstance, error) { //nolint:unparam
 	options := tfresource.Options{
-		PollInterval:              10 * time.Second,
 		Delay:                     1 * time.Minute,
 		ContinuousTargetOccurence: 3,
+		Backoff:                   dbInstanceBackoff,
+		SlowPollThreshold:         30 * time.Minute,
+		SlowPollInterval:          2 * time.Minute,
+		TargetPollInterval:        10 * time.Second,
 	}
 	for _, fn := range optFns {
 		fn(&options)
@@ -2379,9 +2394,12 @@ func waitDBInstanceAvailableSDKv2(ctx context.Context, conn *rds_sdkv2.Client, i
 
 func waitDBInstanceDeleted(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration, optFns ...tfresource.OptionsFunc) (*rds.DBInstance, error) { //nolint:unparam
 	options := tfresource.Options{
-		PollInterval:              10 * time.Second,
 		Delay:                     1 * time.Minute,
 		ContinuousTargetOccurence: 3,
+		Backoff:                   dbInstanceBackoff,
+		SlowPollThreshold:         30 * time.Minute,
+		SlowPollInterval:          2 * time.Minute,
+		TargetPollInterval:        10 * time.Second,
 	}
 	for _, fn := range optFns {
 		fn(&options)
@@ -107,8 +107,8 @@ func resourceProxyTargetCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.DBInstanceIdentifiers = aws.StringSlice([]string{v.(string)})
 	}
 
-	outputRaw, err := tfresource.RetryWhenAWSErrMessageContains(ctx, 5*time.Minute,
-		func() (interface{}, error) {
+	output, err := tfresource.RetryWhenAWSErrMessageContains(ctx, 5*time.Minute,
+		func() (*rds.RegisterDBProxyTargetsOutput, error) {
 			return conn.RegisterDBProxyTargetsWithContext(ctx, input)
 		},
 		rds.ErrCodeInvalidDBInstanceStateFault, "CREATING")
@@ -117,7 +117,7 @@ func resourceProxyTargetCreate(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "registering RDS DB Proxy (%s/%s) Target: %s", dbProxyName, targetGroupName, err)
 	}
 
-	dbProxyTarget := outputRaw.(*rds.RegisterDBProxyTargetsOutput).DBProxyTargets[0]
+	dbProxyTarget := output.DBProxyTargets[0]
 
 	d.SetId(strings.Join([]string{dbProxyName, targetGroupName, aws.StringValue(dbProxyTarget.Type), aws.StringValue(dbProxyTarget.RdsResourceId)}, "/"))
 
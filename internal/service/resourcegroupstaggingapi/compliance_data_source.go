@@ -0,0 +1,140 @@
+package resourcegroupstaggingapi
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// @SDKDataSource("aws_resourcegroupstaggingapi_compliance")
+func dataSourceCompliance() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceComplianceRead,
+
+		Schema: map[string]*schema.Schema{
+			"compliant": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"non_compliant_resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"invalid_keys": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"missing_keys": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"resource_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": tftags.TagsSchemaComputed(),
+					},
+				},
+			},
+			"required_tag": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_value_patterns": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsValidRegExp,
+							},
+						},
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"resource_type_filters": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 100,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceComplianceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIConn()
+
+	policy, err := expandTagPolicy(d.Get("required_tag").(*schema.Set).List())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &resourcegroupstaggingapi.GetResourcesInput{}
+
+	if v, ok := d.GetOk("resource_type_filters"); ok && v.(*schema.Set).Len() > 0 {
+		input.ResourceTypeFilters = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	var nonCompliant []map[string]interface{}
+
+	err = conn.GetResourcesPagesWithContext(ctx, input, func(page *resourcegroupstaggingapi.GetResourcesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ResourceTagMappingList {
+			tags := KeyValueTags(ctx, v.Tags)
+			missing, invalid := policy.check(tags)
+
+			if len(missing) == 0 && len(invalid) == 0 {
+				continue
+			}
+
+			nonCompliant = append(nonCompliant, map[string]interface{}{
+				"invalid_keys": invalid,
+				"missing_keys": missing,
+				"resource_arn": aws.StringValue(v.ResourceARN),
+				"tags":         tags.Map(),
+			})
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "getting Resource Groups Tags API Resources: %s", err)
+	}
+
+	sort.Slice(nonCompliant, func(i, j int) bool {
+		return nonCompliant[i]["resource_arn"].(string) < nonCompliant[j]["resource_arn"].(string)
+	})
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("compliant", len(nonCompliant) == 0)
+	if err := d.Set("non_compliant_resources", nonCompliant); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting non_compliant_resources: %s", err)
+	}
+
+	return diags
+}
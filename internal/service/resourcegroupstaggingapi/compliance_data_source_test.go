@@ -0,0 +1,64 @@
+package resourcegroupstaggingapi_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccResourceGroupsTaggingAPIComplianceDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_resourcegroupstaggingapi_compliance.test"
+	resourceName := "aws_vpc.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, resourcegroupstaggingapi.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComplianceDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "compliant", "false"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "non_compliant_resources.*", map[string]string{
+						"invalid_keys.#": "1",
+						"missing_keys.#": "1",
+						"tags.Key":       rName,
+					}),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "non_compliant_resources.*.resource_arn", resourceName, "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccComplianceDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Key = %[1]q
+  }
+}
+
+data "aws_resourcegroupstaggingapi_compliance" "test" {
+  resource_type_filters = ["ec2:vpc"]
+
+  required_tag {
+    key                    = "Key"
+    allowed_value_patterns = ["not-${aws_vpc.test.tags["Key"]}"]
+  }
+
+  required_tag {
+    key = %[1]q
+  }
+
+  depends_on = [aws_vpc.test]
+}
+`, rName)
+}
@@ -0,0 +1,353 @@
+package resourcegroupstaggingapi
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	resourceTagsIDSeparator = ","
+
+	// Maximum number of ARNs in a TagResources or UntagResources request.
+	tagResourcesMaxARNs = 20
+	// Maximum number of ARNs in a GetResources request.
+	getResourcesMaxARNs = 100
+)
+
+// @SDKResource("aws_resource_tags")
+func resourceResourceTags() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceResourceTagsCreate,
+		ReadWithoutTimeout:   resourceResourceTagsRead,
+		UpdateWithoutTimeout: resourceResourceTagsUpdate,
+		DeleteWithoutTimeout: resourceResourceTagsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceResourceTagsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceResourceTagsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIConn()
+
+	arns := flex.ExpandStringValueSet(d.Get("resource_arns").(*schema.Set))
+	tags := tftags.New(ctx, d.Get("tags").(map[string]interface{}))
+
+	if err := tagResources(ctx, conn, arns, tags); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Resource Tags: %s", err)
+	}
+
+	d.SetId(resourceTagsCreateResourceID(arns))
+
+	return append(diags, resourceResourceTagsRead(ctx, d, meta)...)
+}
+
+func resourceResourceTagsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIConn()
+
+	arns := resourceTagsParseResourceID(d.Id())
+
+	resourceTags, err := FindResourceTagsByARNs(ctx, conn, arns)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Resource Tags (%s): %s", d.Id(), err)
+	}
+
+	managedKeys := tftags.New(ctx, d.Get("tags").(map[string]interface{})).Keys()
+
+	if !d.IsNewResource() && !anyResourceHasTag(resourceTags, arns, managedKeys) {
+		log.Printf("[WARN] Resource Tags (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	// Only the tags managed by this resource are read.
+	// A managed tag is read only if it has the same value on all the resources, otherwise it is re-applied.
+	tags := make(map[string]string)
+
+	for _, key := range managedKeys {
+		if value, ok := commonTagValue(resourceTags, arns, key); ok {
+			tags[key] = value
+		}
+	}
+
+	d.Set("resource_arns", arns)
+	d.Set("tags", tags)
+
+	return diags
+}
+
+func resourceResourceTagsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIConn()
+
+	o, n := d.GetChange("resource_arns")
+	oldARNs, newARNs := o.(*schema.Set), n.(*schema.Set)
+	o, n = d.GetChange("tags")
+	oldTags, newTags := tftags.New(ctx, o), tftags.New(ctx, n)
+
+	// Remove the managed tags from resources no longer managed.
+	if removed := flex.ExpandStringValueSet(oldARNs.Difference(newARNs)); len(removed) > 0 {
+		if err := untagResources(ctx, conn, removed, oldTags.Keys()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Resource Tags (%s): %s", d.Id(), err)
+		}
+	}
+
+	// Apply all the managed tags to newly managed resources.
+	if added := flex.ExpandStringValueSet(newARNs.Difference(oldARNs)); len(added) > 0 {
+		if err := tagResources(ctx, conn, added, newTags); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Resource Tags (%s): %s", d.Id(), err)
+		}
+	}
+
+	if unchanged := flex.ExpandStringValueSet(oldARNs.Intersection(newARNs)); len(unchanged) > 0 {
+		if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
+			if err := untagResources(ctx, conn, unchanged, removedTags.Keys()); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Resource Tags (%s): %s", d.Id(), err)
+			}
+		}
+
+		if updatedTags := oldTags.Updated(newTags); len(updatedTags) > 0 {
+			if err := tagResources(ctx, conn, unchanged, updatedTags); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Resource Tags (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	d.SetId(resourceTagsCreateResourceID(flex.ExpandStringValueSet(newARNs)))
+
+	return append(diags, resourceResourceTagsRead(ctx, d, meta)...)
+}
+
+func resourceResourceTagsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIConn()
+
+	arns := resourceTagsParseResourceID(d.Id())
+	keys := tftags.New(ctx, d.Get("tags").(map[string]interface{})).Keys()
+
+	log.Printf("[DEBUG] Deleting Resource Tags: %s", d.Id())
+	if err := untagResources(ctx, conn, arns, keys); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Resource Tags (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// resourceResourceTagsImport manages the tags that have the same value on all the imported resources.
+func resourceResourceTagsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIConn()
+
+	arns := resourceTagsParseResourceID(d.Id())
+
+	resourceTags, err := FindResourceTagsByARNs(ctx, conn, arns)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading Resource Tags (%s): %w", d.Id(), err)
+	}
+
+	tags := make(map[string]string)
+
+	if v, ok := resourceTags[arns[0]]; ok {
+		for key := range v.IgnoreAWS() {
+			if value, ok := commonTagValue(resourceTags, arns, key); ok {
+				tags[key] = value
+			}
+		}
+	}
+
+	d.SetId(resourceTagsCreateResourceID(arns))
+	d.Set("tags", tags)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceTagsCreateResourceID(arns []string) string {
+	arns = append([]string(nil), arns...)
+	sort.Strings(arns)
+
+	return strings.Join(arns, resourceTagsIDSeparator)
+}
+
+func resourceTagsParseResourceID(id string) []string {
+	return strings.Split(id, resourceTagsIDSeparator)
+}
+
+// commonTagValue returns the value of the specified tag if it has the same value on all the specified resources.
+func commonTagValue(resourceTags map[string]tftags.KeyValueTags, arns []string, key string) (string, bool) {
+	var value *string
+
+	for _, arn := range arns {
+		v := resourceTags[arn].KeyValue(key)
+
+		if v == nil || (value != nil && aws.StringValue(v) != aws.StringValue(value)) {
+			return "", false
+		}
+
+		value = v
+	}
+
+	if value == nil {
+		return "", false
+	}
+
+	return aws.StringValue(value), true
+}
+
+// anyResourceHasTag returns whether any of the specified resources still carries any of the specified tag keys.
+func anyResourceHasTag(resourceTags map[string]tftags.KeyValueTags, arns []string, keys []string) bool {
+	for _, arn := range arns {
+		for _, key := range keys {
+			if resourceTags[arn].KeyExists(key) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// FindResourceTagsByARNs returns the tags on each of the specified resources.
+// Resources that have never been tagged are not returned.
+func FindResourceTagsByARNs(ctx context.Context, conn *resourcegroupstaggingapi.ResourceGroupsTaggingAPI, arns []string) (map[string]tftags.KeyValueTags, error) {
+	output := make(map[string]tftags.KeyValueTags)
+
+	for _, chunk := range chunkARNs(arns, getResourcesMaxARNs) {
+		input := &resourcegroupstaggingapi.GetResourcesInput{
+			ResourceARNList: aws.StringSlice(chunk),
+		}
+
+		err := conn.GetResourcesPagesWithContext(ctx, input, func(page *resourcegroupstaggingapi.GetResourcesOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.ResourceTagMappingList {
+				output[aws.StringValue(v.ResourceARN)] = KeyValueTags(ctx, v.Tags)
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return output, nil
+}
+
+// tagResources applies the specified tags to the specified resources, up to 20 resources per request.
+func tagResources(ctx context.Context, conn *resourcegroupstaggingapi.ResourceGroupsTaggingAPI, arns []string, tags tftags.KeyValueTags) error {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	for _, chunk := range chunkARNs(arns, tagResourcesMaxARNs) {
+		input := &resourcegroupstaggingapi.TagResourcesInput{
+			ResourceARNList: aws.StringSlice(chunk),
+			Tags:            aws.StringMap(tags.Map()),
+		}
+
+		output, err := conn.TagResourcesWithContext(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("tagging resources: %w", err)
+		}
+
+		if err := failedResourcesError(output.FailedResourcesMap); err != nil {
+			return fmt.Errorf("tagging resources: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// untagResources removes the specified tags from the specified resources, up to 20 resources per request.
+func untagResources(ctx context.Context, conn *resourcegroupstaggingapi.ResourceGroupsTaggingAPI, arns []string, keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	for _, chunk := range chunkARNs(arns, tagResourcesMaxARNs) {
+		input := &resourcegroupstaggingapi.UntagResourcesInput{
+			ResourceARNList: aws.StringSlice(chunk),
+			TagKeys:         aws.StringSlice(keys),
+		}
+
+		output, err := conn.UntagResourcesWithContext(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("untagging resources: %w", err)
+		}
+
+		if err := failedResourcesError(output.FailedResourcesMap); err != nil {
+			return fmt.Errorf("untagging resources: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func failedResourcesError(apiObjects map[string]*resourcegroupstaggingapi.FailureInfo) error {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	arns := make([]string, 0, len(apiObjects))
+	for arn := range apiObjects {
+		arns = append(arns, arn)
+	}
+	sort.Strings(arns)
+
+	errs := make([]string, 0, len(arns))
+	for _, arn := range arns {
+		v := apiObjects[arn]
+		errs = append(errs, fmt.Sprintf("%s: %s: %s", arn, aws.StringValue(v.ErrorCode), aws.StringValue(v.ErrorMessage)))
+	}
+
+	return fmt.Errorf("%d resources failed: %s", len(arns), strings.Join(errs, "; "))
+}
+
+func chunkARNs(arns []string, size int) [][]string {
+	var chunks [][]string
+
+	for size < len(arns) {
+		arns, chunks = arns[size:], append(chunks, arns[0:size:size])
+	}
+
+	return append(chunks, arns)
+}
@@ -0,0 +1,200 @@
+package resourcegroupstaggingapi_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfresourcegroupstaggingapi "github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroupstaggingapi"
+)
+
+func TestAccResourceGroupsTaggingAPIResourceTags_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_resource_tags.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, resourcegroupstaggingapi.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceTagsDestroy(ctx, "aws_sqs_queue.test.0", "key1"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceTagsConfig_basic(rName, 2, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceTagExists(ctx, "aws_sqs_queue.test.0", "key1", "value1"),
+					testAccCheckResourceTagExists(ctx, "aws_sqs_queue.test.1", "key1", "value1"),
+					testAccCheckResourceTagExists(ctx, "aws_sqs_queue.test.0", "unmanaged", "value"),
+					resource.TestCheckResourceAttr(resourceName, "resource_arns.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// All the tags common to the resources are imported.
+				ImportStateVerifyIgnore: []string{"tags"},
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupsTaggingAPIResourceTags_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_resource_tags.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, resourcegroupstaggingapi.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceTagsDestroy(ctx, "aws_sqs_queue.test.0", "key2"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceTagsConfig_basic(rName, 1, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceTagExists(ctx, "aws_sqs_queue.test.0", "key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "resource_arns.#", "1"),
+				),
+			},
+			{
+				// Adds a resource and replaces the managed tag.
+				Config: testAccResourceTagsConfig_basic(rName, 25, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceTagExists(ctx, "aws_sqs_queue.test.0", "key2", "value2"),
+					testAccCheckResourceTagExists(ctx, "aws_sqs_queue.test.24", "key2", "value2"),
+					testAccCheckResourceTagsDestroy(ctx, "aws_sqs_queue.test.0", "key1"),
+					testAccCheckResourceTagExists(ctx, "aws_sqs_queue.test.0", "unmanaged", "value"),
+					resource.TestCheckResourceAttr(resourceName, "resource_arns.#", "25"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupsTaggingAPIResourceTags_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, resourcegroupstaggingapi.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceTagsDestroy(ctx, "aws_sqs_queue.test.0", "key1"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceTagsConfig_basic(rName, 2, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceTagExists(ctx, "aws_sqs_queue.test.0", "key1", "value1"),
+					testAccCheckResourceTagDisappears(ctx, "aws_sqs_queue.test.0", "key1"),
+					testAccCheckResourceTagDisappears(ctx, "aws_sqs_queue.test.1", "key1"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckResourceTagDisappears(ctx context.Context, n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceGroupsTaggingAPIConn()
+
+		_, err := conn.UntagResourcesWithContext(ctx, &resourcegroupstaggingapi.UntagResourcesInput{
+			ResourceARNList: aws.StringSlice([]string{rs.Primary.Attributes["arn"]}),
+			TagKeys:         aws.StringSlice([]string{key}),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckResourceTagExists(ctx context.Context, n, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceGroupsTaggingAPIConn()
+
+		arn := rs.Primary.Attributes["arn"]
+		output, err := tfresourcegroupstaggingapi.FindResourceTagsByARNs(ctx, conn, []string{arn})
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.StringValue(output[arn].KeyValue(key)); got != value {
+			return fmt.Errorf("%s tag (%s): got %q, expected %q", arn, key, got, value)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckResourceTagsDestroy(ctx context.Context, n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			// The tagged resource has been destroyed too.
+			return nil
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceGroupsTaggingAPIConn()
+
+		arn := rs.Primary.Attributes["arn"]
+		output, err := tfresourcegroupstaggingapi.FindResourceTagsByARNs(ctx, conn, []string{arn})
+
+		if err != nil {
+			return err
+		}
+
+		if output[arn].KeyExists(key) {
+			return fmt.Errorf("%s tag (%s) still exists", arn, key)
+		}
+
+		return nil
+	}
+}
+
+func testAccResourceTagsConfig_basic(rName string, count int, key, value string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  count = %[2]d
+
+  name = "%[1]s-${count.index}"
+
+  tags = {
+    unmanaged = "value"
+  }
+
+  lifecycle {
+    ignore_changes = [tags, tags_all]
+  }
+}
+
+resource "aws_resource_tags" "test" {
+  resource_arns = aws_sqs_queue.test[*].arn
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, count, key, value)
+}
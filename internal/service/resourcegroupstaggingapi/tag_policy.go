@@ -0,0 +1,88 @@
+package resourcegroupstaggingapi
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// tagPolicy is a tagging standard: the tag keys that resources must have, and the patterns that their values must match.
+type tagPolicy struct {
+	required tftags.KeyValueTags
+	patterns map[string][]*regexp.Regexp // Values of the key must fully match one of the patterns, if any
+}
+
+// check returns the required tag keys missing from the specified tags,
+// and the required tag keys whose values match none of the allowed patterns, both sorted.
+func (p tagPolicy) check(tags tftags.KeyValueTags) ([]string, []string) {
+	present := tags.Only(p.required)
+	missing := p.required.Removed(present).Keys()
+	var invalid []string
+
+	for k, v := range present.Map() {
+		patterns := p.patterns[k]
+
+		if len(patterns) == 0 {
+			continue
+		}
+
+		matched := false
+		for _, re := range patterns {
+			if re.MatchString(v) {
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			invalid = append(invalid, k)
+		}
+	}
+
+	sort.Strings(missing)
+	sort.Strings(invalid)
+
+	return missing, invalid
+}
+
+func expandTagPolicy(tfList []interface{}) (tagPolicy, error) {
+	policy := tagPolicy{
+		required: make(tftags.KeyValueTags),
+		patterns: make(map[string][]*regexp.Regexp),
+	}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		key := tfMap["key"].(string)
+
+		if _, ok := policy.required[key]; ok {
+			return policy, fmt.Errorf("duplicate required_tag key (%s)", key)
+		}
+
+		policy.required[key] = nil
+
+		if v, ok := tfMap["allowed_value_patterns"].(*schema.Set); ok {
+			for _, pattern := range flex.ExpandStringValueSet(v) {
+				// Patterns must match the whole value.
+				re, err := regexp.Compile(`^(?:` + pattern + `)$`)
+
+				if err != nil {
+					return policy, fmt.Errorf("required_tag (%s) allowed value pattern (%s): %w", key, pattern, err)
+				}
+
+				policy.patterns[key] = append(policy.patterns[key], re)
+			}
+		}
+	}
+
+	return policy, nil
+}
@@ -0,0 +1,103 @@
+package resourcegroupstaggingapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestTagPolicyCheck(t *testing.T) {
+	t.Parallel()
+
+	policy, err := expandTagPolicy([]interface{}{
+		map[string]interface{}{
+			"key":                    "CostCenter",
+			"allowed_value_patterns": schema.NewSet(schema.HashString, []interface{}{`\d{4}`}),
+		},
+		map[string]interface{}{
+			"key":                    "Environment",
+			"allowed_value_patterns": schema.NewSet(schema.HashString, []interface{}{"dev", "prod"}),
+		},
+		map[string]interface{}{
+			"key": "Owner",
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := []struct {
+		name        string
+		tags        map[string]string
+		wantMissing []string
+		wantInvalid []string
+	}{
+		{
+			name: "compliant",
+			tags: map[string]string{
+				"CostCenter":  "1234",
+				"Environment": "prod",
+				"Owner":       "payments",
+				"Other":       "value",
+			},
+		},
+		{
+			name:        "no tags",
+			tags:        map[string]string{},
+			wantMissing: []string{"CostCenter", "Environment", "Owner"},
+		},
+		{
+			name: "invalid values",
+			tags: map[string]string{
+				"CostCenter":  "12345",
+				"Environment": "production",
+				"Owner":       "",
+			},
+			wantInvalid: []string{"CostCenter", "Environment"},
+		},
+		{
+			name: "missing and invalid",
+			tags: map[string]string{
+				"Environment": "staging",
+			},
+			wantMissing: []string{"CostCenter", "Owner"},
+			wantInvalid: []string{"Environment"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotMissing, gotInvalid := policy.check(tftags.New(context.Background(), testCase.tags))
+
+			if diff := cmp.Diff(gotMissing, testCase.wantMissing, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("unexpected missing keys diff (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(gotInvalid, testCase.wantInvalid, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("unexpected invalid keys diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandTagPolicyDuplicateKey(t *testing.T) {
+	t.Parallel()
+
+	_, err := expandTagPolicy([]interface{}{
+		map[string]interface{}{"key": "Owner"},
+		map[string]interface{}{"key": "Owner", "allowed_value_patterns": schema.NewSet(schema.HashString, []interface{}{"a"})},
+	})
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
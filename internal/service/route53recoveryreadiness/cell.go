@@ -69,12 +69,11 @@ func ResourceCell() *schema.Resource {
 func resourceCellCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).Route53RecoveryReadinessConn()
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
-	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
 
 	input := &route53recoveryreadiness.CreateCellInput{
 		CellName: aws.String(d.Get("cell_name").(string)),
 		Cells:    flex.ExpandStringList(d.Get("cells").([]interface{})),
+		Tags:     GetTagsIn(ctx, d, meta),
 	}
 
 	resp, err := conn.CreateCellWithContext(ctx, input)
@@ -84,13 +83,6 @@ func resourceCellCreate(ctx context.Context, d *schema.ResourceData, meta interf
 
 	d.SetId(aws.StringValue(resp.CellName))
 
-	if len(tags) > 0 {
-		arn := aws.StringValue(resp.CellArn)
-		if err := UpdateTags(ctx, conn, arn, nil, tags); err != nil {
-			return sdkdiag.AppendErrorf(diags, "adding Route53 Recovery Readiness Cell (%s) tags: %s", d.Id(), err)
-		}
-	}
-
 	return append(diags, resourceCellRead(ctx, d, meta)...)
 }
 
@@ -130,7 +122,7 @@ func resourceCellRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.RemoveDefaultConfig(ctx, defaultTagsConfig).Map()); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
 	}
 
@@ -58,12 +58,11 @@ func ResourceReadinessCheck() *schema.Resource {
 func resourceReadinessCheckCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).Route53RecoveryReadinessConn()
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
-	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
 
 	input := &route53recoveryreadiness.CreateReadinessCheckInput{
 		ReadinessCheckName: aws.String(d.Get("readiness_check_name").(string)),
 		ResourceSetName:    aws.String(d.Get("resource_set_name").(string)),
+		Tags:               GetTagsIn(ctx, d, meta),
 	}
 
 	resp, err := conn.CreateReadinessCheckWithContext(ctx, input)
@@ -73,13 +72,6 @@ func resourceReadinessCheckCreate(ctx context.Context, d *schema.ResourceData, m
 
 	d.SetId(aws.StringValue(resp.ReadinessCheckName))
 
-	if len(tags) > 0 {
-		arn := aws.StringValue(resp.ReadinessCheckArn)
-		if err := UpdateTags(ctx, conn, arn, nil, tags); err != nil {
-			return sdkdiag.AppendErrorf(diags, "adding Route53 Recovery Readiness ReadinessCheck (%s) tags: %s", d.Id(), err)
-		}
-	}
-
 	return append(diags, resourceReadinessCheckRead(ctx, d, meta)...)
 }
 
@@ -118,7 +110,7 @@ func resourceReadinessCheckRead(ctx context.Context, d *schema.ResourceData, met
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.RemoveDefaultConfig(ctx, defaultTagsConfig).Map()); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
 	}
 
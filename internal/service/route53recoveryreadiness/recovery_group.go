@@ -62,12 +62,11 @@ func ResourceRecoveryGroup() *schema.Resource {
 func resourceRecoveryGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).Route53RecoveryReadinessConn()
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
-	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
 
 	input := &route53recoveryreadiness.CreateRecoveryGroupInput{
 		RecoveryGroupName: aws.String(d.Get("recovery_group_name").(string)),
 		Cells:             flex.ExpandStringList(d.Get("cells").([]interface{})),
+		Tags:              GetTagsIn(ctx, d, meta),
 	}
 
 	resp, err := conn.CreateRecoveryGroupWithContext(ctx, input)
@@ -77,13 +76,6 @@ func resourceRecoveryGroupCreate(ctx context.Context, d *schema.ResourceData, me
 
 	d.SetId(aws.StringValue(resp.RecoveryGroupName))
 
-	if len(tags) > 0 {
-		arn := aws.StringValue(resp.RecoveryGroupArn)
-		if err := UpdateTags(ctx, conn, arn, nil, tags); err != nil {
-			return sdkdiag.AppendErrorf(diags, "adding Route53 Recovery Readiness RecoveryGroup (%s) tags: %s", d.Id(), err)
-		}
-	}
-
 	return append(diags, resourceRecoveryGroupRead(ctx, d, meta)...)
 }
 
@@ -121,7 +113,7 @@ func resourceRecoveryGroupRead(ctx context.Context, d *schema.ResourceData, meta
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.RemoveDefaultConfig(ctx, defaultTagsConfig).Map()); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
 	}
 
@@ -129,7 +129,7 @@ func resourceBucketCorsConfigurationRead(ctx context.Context, d *schema.Resource
 		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
 	}
 
-	corsResponse, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, 2*time.Minute, func() (interface{}, error) {
+	output, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, 2*time.Minute, func() (*s3.GetBucketCorsOutput, error) {
 		return conn.GetBucketCorsWithContext(ctx, input)
 	}, ErrCodeNoSuchCORSConfiguration)
 
@@ -143,8 +143,7 @@ func resourceBucketCorsConfigurationRead(ctx context.Context, d *schema.Resource
 		return diag.FromErr(fmt.Errorf("error reading S3 bucket CORS configuration (%s): %w", d.Id(), err))
 	}
 
-	output, ok := corsResponse.(*s3.GetBucketCorsOutput)
-	if !ok || output == nil {
+	if output == nil {
 		if d.IsNewResource() {
 			return diag.FromErr(fmt.Errorf("error reading S3 bucket CORS configuration (%s): empty output", d.Id()))
 		}
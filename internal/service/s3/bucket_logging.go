@@ -150,7 +150,7 @@ func resourceBucketLoggingRead(ctx context.Context, d *schema.ResourceData, meta
 		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
 	}
 
-	resp, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, 2*time.Minute, func() (interface{}, error) {
+	output, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, 2*time.Minute, func() (*s3.GetBucketLoggingOutput, error) {
 		return conn.GetBucketLoggingWithContext(ctx, input)
 	}, s3.ErrCodeNoSuchBucket)
 
@@ -164,9 +164,7 @@ func resourceBucketLoggingRead(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(fmt.Errorf("error reading S3 Bucket (%s) Logging: %w", d.Id(), err))
 	}
 
-	output, ok := resp.(*s3.GetBucketLoggingOutput)
-
-	if !ok || output.LoggingEnabled == nil {
+	if output == nil || output.LoggingEnabled == nil {
 		if d.IsNewResource() {
 			return diag.FromErr(fmt.Errorf("error reading S3 Bucket (%s) Logging: empty output", d.Id()))
 		}
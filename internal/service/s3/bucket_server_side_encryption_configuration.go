@@ -123,8 +123,8 @@ func resourceBucketServerSideEncryptionConfigurationRead(ctx context.Context, d
 		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
 	}
 
-	resp, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, propagationTimeout,
-		func() (interface{}, error) {
+	output, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, propagationTimeout,
+		func() (*s3.GetBucketEncryptionOutput, error) {
 			return conn.GetBucketEncryptionWithContext(ctx, input)
 		},
 		s3.ErrCodeNoSuchBucket,
@@ -141,8 +141,7 @@ func resourceBucketServerSideEncryptionConfigurationRead(ctx context.Context, d
 		return diag.FromErr(fmt.Errorf("error reading S3 bucket server-side encryption configuration (%s): %w", d.Id(), err))
 	}
 
-	output, ok := resp.(*s3.GetBucketEncryptionOutput)
-	if !ok || output.ServerSideEncryptionConfiguration == nil {
+	if output == nil || output.ServerSideEncryptionConfiguration == nil {
 		if d.IsNewResource() {
 			return diag.FromErr(fmt.Errorf("error reading S3 bucket server-side encryption configuration (%s): empty output", d.Id()))
 		}
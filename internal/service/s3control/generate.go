@@ -1,4 +1,4 @@
-//go:generate go run ../../generate/tags/main.go -ServiceTagsSlice -TagType=S3Tag -ContextOnly
+//go:generate go run ../../generate/tags/main.go -ServiceTagsSlice -GetTagsIn -TagType=S3Tag -ContextOnly
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package s3control
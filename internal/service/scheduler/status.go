@@ -4,7 +4,6 @@ import (
 	"context"
 
 	"github.com/aws/aws-sdk-go-v2/service/scheduler"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -13,17 +12,13 @@ const (
 	scheduleGroupStatusDeleting = "DELETING"
 )
 
-func statusScheduleGroup(ctx context.Context, conn *scheduler.Client, name string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		out, err := findScheduleGroupByName(ctx, conn, name)
-		if tfresource.NotFound(err) {
-			return nil, "", nil
-		}
-
-		if err != nil {
-			return nil, "", err
-		}
-
-		return out, string(out.State), nil
+func scheduleGroupWaiter(conn *scheduler.Client, name string) tfresource.Waiter[*scheduler.GetScheduleGroupOutput] {
+	return tfresource.Waiter[*scheduler.GetScheduleGroupOutput]{
+		Find: func(ctx context.Context) (*scheduler.GetScheduleGroupOutput, error) {
+			return findScheduleGroupByName(ctx, conn, name)
+		},
+		Status: func(output *scheduler.GetScheduleGroupOutput) string {
+			return string(output.State)
+		},
 	}
 }
@@ -5,39 +5,21 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/scheduler"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func waitScheduleGroupActive(ctx context.Context, conn *scheduler.Client, name string, timeout time.Duration) (*scheduler.GetScheduleGroupOutput, error) {
-	stateConf := &resource.StateChangeConf{
-		Pending:                   []string{},
-		Target:                    []string{scheduleGroupStatusActive},
-		Refresh:                   statusScheduleGroup(ctx, conn, name),
-		Timeout:                   timeout,
-		NotFoundChecks:            20,
-		ContinuousTargetOccurence: 2,
-	}
+	w := scheduleGroupWaiter(conn, name)
+	w.Pending = []string{}
+	w.Target = []string{scheduleGroupStatusActive}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-	if out, ok := outputRaw.(*scheduler.GetScheduleGroupOutput); ok {
-		return out, err
-	}
-
-	return nil, err
+	return w.Wait(ctx, timeout, tfresource.WithNotFoundChecks(20), tfresource.WithContinuousTargetOccurence(2))
 }
 
 func waitScheduleGroupDeleted(ctx context.Context, conn *scheduler.Client, name string, timeout time.Duration) (*scheduler.GetScheduleGroupOutput, error) {
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{scheduleGroupStatusDeleting, scheduleGroupStatusActive},
-		Target:  []string{},
-		Refresh: statusScheduleGroup(ctx, conn, name),
-		Timeout: timeout,
-	}
-
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-	if out, ok := outputRaw.(*scheduler.GetScheduleGroupOutput); ok {
-		return out, err
-	}
+	w := scheduleGroupWaiter(conn, name)
+	w.Pending = []string{scheduleGroupStatusDeleting, scheduleGroupStatusActive}
+	w.Target = []string{}
 
-	return nil, err
+	return w.Wait(ctx, timeout)
 }
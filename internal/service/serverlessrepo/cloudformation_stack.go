@@ -168,7 +168,7 @@ func resourceCloudFormationStackRead(ctx context.Context, d *schema.ResourceData
 	tags = tags.IgnoreServerlessApplicationRepository().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err = d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err = d.Set("tags", tags.RemoveDefaultConfig(ctx, defaultTagsConfig).Map()); err != nil {
 		return sdkdiag.AppendErrorf(diags, "to set tags: %s", err)
 	}
 
@@ -305,7 +305,7 @@ func createCloudFormationChangeSet(ctx context.Context, d *schema.ResourceData,
 	serverlessConn := client.ServerlessRepoConn()
 	cfConn := client.CloudFormationConn()
 	defaultTagsConfig := client.DefaultTagsConfig
-	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
+	tags := defaultTagsConfig.MergeTags(ctx, tftags.New(ctx, d.Get("tags").(map[string]interface{})))
 
 	stackName := d.Get("name").(string)
 	changeSetRequest := serverlessrepo.CreateCloudFormationChangeSetRequest{
@@ -1,4 +1,4 @@
-//go:generate go run ../../generate/tags/main.go -ServiceTagsSlice -ContextOnly
+//go:generate go run ../../generate/tags/main.go -ServiceTagsSlice -GetTagsIn -ContextOnly
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package servicecatalog
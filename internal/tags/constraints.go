@@ -0,0 +1,132 @@
+package tags
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// AWS-wide defaults for the constraints on resource tags.
+// Reference: https://docs.aws.amazon.com/tag-editor/latest/userguide/tagging.html#tag-conventions
+const (
+	defaultMaxTags        = 50
+	defaultMaxKeyLength   = 128
+	defaultMaxValueLength = 256
+)
+
+// Constraints are the constraints that an AWS service places on the tags of its resources.
+type Constraints struct {
+	AllowedCharacters *regexp.Regexp // Tag keys and values must match, if not nil
+	MaxKeyLength      int
+	MaxTags           int
+	MaxValueLength    int
+	Service           string // Human-friendly name of the AWS service, used in error messages
+}
+
+var constraintsCache sync.Map // resource type -> *Constraints
+
+// ConstraintsForResourceType returns the constraints that the AWS service implementing the specified resource type places on tags.
+// Constraints not recorded for the service in names/tags_data.csv, and those for unknown resource types, are the AWS-wide defaults.
+func ConstraintsForResourceType(resourceType string) *Constraints {
+	if v, ok := constraintsCache.Load(resourceType); ok {
+		return v.(*Constraints)
+	}
+
+	constraints := &Constraints{
+		MaxKeyLength:   defaultMaxKeyLength,
+		MaxTags:        defaultMaxTags,
+		MaxValueLength: defaultMaxValueLength,
+	}
+
+	if providerPackage, err := names.ProviderPackageForResourceType(resourceType); err == nil {
+		if v, err := names.FullHumanFriendly(providerPackage); err == nil {
+			constraints.Service = v
+		}
+
+		if v, ok := names.TagConstraints(providerPackage); ok {
+			if v.AllowedCharacters != "" {
+				// Validated by the names package tests.
+				constraints.AllowedCharacters = regexp.MustCompile(v.AllowedCharacters)
+			}
+			if v.MaxKeyLength > 0 {
+				constraints.MaxKeyLength = v.MaxKeyLength
+			}
+			if v.MaxTags > 0 {
+				constraints.MaxTags = v.MaxTags
+			}
+			if v.MaxValueLength > 0 {
+				constraints.MaxValueLength = v.MaxValueLength
+			}
+		}
+	}
+
+	v, _ := constraintsCache.LoadOrStore(resourceType, constraints)
+
+	return v.(*Constraints)
+}
+
+// Validate returns an error listing the ways in which the specified tags do not satisfy the constraints,
+// or nil if they satisfy all of them.
+// Lengths are counted in Unicode characters.
+func (c *Constraints) Validate(resourceType string, tags KeyValueTags) error {
+	if c == nil {
+		return nil
+	}
+
+	var problems []string
+
+	if n := len(tags); c.MaxTags > 0 && n > c.MaxTags {
+		problems = append(problems, fmt.Sprintf("%d tags, at most %d allowed", n, c.MaxTags))
+	}
+
+	keys := tags.Keys()
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if strings.HasPrefix(k, awsTagKeyPrefix) {
+			problems = append(problems, fmt.Sprintf("tag key (%s) uses the reserved prefix %q", k, awsTagKeyPrefix))
+		}
+
+		if n := utf8.RuneCountInString(k); c.MaxKeyLength > 0 && n > c.MaxKeyLength {
+			problems = append(problems, fmt.Sprintf("tag key (%s) is %d characters, at most %d allowed", k, n, c.MaxKeyLength))
+		}
+
+		if c.AllowedCharacters != nil && !c.AllowedCharacters.MatchString(k) {
+			problems = append(problems, fmt.Sprintf("tag key (%s) does not match %q", k, c.AllowedCharacters))
+		}
+
+		v := tags.KeyValue(k)
+
+		if v == nil {
+			continue
+		}
+
+		if n := utf8.RuneCountInString(*v); c.MaxValueLength > 0 && n > c.MaxValueLength {
+			problems = append(problems, fmt.Sprintf("tag (%s) value is %d characters, at most %d allowed", k, n, c.MaxValueLength))
+		}
+
+		if c.AllowedCharacters != nil && !c.AllowedCharacters.MatchString(*v) {
+			problems = append(problems, fmt.Sprintf("tag (%s) value does not match %q", k, c.AllowedCharacters))
+		}
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+
+	if resourceType == "" {
+		resourceType = "resource"
+	}
+
+	service := c.Service
+	if service == "" {
+		service = "AWS"
+	}
+
+	return fmt.Errorf("%s tags do not satisfy %s tag constraints: %s", resourceType, service, strings.Join(problems, "; "))
+}
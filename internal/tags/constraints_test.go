@@ -0,0 +1,163 @@
+package tags
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestConstraintsForResourceType(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name              string
+		resourceType      string
+		wantMaxTags       int
+		wantService       string
+		wantAllowedPolicy bool
+	}{
+		{
+			name:         "unknown resource type",
+			resourceType: "aws_doesnotexist",
+			wantMaxTags:  defaultMaxTags,
+		},
+		{
+			name:         "no recorded constraints",
+			resourceType: "aws_sfn_state_machine",
+			wantMaxTags:  defaultMaxTags,
+			wantService:  "AWS SFN (Step Functions)",
+		},
+		{
+			name:         "recorded maximum",
+			resourceType: "aws_route53_zone",
+			wantMaxTags:  50,
+			wantService:  "Amazon Route 53",
+		},
+		{
+			name:              "recorded allowed characters",
+			resourceType:      "aws_iam_role",
+			wantMaxTags:       defaultMaxTags,
+			wantService:       "AWS IAM (Identity & Access Management)",
+			wantAllowedPolicy: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := ConstraintsForResourceType(testCase.resourceType)
+
+			if got.MaxTags != testCase.wantMaxTags {
+				t.Errorf("MaxTags: got %d; want %d", got.MaxTags, testCase.wantMaxTags)
+			}
+
+			if got.MaxKeyLength != defaultMaxKeyLength {
+				t.Errorf("MaxKeyLength: got %d; want %d", got.MaxKeyLength, defaultMaxKeyLength)
+			}
+
+			if got.MaxValueLength != defaultMaxValueLength {
+				t.Errorf("MaxValueLength: got %d; want %d", got.MaxValueLength, defaultMaxValueLength)
+			}
+
+			if got.Service != testCase.wantService {
+				t.Errorf("Service: got %q; want %q", got.Service, testCase.wantService)
+			}
+
+			if (got.AllowedCharacters != nil) != testCase.wantAllowedPolicy {
+				t.Errorf("AllowedCharacters: got %v", got.AllowedCharacters)
+			}
+		})
+	}
+}
+
+func TestConstraintsValidate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	manyTags := make(map[string]string)
+	for i := 0; i < 51; i++ {
+		manyTags[fmt.Sprintf("key%02d", i)] = "value"
+	}
+
+	testCases := []struct {
+		name         string
+		resourceType string
+		tags         KeyValueTags
+		wantErr      string
+	}{
+		{
+			name:         "valid",
+			resourceType: "aws_iam_role",
+			tags: New(ctx, map[string]string{
+				"Name":  "example",
+				"Owner": "team@example.com",
+			}),
+		},
+		{
+			name:         "too many tags",
+			resourceType: "aws_route53_zone",
+			tags:         New(ctx, manyTags),
+			wantErr:      `aws_route53_zone tags do not satisfy Amazon Route 53 tag constraints: 51 tags, at most 50 allowed`,
+		},
+		{
+			name:         "reserved prefix",
+			resourceType: "aws_instance",
+			tags: New(ctx, map[string]string{
+				"aws:cloudformation:stack-name": "example",
+			}),
+			wantErr: `aws_instance tags do not satisfy Amazon EC2 (Elastic Compute Cloud) tag constraints: tag key (aws:cloudformation:stack-name) uses the reserved prefix "aws:"`,
+		},
+		{
+			name:         "too long",
+			resourceType: "aws_instance",
+			tags: New(ctx, map[string]string{
+				strings.Repeat("k", 129): "value",
+				"Name":                   strings.Repeat("ü", 257),
+			}),
+			wantErr: fmt.Sprintf(`aws_instance tags do not satisfy Amazon EC2 (Elastic Compute Cloud) tag constraints: tag (Name) value is 257 characters, at most 256 allowed; tag key (%s) is 129 characters, at most 128 allowed`, strings.Repeat("k", 129)),
+		},
+		{
+			name:         "characters not allowed",
+			resourceType: "aws_iam_role",
+			tags: New(ctx, map[string]string{
+				"Name": "example*",
+			}),
+			wantErr: `aws_iam_role tags do not satisfy AWS IAM (Identity & Access Management) tag constraints: tag (Name) value does not match "^[\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*$"`,
+		},
+		{
+			name: "unknown resource type",
+			tags: New(ctx, map[string]string{
+				"aws:example": "example",
+			}),
+			wantErr: `resource tags do not satisfy AWS tag constraints: tag key (aws:example) uses the reserved prefix "aws:"`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ConstraintsForResourceType(testCase.resourceType).Validate(testCase.resourceType, testCase.tags)
+
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error %q", testCase.wantErr)
+			}
+
+			if got, want := err.Error(), testCase.wantErr; got != want {
+				t.Errorf("got error %q; want %q", got, want)
+			}
+		})
+	}
+}
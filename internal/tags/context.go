@@ -0,0 +1,20 @@
+package tags
+
+import (
+	"context"
+)
+
+type resourceTypeKey struct{}
+
+// NewResourceTypeContext returns a copy of the specified context carrying the Terraform resource type.
+// The provider's default tags for the resource type are evaluated using the returned context.
+func NewResourceTypeContext(ctx context.Context, resourceType string) context.Context {
+	return context.WithValue(ctx, resourceTypeKey{}, resourceType)
+}
+
+// ResourceTypeFromContext returns the Terraform resource type carried by the specified context, if any.
+func ResourceTypeFromContext(ctx context.Context) string {
+	resourceType, _ := ctx.Value(resourceTypeKey{}).(string)
+
+	return resourceType
+}
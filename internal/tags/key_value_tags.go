@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"path"
 	"reflect"
 	"regexp"
 	"sort"
@@ -24,13 +25,33 @@ const (
 
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
-	Tags KeyValueTags
+	RequiredTags *RequiredTagsConfig
+	Rules        []DefaultTagsRule
+	Tags         KeyValueTags
+}
+
+// DefaultTagsRule adds and removes default tags for the resource types matching any of its glob patterns.
+type DefaultTagsRule struct {
+	RemoveKeys    []string
+	ResourceTypes []string
+	Tags          KeyValueTags
+}
+
+// RequiredTagsConfig contains the tags that all taggable resources must have.
+type RequiredTagsConfig struct {
+	AllowedValues        map[string]*regexp.Regexp
+	ExcludeResourceTypes []string
+	Keys                 []string
+	WarnOnly             bool
 }
 
 // IgnoreConfig contains various options for removing resource tags.
 type IgnoreConfig struct {
-	Keys        KeyValueTags
-	KeyPrefixes KeyValueTags
+	Keys         KeyValueTags
+	KeyPatterns  []string
+	KeyPrefixes  KeyValueTags
+	KeyRegexes   []*regexp.Regexp
+	ValueRegexes []*regexp.Regexp
 }
 
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
@@ -61,22 +82,65 @@ func (dc *DefaultConfig) GetTags() KeyValueTags {
 	return dc.Tags
 }
 
+// TagsForResourceType returns the DefaultConfig's Tags for the specified resource type,
+// after applying the Rules that match the resource type in order.
+func (dc *DefaultConfig) TagsForResourceType(resourceType string) KeyValueTags {
+	if dc == nil {
+		return nil
+	}
+
+	result := dc.Tags
+
+	for _, rule := range dc.Rules {
+		if !rule.matches(resourceType) {
+			continue
+		}
+
+		result = make(KeyValueTags).Merge(result)
+
+		for _, k := range rule.RemoveKeys {
+			delete(result, k)
+		}
+
+		result = result.Merge(rule.Tags)
+	}
+
+	return result
+}
+
+// matches returns whether the rule applies to the specified resource type.
+func (rule DefaultTagsRule) matches(resourceType string) bool {
+	for _, pattern := range rule.ResourceTypes {
+		if ok, _ := path.Match(pattern, resourceType); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
 // MergeTags returns the result of keyvaluetags.Merge() on the given
-// DefaultConfig.Tags with KeyValueTags provided as an argument,
+// DefaultConfig's Tags for the resource type carried by the context
+// with KeyValueTags provided as an argument,
 // overriding the value of any tag with a matching key.
-func (dc *DefaultConfig) MergeTags(tags KeyValueTags) KeyValueTags {
-	if dc == nil || dc.Tags == nil {
+func (dc *DefaultConfig) MergeTags(ctx context.Context, tags KeyValueTags) KeyValueTags {
+	defaultTags := dc.TagsForResourceType(ResourceTypeFromContext(ctx))
+
+	if defaultTags == nil {
 		return tags
 	}
 
-	return dc.Tags.Merge(tags)
+	return defaultTags.Merge(tags)
 }
 
 // TagsEqual returns true if the given configuration's Tags
+// for the resource type carried by the context
 // are equal to those passed in as an argument;
 // otherwise returns false
-func (dc *DefaultConfig) TagsEqual(tags KeyValueTags) bool {
-	if dc == nil || dc.Tags == nil {
+func (dc *DefaultConfig) TagsEqual(ctx context.Context, tags KeyValueTags) bool {
+	defaultTags := dc.TagsForResourceType(ResourceTypeFromContext(ctx))
+
+	if defaultTags == nil {
 		return tags == nil
 	}
 
@@ -85,10 +149,69 @@ func (dc *DefaultConfig) TagsEqual(tags KeyValueTags) bool {
 	}
 
 	if len(tags) == 0 {
-		return len(dc.Tags) == 0
+		return len(defaultTags) == 0
+	}
+
+	return defaultTags.ContainsAll(tags)
+}
+
+// GetRequiredTags is convenience method that returns the DefaultConfig's RequiredTags, if any
+func (dc *DefaultConfig) GetRequiredTags() *RequiredTagsConfig {
+	if dc == nil {
+		return nil
+	}
+
+	return dc.RequiredTags
+}
+
+// Check returns an error listing the required tags that are missing from the specified tags
+// and those whose values are not allowed, or nil if all required tags are present with allowed values.
+// Resources whose types match an excluded resource type pattern are not checked.
+func (rc *RequiredTagsConfig) Check(resourceType string, tags KeyValueTags) error {
+	if rc == nil {
+		return nil
+	}
+
+	for _, pattern := range rc.ExcludeResourceTypes {
+		if ok, _ := path.Match(pattern, resourceType); ok {
+			return nil
+		}
+	}
+
+	var missing, disallowed []string
+
+	for _, key := range rc.Keys {
+		value := tags.KeyValue(key)
+
+		if value == nil {
+			missing = append(missing, key)
+			continue
+		}
+
+		if re, ok := rc.AllowedValues[key]; ok && !re.MatchString(*value) {
+			disallowed = append(disallowed, fmt.Sprintf("%s (%q does not match %q)", key, *value, re))
+		}
+	}
+
+	if len(missing) == 0 && len(disallowed) == 0 {
+		return nil
+	}
+
+	var problems []string
+
+	if len(missing) > 0 {
+		problems = append(problems, fmt.Sprintf("missing required tags: %s", strings.Join(missing, ", ")))
+	}
+
+	if len(disallowed) > 0 {
+		problems = append(problems, fmt.Sprintf("required tags with disallowed values: %s", strings.Join(disallowed, ", ")))
+	}
+
+	if resourceType == "" {
+		resourceType = "resource"
 	}
 
-	return dc.Tags.ContainsAll(tags)
+	return fmt.Errorf("%s does not satisfy the provider's required_tags policy: %s", resourceType, strings.Join(problems, "; "))
 }
 
 // IgnoreConfig returns any tags not removed by a given configuration.
@@ -99,6 +222,9 @@ func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 
 	result := tags.IgnorePrefixes(config.KeyPrefixes)
 	result = result.Ignore(config.Keys)
+	result = result.IgnoreKeyPatterns(config.KeyPatterns)
+	result = result.IgnoreKeyRegexes(config.KeyRegexes)
+	result = result.IgnoreValueRegexes(config.ValueRegexes)
 
 	return result
 }
@@ -150,6 +276,81 @@ func (tags KeyValueTags) IgnorePrefixes(ignoreTagPrefixes KeyValueTags) KeyValue
 	return result
 }
 
+// IgnoreKeyPatterns returns tags whose keys do not match any of the glob patterns.
+// Patterns use the syntax of path.Match, in which `*` does not match `/`.
+func (tags KeyValueTags) IgnoreKeyPatterns(ignoreTagKeyPatterns []string) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		var ignore bool
+
+		for _, pattern := range ignoreTagKeyPatterns {
+			if ok, _ := path.Match(pattern, k); ok {
+				ignore = true
+				break
+			}
+		}
+
+		if ignore {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// IgnoreKeyRegexes returns tags whose keys do not match any of the regular expressions.
+func (tags KeyValueTags) IgnoreKeyRegexes(ignoreTagKeyRegexes []*regexp.Regexp) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		var ignore bool
+
+		for _, re := range ignoreTagKeyRegexes {
+			if re.MatchString(k) {
+				ignore = true
+				break
+			}
+		}
+
+		if ignore {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// IgnoreValueRegexes returns tags whose values do not match any of the regular expressions.
+func (tags KeyValueTags) IgnoreValueRegexes(ignoreTagValueRegexes []*regexp.Regexp) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		var ignore bool
+
+		if v != nil && v.Value != nil {
+			for _, re := range ignoreTagValueRegexes {
+				if re.MatchString(*v.Value) {
+					ignore = true
+					break
+				}
+			}
+		}
+
+		if ignore {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
 // IgnoreRDS returns non-AWS and non-RDS tag keys.
 func (tags KeyValueTags) IgnoreRDS() KeyValueTags {
 	result := make(KeyValueTags)
@@ -475,15 +676,17 @@ func (tags KeyValueTags) Hash() int {
 // however, if all tags present in the DefaultConfig object are equivalent to those
 // in the given KeyValueTags, then the KeyValueTags are returned, effectively
 // bypassing the need to remove differing tags.
-func (tags KeyValueTags) RemoveDefaultConfig(dc *DefaultConfig) KeyValueTags {
-	if dc == nil || dc.Tags == nil {
+func (tags KeyValueTags) RemoveDefaultConfig(ctx context.Context, dc *DefaultConfig) KeyValueTags {
+	defaultTags := dc.TagsForResourceType(ResourceTypeFromContext(ctx))
+
+	if defaultTags == nil {
 		return tags
 	}
 
 	result := make(KeyValueTags)
 
 	for k, v := range tags {
-		if defaultVal, ok := dc.Tags[k]; !ok || !v.Equal(defaultVal) {
+		if defaultVal, ok := defaultTags[k]; !ok || !v.Equal(defaultVal) {
 			result[k] = v
 		}
 	}
@@ -2,6 +2,7 @@ package tags
 
 import (
 	"context"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -193,7 +194,7 @@ func TestKeyValueTagsDefaultConfigMergeTags(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			t.Parallel()
 
-			got := testCase.defaultConfig.MergeTags(testCase.tags)
+			got := testCase.defaultConfig.MergeTags(ctx, testCase.tags)
 			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
 		})
 	}
@@ -304,7 +305,7 @@ func TestKeyValueTagsDefaultConfigTagsEqual(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			t.Parallel()
 
-			got := testCase.defaultConfig.TagsEqual(testCase.tags)
+			got := testCase.defaultConfig.TagsEqual(ctx, testCase.tags)
 
 			if got != testCase.want {
 				t.Errorf("got %t; want %t", got, testCase.want)
@@ -313,6 +314,223 @@ func TestKeyValueTagsDefaultConfigTagsEqual(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsDefaultConfigTagsForResourceType(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	defaultConfig := &DefaultConfig{
+		Rules: []DefaultTagsRule{
+			{
+				RemoveKeys:    []string{"Propagate"},
+				ResourceTypes: []string{"aws_autoscaling_*"},
+			},
+			{
+				ResourceTypes: []string{"aws_instance", "aws_spot_instance_request"},
+				Tags: New(ctx, map[string]string{
+					"PatchGroup": "default",
+				}),
+			},
+			{
+				ResourceTypes: []string{"aws_instance"},
+				Tags: New(ctx, map[string]string{
+					"PatchGroup": "instances",
+				}),
+			},
+		},
+		Tags: New(ctx, map[string]string{
+			"Environment": "test",
+			"Propagate":   "true",
+		}),
+	}
+	testCases := []struct {
+		name          string
+		defaultConfig *DefaultConfig
+		resourceType  string
+		want          map[string]string
+	}{
+		{
+			name:          "nil config",
+			defaultConfig: nil,
+			resourceType:  "aws_instance",
+			want:          map[string]string{},
+		},
+		{
+			name:          "no matching rules",
+			defaultConfig: defaultConfig,
+			resourceType:  "aws_vpc",
+			want: map[string]string{
+				"Environment": "test",
+				"Propagate":   "true",
+			},
+		},
+		{
+			name:          "no resource type",
+			defaultConfig: defaultConfig,
+			want: map[string]string{
+				"Environment": "test",
+				"Propagate":   "true",
+			},
+		},
+		{
+			name:          "remove keys",
+			defaultConfig: defaultConfig,
+			resourceType:  "aws_autoscaling_group",
+			want: map[string]string{
+				"Environment": "test",
+			},
+		},
+		{
+			name:          "rules applied in order",
+			defaultConfig: defaultConfig,
+			resourceType:  "aws_instance",
+			want: map[string]string{
+				"Environment": "test",
+				"PatchGroup":  "instances",
+				"Propagate":   "true",
+			},
+		},
+		{
+			name:          "add keys",
+			defaultConfig: defaultConfig,
+			resourceType:  "aws_spot_instance_request",
+			want: map[string]string{
+				"Environment": "test",
+				"PatchGroup":  "default",
+				"Propagate":   "true",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.defaultConfig.TagsForResourceType(testCase.resourceType)
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+
+			// The resource type is carried by the context when merging and removing tags.
+			ctx := NewResourceTypeContext(ctx, testCase.resourceType)
+			got = testCase.defaultConfig.MergeTags(ctx, New(ctx, map[string]string{}))
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+
+			got = New(ctx, testCase.want).RemoveDefaultConfig(ctx, testCase.defaultConfig)
+			testKeyValueTagsVerifyMap(t, got.Map(), map[string]string{})
+
+			// Rules don't alter the provider-level tags.
+			if testCase.defaultConfig != nil {
+				testKeyValueTagsVerifyMap(t, testCase.defaultConfig.Tags.Map(), map[string]string{
+					"Environment": "test",
+					"Propagate":   "true",
+				})
+			}
+		})
+	}
+}
+
+func TestKeyValueTagsRequiredTagsConfigCheck(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	requiredTags := &RequiredTagsConfig{
+		AllowedValues: map[string]*regexp.Regexp{
+			"Environment": regexp.MustCompile(`^(?:dev|prod)$`),
+		},
+		ExcludeResourceTypes: []string{"aws_untaggable", "aws_untagged_*"},
+		Keys:                 []string{"CostCenter", "Environment", "Owner"},
+	}
+	testCases := []struct {
+		name         string
+		requiredTags *RequiredTagsConfig
+		resourceType string
+		tags         KeyValueTags
+		wantErr      string
+	}{
+		{
+			name:         "nil config",
+			requiredTags: nil,
+			resourceType: "aws_vpc",
+			tags:         New(ctx, map[string]string{}),
+		},
+		{
+			name:         "all present",
+			requiredTags: requiredTags,
+			resourceType: "aws_vpc",
+			tags: New(ctx, map[string]string{
+				"CostCenter":  "1234",
+				"Environment": "prod",
+				"Owner":       "team",
+				"key1":        "value1",
+			}),
+		},
+		{
+			name:         "missing",
+			requiredTags: requiredTags,
+			resourceType: "aws_vpc",
+			tags: New(ctx, map[string]string{
+				"Environment": "dev",
+			}),
+			wantErr: `aws_vpc does not satisfy the provider's required_tags policy: missing required tags: CostCenter, Owner`,
+		},
+		{
+			name:         "disallowed value",
+			requiredTags: requiredTags,
+			resourceType: "aws_vpc",
+			tags: New(ctx, map[string]string{
+				"CostCenter":  "1234",
+				"Environment": "qa",
+			}),
+			wantErr: `aws_vpc does not satisfy the provider's required_tags policy: missing required tags: Owner; required tags with disallowed values: Environment ("qa" does not match "^(?:dev|prod)$")`,
+		},
+		{
+			name:         "excluded resource type",
+			requiredTags: requiredTags,
+			resourceType: "aws_untaggable",
+			tags:         New(ctx, map[string]string{}),
+		},
+		{
+			name:         "excluded resource type pattern",
+			requiredTags: requiredTags,
+			resourceType: "aws_untagged_thing",
+			tags:         New(ctx, map[string]string{}),
+		},
+		{
+			name:         "unknown resource type",
+			requiredTags: requiredTags,
+			tags: New(ctx, map[string]string{
+				"CostCenter":  "1234",
+				"Environment": "dev",
+			}),
+			wantErr: `resource does not satisfy the provider's required_tags policy: missing required tags: Owner`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.requiredTags.Check(testCase.resourceType, testCase.tags)
+
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error %q", testCase.wantErr)
+			}
+
+			if got, want := err.Error(), testCase.wantErr; got != want {
+				t.Errorf("got error %q; want %q", got, want)
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreAWS(t *testing.T) { // nosemgrep:ci.aws-in-func-name
 	t.Parallel()
 
@@ -564,6 +782,23 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 				"key3": "value3",
 			},
 		},
+		{
+			name: "patterns and regexes",
+			tags: New(ctx, map[string]string{
+				"kubernetes.io/cluster/example": "owned",
+				"aws-backup-plan":               "daily",
+				"key1":                          "managed-by-scanner",
+				"key2":                          "value2",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeyPatterns:  []string{"kubernetes.io/cluster/*"},
+				KeyRegexes:   []*regexp.Regexp{regexp.MustCompile(`^aws-backup-`)},
+				ValueRegexes: []*regexp.Regexp{regexp.MustCompile(`^managed-by-`)},
+			},
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -735,6 +970,171 @@ func TestKeyValueTagsIgnorePrefixes(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsIgnoreKeyPatterns(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name                 string
+		tags                 KeyValueTags
+		ignoreTagKeyPatterns []string
+		want                 map[string]string
+	}{
+		{
+			name:                 "empty",
+			tags:                 New(ctx, map[string]string{}),
+			ignoreTagKeyPatterns: []string{"kubernetes.io/cluster/*"},
+			want:                 map[string]string{},
+		},
+		{
+			name: "none",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			ignoreTagKeyPatterns: []string{"kubernetes.io/cluster/*"},
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+		{
+			name: "mixed",
+			tags: New(ctx, map[string]string{
+				"kubernetes.io/cluster/example": "owned",
+				"kubernetes.io/role/elb":        "1",
+				"backup-plan-1":                 "daily",
+				"key1":                          "value1",
+			}),
+			ignoreTagKeyPatterns: []string{
+				"kubernetes.io/cluster/*",
+				"backup-plan-?",
+			},
+			want: map[string]string{
+				"kubernetes.io/role/elb": "1",
+				"key1":                   "value1",
+			},
+		},
+		{
+			name: "star does not match separator",
+			tags: New(ctx, map[string]string{
+				"kubernetes.io/cluster/example": "owned",
+			}),
+			ignoreTagKeyPatterns: []string{"kubernetes.io/*"},
+			want: map[string]string{
+				"kubernetes.io/cluster/example": "owned",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.tags.IgnoreKeyPatterns(testCase.ignoreTagKeyPatterns)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsIgnoreKeyRegexes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name                string
+		tags                KeyValueTags
+		ignoreTagKeyRegexes []*regexp.Regexp
+		want                map[string]string
+	}{
+		{
+			name:                "empty",
+			tags:                New(ctx, map[string]string{}),
+			ignoreTagKeyRegexes: []*regexp.Regexp{regexp.MustCompile(`^key`)},
+			want:                map[string]string{},
+		},
+		{
+			name: "mixed",
+			tags: New(ctx, map[string]string{
+				"kubernetes.io/cluster/example": "owned",
+				"Scanner-Finding-123":           "low",
+				"key1":                          "value1",
+			}),
+			ignoreTagKeyRegexes: []*regexp.Regexp{
+				regexp.MustCompile(`^kubernetes\.io/cluster/`),
+				regexp.MustCompile(`(?i)^scanner-finding-\d+$`),
+			},
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.tags.IgnoreKeyRegexes(testCase.ignoreTagKeyRegexes)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsIgnoreValueRegexes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name                  string
+		tags                  KeyValueTags
+		ignoreTagValueRegexes []*regexp.Regexp
+		want                  map[string]string
+	}{
+		{
+			name:                  "empty",
+			tags:                  New(ctx, map[string]string{}),
+			ignoreTagValueRegexes: []*regexp.Regexp{regexp.MustCompile(`^managed-by-`)},
+			want:                  map[string]string{},
+		},
+		{
+			name: "mixed",
+			tags: New(ctx, map[string]string{
+				"key1": "managed-by-scanner",
+				"key2": "value2",
+			}),
+			ignoreTagValueRegexes: []*regexp.Regexp{regexp.MustCompile(`^managed-by-`)},
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+		{
+			name: "nil value",
+			tags: New(ctx, []string{
+				"key1",
+			}),
+			ignoreTagValueRegexes: []*regexp.Regexp{regexp.MustCompile(`.*`)},
+			want: map[string]string{
+				"key1": "",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.tags.IgnoreValueRegexes(testCase.ignoreTagValueRegexes)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreRDS(t *testing.T) {
 	t.Parallel()
 
@@ -2266,7 +2666,7 @@ func TestKeyValueTagsRemoveDefaultConfig(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			t.Parallel()
 
-			got := testCase.tags.RemoveDefaultConfig(testCase.defaultConfig)
+			got := testCase.tags.RemoveDefaultConfig(ctx, testCase.defaultConfig)
 
 			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
 		})
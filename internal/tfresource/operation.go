@@ -0,0 +1,189 @@
+package tfresource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Resumable operations.
+// A resource that waits a long time for AWS to complete an operation, e.g. a create, records the operation in its private state.
+// If the wait times out or the provider is stopped, the resource operation succeeds with a warning
+// and the resource's state is saved with the operation still in flight.
+// The resource's following Reads check, without waiting, whether the operation has completed,
+// and its following Update waits for the operation to complete before changing the resource.
+// If the provider process exits before the resource's state is saved, the resource's next Create adopts the resource instead.
+
+// InFlightOperationPrivateStateKey is the resource private state key under which an in-flight operation is kept.
+const InFlightOperationPrivateStateKey = "aws_in_flight_operation"
+
+// InFlightOperation is an operation that a resource has started but not yet seen complete.
+type InFlightOperation struct {
+	ID      string    `json:"id"` // The operation's ARN, request ID or change ID.
+	Started time.Time `json:"started"`
+}
+
+type inFlightOperationKey struct{}
+
+type inFlightOperationsStopKey struct{}
+
+// inFlightOperationState tracks a resource's in-flight operation during a resource operation.
+type inFlightOperationState struct {
+	mu        sync.Mutex
+	operation *InFlightOperation
+	changed   bool
+	stop      <-chan struct{}
+}
+
+// NewInFlightOperationsStopContext returns a copy of the specified context in which waits for in-flight operations
+// are interrupted when the stop channel is closed, e.g. when Terraform stops the provider.
+func NewInFlightOperationsStopContext(ctx context.Context, stop <-chan struct{}) context.Context {
+	return context.WithValue(ctx, inFlightOperationsStopKey{}, stop)
+}
+
+// NewInFlightOperationContext returns a copy of the specified context that tracks the resource's in-flight operation,
+// starting with the one recorded in the resource's private state, if any.
+func NewInFlightOperationContext(ctx context.Context, operation *InFlightOperation) context.Context {
+	stop, _ := ctx.Value(inFlightOperationsStopKey{}).(<-chan struct{})
+
+	return context.WithValue(ctx, inFlightOperationKey{}, &inFlightOperationState{operation: operation, stop: stop})
+}
+
+// InFlightOperationFromContext returns the resource's in-flight operation, if any, and whether it has changed during the resource operation.
+func InFlightOperationFromContext(ctx context.Context) (*InFlightOperation, bool) {
+	state, ok := ctx.Value(inFlightOperationKey{}).(*inFlightOperationState)
+
+	if !ok {
+		return nil, false
+	}
+
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	return state.operation, state.changed
+}
+
+// InFlightOperationError is returned when a wait for an in-flight operation is interrupted.
+// The operation is kept in the resource's private state so that the wait can be resumed.
+type InFlightOperationError struct {
+	OperationID string
+	Err         error
+}
+
+func (e *InFlightOperationError) Error() string {
+	return fmt.Sprintf("operation (%s) still in progress: %s", e.OperationID, e.Err)
+}
+
+func (e *InFlightOperationError) Unwrap() error {
+	return e.Err
+}
+
+// OperationInFlight returns true if the error represents an interrupted wait for an in-flight operation.
+func OperationInFlight(err error) bool {
+	var e *InFlightOperationError
+	return errors.As(err, &e)
+}
+
+// WaitInFlightOperation records the specified operation as the resource's in-flight operation and calls wait.
+// If wait times out or the provider is stopped, an InFlightOperationError is returned and the operation is kept in flight.
+// Otherwise the operation is no longer in flight and wait's result is returned.
+// If the resource's private state is not available, WaitInFlightOperation just calls wait.
+func WaitInFlightOperation(ctx context.Context, operationID string, wait func(context.Context) error) error {
+	state, ok := ctx.Value(inFlightOperationKey{}).(*inFlightOperationState)
+
+	if !ok {
+		return wait(ctx)
+	}
+
+	state.set(&InFlightOperation{ID: operationID, Started: time.Now()})
+
+	return state.wait(ctx, operationID, wait)
+}
+
+// ResumeInFlightOperation resumes the wait for the resource's in-flight operation, if any.
+// Called by a resource's Update before it changes the resource. The result is as for WaitInFlightOperation.
+func ResumeInFlightOperation(ctx context.Context, wait func(context.Context) error) error {
+	state, operation := inFlightOperationStateFromContext(ctx)
+
+	if operation == nil {
+		return nil
+	}
+
+	tflog.Info(ctx, "Resuming wait for in-flight operation", map[string]any{
+		"operation_id": operation.ID,
+		"started":      operation.Started.Format(time.RFC3339),
+	})
+
+	return state.wait(ctx, operation.ID, wait)
+}
+
+// CheckInFlightOperation records whether the resource's in-flight operation, if any, has completed,
+// as seen in the resource's current status. Called by a resource's Read, which doesn't wait for the operation.
+// If the operation has not completed, an InFlightOperationError is returned and the operation is kept in flight.
+func CheckInFlightOperation(ctx context.Context, done bool) error {
+	state, operation := inFlightOperationStateFromContext(ctx)
+
+	if operation == nil {
+		return nil
+	}
+
+	if done {
+		state.set(nil)
+
+		return nil
+	}
+
+	return &InFlightOperationError{OperationID: operation.ID, Err: fmt.Errorf("started %s", operation.Started.Format(time.RFC3339))}
+}
+
+func inFlightOperationStateFromContext(ctx context.Context) (*inFlightOperationState, *InFlightOperation) {
+	state, ok := ctx.Value(inFlightOperationKey{}).(*inFlightOperationState)
+
+	if !ok {
+		return nil, nil
+	}
+
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	return state, state.operation
+}
+
+func (s *inFlightOperationState) set(operation *InFlightOperation) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.operation = operation
+	s.changed = true
+}
+
+func (s *inFlightOperationState) wait(ctx context.Context, operationID string, wait func(context.Context) error) error {
+	waitCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	if s.stop != nil {
+		go func() {
+			select {
+			case <-s.stop:
+				cancel()
+			case <-waitCtx.Done():
+			}
+		}()
+	}
+
+	err := wait(waitCtx)
+
+	var timeoutErr *resource.TimeoutError
+	if err != nil && (errors.As(err, &timeoutErr) || waitCtx.Err() != nil) {
+		return &InFlightOperationError{OperationID: operationID, Err: err}
+	}
+
+	s.set(nil)
+
+	return err
+}
@@ -0,0 +1,188 @@
+package tfresource_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestWaitInFlightOperation(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name              string
+		Wait              func(context.Context) error
+		Stop              bool
+		ExpectError       bool
+		ExpectInFlight    bool
+		ExpectedOperation bool
+	}{
+		{
+			Name: "success",
+			Wait: func(context.Context) error {
+				return nil
+			},
+		},
+		{
+			Name: "failure",
+			Wait: func(context.Context) error {
+				return errors.New("failed")
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "timeout",
+			Wait: func(context.Context) error {
+				return &resource.TimeoutError{LastState: "creating"}
+			},
+			ExpectError:       true,
+			ExpectInFlight:    true,
+			ExpectedOperation: true,
+		},
+		{
+			Name: "stopped",
+			Wait: func(ctx context.Context) error {
+				<-ctx.Done()
+				return ctx.Err()
+			},
+			Stop:              true,
+			ExpectError:       true,
+			ExpectInFlight:    true,
+			ExpectedOperation: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			stop := make(chan struct{})
+			ctx := tfresource.NewInFlightOperationContext(tfresource.NewInFlightOperationsStopContext(context.Background(), stop), nil)
+
+			if testCase.Stop {
+				close(stop)
+			}
+
+			err := tfresource.WaitInFlightOperation(ctx, "test-operation", testCase.Wait)
+
+			if got, want := err != nil, testCase.ExpectError; got != want {
+				t.Fatalf("error = %v, want error %t", err, want)
+			}
+
+			if got, want := tfresource.OperationInFlight(err), testCase.ExpectInFlight; got != want {
+				t.Errorf("OperationInFlight = %t, want %t", got, want)
+			}
+
+			operation, changed := tfresource.InFlightOperationFromContext(ctx)
+
+			if !changed {
+				t.Error("expected in-flight operation to have changed")
+			}
+
+			if got, want := operation != nil, testCase.ExpectedOperation; got != want {
+				t.Fatalf("operation = %v, want operation %t", operation, want)
+			}
+
+			if operation != nil && operation.ID != "test-operation" {
+				t.Errorf("operation ID = %q", operation.ID)
+			}
+		})
+	}
+}
+
+func TestResumeInFlightOperation(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+	wait := func(ctx context.Context) error {
+		calls++
+
+		return nil
+	}
+
+	// No in-flight operation.
+	ctx := tfresource.NewInFlightOperationContext(context.Background(), nil)
+
+	if err := tfresource.ResumeInFlightOperation(ctx, wait); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if calls != 0 {
+		t.Errorf("calls = %d, want 0", calls)
+	}
+
+	// In-flight operation.
+	ctx = tfresource.NewInFlightOperationContext(context.Background(), &tfresource.InFlightOperation{ID: "test", Started: time.Now()})
+
+	if err := tfresource.ResumeInFlightOperation(ctx, wait); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if calls != 1 {
+		t.Errorf("calls = %d, want 1", calls)
+	}
+
+	if operation, changed := tfresource.InFlightOperationFromContext(ctx); operation != nil || !changed {
+		t.Errorf("operation = %v, changed = %t; want completed operation", operation, changed)
+	}
+
+	// Interrupted resumed wait.
+	ctx, cancel := context.WithCancel(context.Background())
+	ctx = tfresource.NewInFlightOperationContext(ctx, &tfresource.InFlightOperation{ID: "test", Started: time.Now()})
+	cancel()
+
+	if err := tfresource.ResumeInFlightOperation(ctx, func(ctx context.Context) error {
+		return ctx.Err()
+	}); !tfresource.OperationInFlight(err) {
+		t.Errorf("expected interrupted resumed wait to be in flight, got %v", err)
+	}
+
+	// No private state.
+	if err := tfresource.WaitInFlightOperation(context.Background(), "test", func(context.Context) error {
+		return &resource.TimeoutError{}
+	}); tfresource.OperationInFlight(err) {
+		t.Error("expected wait without private state not to be resumable")
+	}
+}
+
+func TestCheckInFlightOperation(t *testing.T) {
+	t.Parallel()
+
+	// No in-flight operation.
+	ctx := tfresource.NewInFlightOperationContext(context.Background(), nil)
+
+	if err := tfresource.CheckInFlightOperation(ctx, false); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, changed := tfresource.InFlightOperationFromContext(ctx); changed {
+		t.Error("expected no in-flight operation change")
+	}
+
+	// In-flight operation still in progress.
+	operation := &tfresource.InFlightOperation{ID: "test", Started: time.Now()}
+	ctx = tfresource.NewInFlightOperationContext(context.Background(), operation)
+
+	if err := tfresource.CheckInFlightOperation(ctx, false); !tfresource.OperationInFlight(err) {
+		t.Errorf("expected operation to be in flight, got %v", err)
+	}
+
+	if got, changed := tfresource.InFlightOperationFromContext(ctx); got != operation || changed {
+		t.Errorf("operation = %v, changed = %t; want unchanged operation", got, changed)
+	}
+
+	// In-flight operation completed.
+	if err := tfresource.CheckInFlightOperation(ctx, true); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, changed := tfresource.InFlightOperationFromContext(ctx); got != nil || !changed {
+		t.Errorf("operation = %v, changed = %t; want completed operation", got, changed)
+	}
+}
@@ -0,0 +1,415 @@
+package tfresource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/throttling"
+)
+
+// Progress reporting for waits.
+// Each wait logs the states it sees, the time elapsed and an estimate of the time remaining.
+// The estimate is based on the percentage of completion reported by the service, if any,
+// or on the durations of earlier waits with the same name.
+
+const (
+	// progressLogInterval is the interval at which a wait whose state has not changed is logged.
+	progressLogInterval = 1 * time.Minute
+	// progressSummaryThreshold is the duration above which a wait is summarized in a warning when its resource operation completes.
+	progressSummaryThreshold = 10 * time.Minute
+	// waitHistoryThreshold is the duration above which a successful wait is added to the wait history.
+	waitHistoryThreshold = 30 * time.Second
+	// waitHistorySize is the number of durations kept for each wait name.
+	waitHistorySize = 10
+)
+
+type progressKey struct{}
+
+// operationProgress tracks the waits made during a resource operation.
+type operationProgress struct {
+	mu      sync.Mutex
+	name    string
+	current *waitProgress
+	waits   []*waitProgress
+}
+
+// NewProgressContext returns a copy of the specified context that tracks the waits made during the named resource operation,
+// e.g. "aws_rds_cluster.Create".
+func NewProgressContext(ctx context.Context, operation string) context.Context {
+	return context.WithValue(ctx, progressKey{}, &operationProgress{name: operation})
+}
+
+func operationProgressFromContext(ctx context.Context) *operationProgress {
+	op, _ := ctx.Value(progressKey{}).(*operationProgress)
+
+	return op
+}
+
+// ReportProgress attaches a percentage of completion and a message to the current wait, if any.
+// Status functions call it with progress information returned by AWS, e.g. RDS's PercentProgress
+// or the latest CloudFormation stack event. A negative percentage means that the percentage is not known.
+func ReportProgress(ctx context.Context, percent int, message string) {
+	op := operationProgressFromContext(ctx)
+
+	if op == nil {
+		return
+	}
+
+	op.mu.Lock()
+	w := op.current
+	op.mu.Unlock()
+
+	if w == nil {
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if percent == w.percent && message == w.message {
+		return
+	}
+
+	w.percent, w.message = percent, message
+	w.log(ctx, time.Now(), "Wait progress")
+}
+
+// ProgressSummaries returns summaries of the waits made during a resource operation that took longer than 10 minutes.
+func ProgressSummaries(ctx context.Context) []string {
+	op := operationProgressFromContext(ctx)
+
+	if op == nil {
+		return nil
+	}
+
+	op.mu.Lock()
+	defer op.mu.Unlock()
+
+	var summaries []string
+
+	for _, w := range op.waits {
+		if v := w.summary(); v != "" {
+			summaries = append(summaries, v)
+		}
+	}
+
+	return summaries
+}
+
+// waitProgress tracks the progress of a single wait.
+type waitProgress struct {
+	mu          sync.Mutex
+	name        string
+	op          *operationProgress
+	previous    *waitProgress // The operation's current wait when this wait started.
+	start       time.Time
+	state       string
+	stateStart  time.Time
+	transitions []stateDuration
+	percent     int
+	message     string
+	lastLog     time.Time
+}
+
+type stateDuration struct {
+	state    string
+	duration time.Duration
+}
+
+// startWaitProgress starts tracking the progress of a wait.
+// The wait's name is qualified by the resource operation or, failing that, the resource type.
+func startWaitProgress(ctx context.Context, name string) *waitProgress {
+	op := operationProgressFromContext(ctx)
+
+	if op != nil {
+		name = op.name + ":" + name
+	} else if resourceType := tftags.ResourceTypeFromContext(ctx); resourceType != "" {
+		name = resourceType + ":" + name
+	}
+
+	now := time.Now()
+	w := &waitProgress{
+		name:       name,
+		op:         op,
+		start:      now,
+		stateStart: now,
+		percent:    -1,
+		lastLog:    now,
+	}
+
+	if op != nil {
+		op.mu.Lock()
+		w.previous = op.current
+		op.current = w
+		op.waits = append(op.waits, w)
+		op.mu.Unlock()
+	}
+
+	fields := map[string]any{
+		"wait": name,
+	}
+
+	if expected, ok := processWaitHistory.expected(name); ok {
+		fields["expected"] = expected.Round(time.Second).String()
+	}
+
+	tflog.Debug(ctx, "Wait started", fields)
+
+	return w
+}
+
+// observe records the state returned by a poll.
+func (w *waitProgress) observe(ctx context.Context, state string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	now := time.Now()
+
+	if state == w.state {
+		if now.Sub(w.lastLog) >= progressLogInterval {
+			w.log(ctx, now, "Still waiting")
+		}
+
+		return
+	}
+
+	if w.state != "" {
+		w.transitions = append(w.transitions, stateDuration{state: w.state, duration: now.Sub(w.stateStart)})
+	}
+
+	tflog.Debug(ctx, "Wait state changed", map[string]any{
+		"wait":       w.name,
+		"from_state": w.state,
+		"to_state":   state,
+		"elapsed":    now.Sub(w.start).Round(time.Second).String(),
+	})
+
+	w.state, w.stateStart = state, now
+}
+
+// finish records the end of the wait.
+func (w *waitProgress) finish(ctx context.Context, err error) {
+	if op := w.op; op != nil {
+		op.mu.Lock()
+		if op.current == w {
+			op.current = w.previous
+		}
+		op.mu.Unlock()
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	now := time.Now()
+	elapsed := now.Sub(w.start)
+
+	if w.state != "" {
+		w.transitions = append(w.transitions, stateDuration{state: w.state, duration: now.Sub(w.stateStart)})
+	}
+
+	fields := map[string]any{
+		"wait":    w.name,
+		"elapsed": elapsed.Round(time.Second).String(),
+	}
+
+	if err != nil {
+		fields["error"] = err.Error()
+	} else if elapsed >= waitHistoryThreshold {
+		processWaitHistory.record(w.name, elapsed)
+	}
+
+	tflog.Debug(ctx, "Wait finished", fields)
+}
+
+// log logs the wait's progress. The caller must hold the wait's lock.
+func (w *waitProgress) log(ctx context.Context, now time.Time, msg string) {
+	elapsed := now.Sub(w.start)
+	fields := map[string]any{
+		"wait":    w.name,
+		"state":   w.state,
+		"elapsed": elapsed.Round(time.Second).String(),
+	}
+
+	if eta, ok := w.eta(elapsed); ok {
+		fields["eta"] = eta.Round(time.Second).String()
+	}
+
+	if w.percent >= 0 {
+		fields["percent"] = w.percent
+	}
+
+	if w.message != "" {
+		fields["progress"] = w.message
+	}
+
+	tflog.Info(ctx, msg, fields)
+	w.lastLog = now
+}
+
+// eta estimates the time remaining from the reported percentage of completion or from the wait history.
+func (w *waitProgress) eta(elapsed time.Duration) (time.Duration, bool) {
+	if w.percent > 0 && w.percent < 100 {
+		return time.Duration(float64(elapsed) * float64(100-w.percent) / float64(w.percent)), true
+	}
+
+	if expected, ok := processWaitHistory.expected(w.name); ok && expected > elapsed {
+		return expected - elapsed, true
+	}
+
+	return 0, false
+}
+
+// summary returns a summary of the wait's state transitions if it took longer than progressSummaryThreshold.
+func (w *waitProgress) summary() string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var elapsed time.Duration
+	var states []string
+
+	for _, v := range w.transitions {
+		elapsed += v.duration
+		states = append(states, fmt.Sprintf("%s (%s)", v.state, v.duration.Round(time.Second)))
+	}
+
+	if elapsed < progressSummaryThreshold {
+		return ""
+	}
+
+	return fmt.Sprintf("waited %s for %s: %s", elapsed.Round(time.Second), w.name, strings.Join(states, " -> "))
+}
+
+// waitHistory holds the durations of recent successful waits, by wait name.
+// If the TF_AWS_WAIT_HISTORY_FILE environment variable is set, the history is persisted to that file between runs.
+type waitHistory struct {
+	mu        sync.Mutex
+	durations map[string][]time.Duration
+	file      string
+	loaded    bool
+}
+
+var processWaitHistory = newWaitHistory(os.Getenv(envvar.WaitHistoryFile))
+
+// newWaitHistory returns a wait history that is persisted to the specified file, if any.
+func newWaitHistory(file string) *waitHistory {
+	return &waitHistory{file: file}
+}
+
+// load loads the wait history on first use. The caller must hold the lock.
+func (h *waitHistory) load() {
+	if h.loaded {
+		return
+	}
+
+	h.loaded = true
+	h.durations = make(map[string][]time.Duration)
+
+	if h.file == "" {
+		return
+	}
+
+	b, err := os.ReadFile(h.file)
+
+	if err != nil {
+		return
+	}
+
+	// An unreadable history is ignored.
+	_ = json.Unmarshal(b, &h.durations)
+}
+
+func (h *waitHistory) record(name string, d time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.load()
+
+	durations := append(h.durations[name], d)
+	if n := len(durations); n > waitHistorySize {
+		durations = durations[n-waitHistorySize:]
+	}
+	h.durations[name] = durations
+
+	if h.file == "" {
+		return
+	}
+
+	if b, err := json.Marshal(h.durations); err == nil {
+		_ = os.WriteFile(h.file, b, 0600)
+	}
+}
+
+// expected returns the mean duration of the recent successful waits with the specified name.
+func (h *waitHistory) expected(name string) (time.Duration, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.load()
+
+	durations := h.durations[name]
+
+	if len(durations) == 0 {
+		return 0, false
+	}
+
+	var total time.Duration
+
+	for _, d := range durations {
+		total += d
+	}
+
+	return total / time.Duration(len(durations)), true
+}
+
+// WaitForStateContext calls the specified StateChangeConf's WaitForStateContext, reporting the wait's progress.
+// Each refresh first backs off while any AWS service called during the resource operation is throttled.
+// The wait is named after its target states, or "deleted" if there are none.
+// The specified StateChangeConf is not modified, so it can be reused.
+func WaitForStateContext(ctx context.Context, conf *resource.StateChangeConf) (interface{}, error) {
+	name := "deleted"
+	if len(conf.Target) > 0 {
+		name = strings.Join(conf.Target, ",")
+	}
+
+	w := startWaitProgress(ctx, name)
+
+	c := *conf
+
+	if f := conf.Refresh; f != nil {
+		c.Refresh = func() (interface{}, string, error) {
+			if err := throttling.Wait(ctx); err != nil {
+				return nil, "", err
+			}
+
+			result, state, err := f()
+
+			// Options' refresh functions set the PollInterval for the next refresh on the original StateChangeConf.
+			c.PollInterval = conf.PollInterval
+
+			if err == nil {
+				if result == nil {
+					w.observe(ctx, "deleted")
+				} else {
+					w.observe(ctx, state)
+				}
+			}
+
+			return result, state, err
+		}
+	}
+
+	output, err := c.WaitForStateContext(ctx)
+
+	w.finish(ctx, err)
+
+	return output, err
+}
@@ -0,0 +1,226 @@
+package tfresource
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestWaitProgressETA(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name        string
+		WaitName    string
+		Percent     int
+		Elapsed     time.Duration
+		ExpectedOK  bool
+		ExpectedETA time.Duration
+	}{
+		{
+			Name:       "no information",
+			WaitName:   "TestWaitProgressETA:none",
+			Percent:    -1,
+			Elapsed:    time.Minute,
+			ExpectedOK: false,
+		},
+		{
+			Name:        "percent",
+			WaitName:    "TestWaitProgressETA:none",
+			Percent:     25,
+			Elapsed:     time.Minute,
+			ExpectedOK:  true,
+			ExpectedETA: 3 * time.Minute,
+		},
+		{
+			Name:        "history",
+			WaitName:    "TestWaitProgressETA:history",
+			Percent:     -1,
+			Elapsed:     time.Minute,
+			ExpectedOK:  true,
+			ExpectedETA: 9 * time.Minute,
+		},
+		{
+			Name:       "history exceeded",
+			WaitName:   "TestWaitProgressETA:history",
+			Percent:    -1,
+			Elapsed:    15 * time.Minute,
+			ExpectedOK: false,
+		},
+	}
+
+	processWaitHistory.record("TestWaitProgressETA:history", 8*time.Minute)
+	processWaitHistory.record("TestWaitProgressETA:history", 12*time.Minute)
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			w := &waitProgress{name: testCase.WaitName, percent: testCase.Percent}
+
+			eta, ok := w.eta(testCase.Elapsed)
+
+			if got, want := ok, testCase.ExpectedOK; got != want {
+				t.Fatalf("ok = %t, want %t", got, want)
+			}
+
+			if got, want := eta, testCase.ExpectedETA; got != want {
+				t.Errorf("eta = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestWaitProgressSummary(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name        string
+		Transitions []stateDuration
+		Expected    string
+	}{
+		{
+			Name: "short",
+			Transitions: []stateDuration{
+				{state: "creating", duration: 5 * time.Minute},
+				{state: "available", duration: 0},
+			},
+		},
+		{
+			Name: "long",
+			Transitions: []stateDuration{
+				{state: "creating", duration: 12 * time.Minute},
+				{state: "backing-up", duration: 3 * time.Minute},
+				{state: "available", duration: 0},
+			},
+			Expected: "waited 15m0s for aws_rds_cluster.Create:available: creating (12m0s) -> backing-up (3m0s) -> available (0s)",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			w := &waitProgress{name: "aws_rds_cluster.Create:available", transitions: testCase.Transitions}
+
+			if got, want := w.summary(), testCase.Expected; got != want {
+				t.Errorf("summary = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestWaitHistory(t *testing.T) {
+	t.Parallel()
+
+	file := filepath.Join(t.TempDir(), "history.json")
+	h := newWaitHistory(file)
+
+	if _, ok := h.expected("test"); ok {
+		t.Fatal("expected no history")
+	}
+
+	for i := 1; i <= waitHistorySize+2; i++ {
+		h.record("test", time.Duration(i)*time.Minute)
+	}
+
+	// The oldest 2 durations have been dropped.
+	if got, want := len(h.durations["test"]), waitHistorySize; got != want {
+		t.Errorf("len(durations) = %d, want %d", got, want)
+	}
+
+	// The history is reloaded from the file.
+	h = newWaitHistory(file)
+
+	expected, ok := h.expected("test")
+
+	if !ok {
+		t.Fatal("expected history")
+	}
+
+	if got, want := expected, 7*time.Minute+30*time.Second; got != want {
+		t.Errorf("expected = %s, want %s", got, want)
+	}
+}
+
+func TestWaitForStateContext(t *testing.T) {
+	t.Parallel()
+
+	ctx := NewProgressContext(context.Background(), "aws_test.Create")
+
+	var polls int
+	conf := &resource.StateChangeConf{
+		Pending: []string{"creating"},
+		Target:  []string{"available"},
+		Refresh: func() (interface{}, string, error) {
+			polls++
+			ReportProgress(ctx, polls*25, "")
+
+			if polls < 3 {
+				return 42, "creating", nil
+			}
+
+			return 42, "available", nil
+		},
+		Timeout:      time.Minute,
+		PollInterval: 10 * time.Millisecond,
+	}
+
+	refresh := reflect.ValueOf(conf.Refresh).Pointer()
+
+	output, err := WaitForStateContext(ctx, conf)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if reflect.ValueOf(conf.Refresh).Pointer() != refresh {
+		t.Error("expected Refresh to be unchanged")
+	}
+
+	if got, want := output, 42; got != want {
+		t.Errorf("output = %v, want %v", got, want)
+	}
+
+	op := operationProgressFromContext(ctx)
+
+	if got, want := len(op.waits), 1; got != want {
+		t.Fatalf("len(waits) = %d, want %d", got, want)
+	}
+
+	w := op.waits[0]
+
+	if got, want := w.name, "aws_test.Create:available"; got != want {
+		t.Errorf("name = %q, want %q", got, want)
+	}
+
+	if got, want := w.percent, 75; got != want {
+		t.Errorf("percent = %d, want %d", got, want)
+	}
+
+	var states []string
+	for _, v := range w.transitions {
+		states = append(states, v.state)
+	}
+
+	if got, want := strings.Join(states, ","), "creating,available"; got != want {
+		t.Errorf("states = %q, want %q", got, want)
+	}
+
+	if op.current != nil {
+		t.Error("expected no current wait")
+	}
+
+	if got := ProgressSummaries(ctx); len(got) != 0 {
+		t.Errorf("unexpected summaries: %v", got)
+	}
+}
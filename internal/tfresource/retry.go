@@ -9,6 +9,8 @@ import (
 
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/throttling"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
 )
 
 // Retryable is a function that is used to decide if a function's error is retryable or not.
@@ -17,10 +19,11 @@ import (
 // If the error is not retryable, returns a bool value of `false` and either no error (success state) or an error (not necessarily the error passed as the argument).
 type Retryable func(error) (bool, error)
 
-// RetryWhen retries the function `f` when the error it returns satisfies `predicate`.
+// RetryWhen retries the function `f` when the error it returns satisfies `retryable`.
 // `f` is retried until `timeout` expires.
-func RetryWhen(ctx context.Context, timeout time.Duration, f func() (interface{}, error), retryable Retryable) (interface{}, error) {
-	var output interface{}
+// The result of a successful call to `f` is returned as its own type, `T`.
+func RetryWhen[T any](ctx context.Context, timeout time.Duration, f func() (T, error), retryable Retryable, optFns ...OptionsFunc) (T, error) {
+	var output T
 
 	err := Retry(ctx, timeout, func() *resource.RetryError { // nosemgrep:ci.helper-schema-resource-Retry-without-TimeoutError-check
 		var err error
@@ -38,45 +41,52 @@ func RetryWhen(ctx context.Context, timeout time.Duration, f func() (interface{}
 		}
 
 		return nil
-	})
+	}, optFns...)
 
 	if TimedOut(err) {
 		output, err = f()
 	}
 
 	if err != nil {
-		return nil, err
+		var zero T
+		return zero, err
 	}
 
 	return output, nil
 }
 
-// RetryWhenAWSErrCodeEquals retries the specified function when it returns one of the specified AWS error code.
-func RetryWhenAWSErrCodeEquals(ctx context.Context, timeout time.Duration, f func() (interface{}, error), codes ...string) (interface{}, error) { // nosemgrep:ci.aws-in-func-name
+// RetryWhenAWSErrCodeEquals retries the specified function when it returns one of the specified AWS error codes.
+func RetryWhenAWSErrCodeEquals[T any](ctx context.Context, timeout time.Duration, f func() (T, error), codes ...string) (T, error) { // nosemgrep:ci.aws-in-func-name
+	return RetryWhenAWSErrCodeEqualsWithOptions(ctx, timeout, f, codes)
+}
+
+// RetryWhenAWSErrCodeEqualsWithOptions retries the specified function when it returns one of the specified AWS error codes,
+// using the specified retry options.
+func RetryWhenAWSErrCodeEqualsWithOptions[T any](ctx context.Context, timeout time.Duration, f func() (T, error), codes []string, optFns ...OptionsFunc) (T, error) { // nosemgrep:ci.aws-in-func-name
 	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
 		if tfawserr.ErrCodeEquals(err, codes...) {
 			return true, err
 		}
 
 		return false, err
-	})
+	}, optFns...)
 }
 
 // RetryWhenAWSErrMessageContains retries the specified function when it returns an AWS error containing the specified message.
-func RetryWhenAWSErrMessageContains(ctx context.Context, timeout time.Duration, f func() (interface{}, error), code, message string) (interface{}, error) { // nosemgrep:ci.aws-in-func-name
+func RetryWhenAWSErrMessageContains[T any](ctx context.Context, timeout time.Duration, f func() (T, error), code, message string, optFns ...OptionsFunc) (T, error) { // nosemgrep:ci.aws-in-func-name
 	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
 		if tfawserr.ErrMessageContains(err, code, message) {
 			return true, err
 		}
 
 		return false, err
-	})
+	}, optFns...)
 }
 
 var errFoundResource = errors.New(`found resource`)
 
 // RetryUntilNotFound retries the specified function until it returns a resource.NotFoundError.
-func RetryUntilNotFound(ctx context.Context, timeout time.Duration, f func() (interface{}, error)) (interface{}, error) {
+func RetryUntilNotFound[T any](ctx context.Context, timeout time.Duration, f func() (T, error), optFns ...OptionsFunc) (T, error) {
 	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
 		if NotFound(err) {
 			return false, nil
@@ -87,29 +97,29 @@ func RetryUntilNotFound(ctx context.Context, timeout time.Duration, f func() (in
 		}
 
 		return true, errFoundResource
-	})
+	}, optFns...)
 }
 
 // RetryWhenNotFound retries the specified function when it returns a resource.NotFoundError.
-func RetryWhenNotFound(ctx context.Context, timeout time.Duration, f func() (interface{}, error)) (interface{}, error) {
+func RetryWhenNotFound[T any](ctx context.Context, timeout time.Duration, f func() (T, error), optFns ...OptionsFunc) (T, error) {
 	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
 		if NotFound(err) {
 			return true, err
 		}
 
 		return false, err
-	})
+	}, optFns...)
 }
 
 // RetryWhenNewResourceNotFound retries the specified function when it returns a resource.NotFoundError and `isNewResource` is true.
-func RetryWhenNewResourceNotFound(ctx context.Context, timeout time.Duration, f func() (interface{}, error), isNewResource bool) (interface{}, error) {
+func RetryWhenNewResourceNotFound[T any](ctx context.Context, timeout time.Duration, f func() (T, error), isNewResource bool, optFns ...OptionsFunc) (T, error) {
 	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
 		if isNewResource && NotFound(err) {
 			return true, err
 		}
 
 		return false, err
-	})
+	}, optFns...)
 }
 
 type Options struct {
@@ -118,6 +128,60 @@ type Options struct {
 	PollInterval              time.Duration // Override MinPollInterval/backoff and only poll this often
 	NotFoundChecks            int           // Number of times to allow not found (nil result from Refresh)
 	ContinuousTargetOccurence int           // Number of times the Target state has to occur continuously
+	Backoff                   *Backoff      // Override MinPollInterval with exponential backoff and jitter
+	MaxPolls                  int           // Maximum number of refreshes before timing out
+	SlowPollThreshold         time.Duration // Time after the first refresh from which to only poll every SlowPollInterval
+	SlowPollInterval          time.Duration // Override backoff and only poll this often once SlowPollThreshold has elapsed
+	TargetPollInterval        time.Duration // Override backoff and slow polling and only poll this often while the Target state occurs, to confirm ContinuousTargetOccurence
+}
+
+// Backoff configures exponential backoff with jitter between refreshes.
+type Backoff struct {
+	Initial    time.Duration // Time to wait after the first refresh
+	Max        time.Duration // Largest time to wait between refreshes
+	Multiplier float64       // Factor by which the time to wait grows after each refresh
+	Jitter     float64       // Fraction, between 0 and 1, of the time to wait by which it is randomly varied
+}
+
+// maxPollInterval is the largest poll interval honored by resource.StateChangeConf.
+const maxPollInterval = 180*time.Second - time.Millisecond
+
+// interval returns the time to wait after the specified refresh (counting from 1), before jitter.
+func (b Backoff) interval(poll int) time.Duration {
+	interval := float64(b.Initial)
+	max := float64(b.Max)
+	if max <= 0 || max > float64(maxPollInterval) {
+		max = float64(maxPollInterval)
+	}
+
+	for i := 1; i < poll && b.Multiplier > 1 && interval < max; i++ {
+		interval *= b.Multiplier
+	}
+
+	if interval > max {
+		interval = max
+	}
+
+	return time.Duration(interval)
+}
+
+// jitter randomly varies the specified interval by up to the specified fraction of its value.
+func jitter(interval time.Duration, fraction float64) time.Duration {
+	if fraction <= 0 {
+		return interval
+	}
+
+	if fraction > 1 {
+		fraction = 1
+	}
+
+	interval = time.Duration(float64(interval) * (1 + fraction*(2*rand.Float64()-1))) //nolint:gosec // Jitter need not be cryptographically random.
+
+	if interval > maxPollInterval {
+		interval = maxPollInterval
+	}
+
+	return interval
 }
 
 func (o Options) Apply(c *resource.StateChangeConf) {
@@ -140,6 +204,72 @@ func (o Options) Apply(c *resource.StateChangeConf) {
 	if o.ContinuousTargetOccurence > 0 {
 		c.ContinuousTargetOccurence = o.ContinuousTargetOccurence
 	}
+
+	if c.Refresh != nil && (o.Backoff != nil || o.MaxPolls > 0 || o.SlowPollInterval > 0 || o.TargetPollInterval > 0) {
+		c.Refresh = o.refresh(c, c.Refresh)
+	}
+}
+
+// refresh wraps the specified StateChangeConf's refresh function to enforce MaxPolls and to set the PollInterval for the next refresh.
+// StateChangeConf reads PollInterval after each refresh in the goroutine that calls the refresh function.
+func (o Options) refresh(c *resource.StateChangeConf, f resource.StateRefreshFunc) resource.StateRefreshFunc {
+	var polls int
+	var lastState string
+	var start time.Time
+
+	if o.Backoff != nil && o.Backoff.Initial > 0 && o.PollInterval == 0 {
+		c.PollInterval = jitter(o.Backoff.interval(1), o.Backoff.Jitter)
+	}
+
+	return func() (interface{}, string, error) {
+		if o.MaxPolls > 0 && polls >= o.MaxPolls {
+			// As if the timeout had elapsed.
+			return nil, "", &resource.TimeoutError{
+				LastState:     lastState,
+				ExpectedState: c.Target,
+			}
+		}
+
+		if polls == 0 {
+			start = time.Now()
+		}
+		polls++
+
+		result, state, err := f()
+		lastState = state
+
+		var interval time.Duration
+		switch {
+		case o.TargetPollInterval > 0 && err == nil && targetOccurred(c, result, state):
+			interval = o.TargetPollInterval
+		case o.SlowPollInterval > 0 && time.Since(start) >= o.SlowPollThreshold:
+			interval = o.SlowPollInterval
+		case o.Backoff != nil && o.Backoff.Initial > 0 && o.PollInterval == 0:
+			interval = jitter(o.Backoff.interval(polls+1), o.Backoff.Jitter)
+		}
+
+		if interval > 0 {
+			c.PollInterval = interval
+		}
+
+		return result, state, err
+	}
+}
+
+// targetOccurred returns whether the specified refresh result is one of the StateChangeConf's Target states.
+// A StateChangeConf without Target states waits for the result to be nil, e.g. for a resource to be deleted.
+func targetOccurred(c *resource.StateChangeConf, result interface{}, state string) bool {
+	if len(c.Target) == 0 {
+		return result == nil
+	}
+
+	for _, v := range c.Target {
+		if v == state {
+			return true
+		}
+	}
+
+	return false
 }
 
 type OptionsFunc func(*Options)
@@ -181,6 +311,43 @@ func WithContinuousTargetOccurence(continuousTargetOccurence int) OptionsFunc {
 	}
 }
 
+// WithBackoff polls with exponential backoff: the time to wait after the first refresh is initial,
+// multiplied by multiplier after each further refresh up to max, and randomly varied by up to jitter (a fraction between 0 and 1) of its value.
+// The time to wait is at most 3 minutes, the largest poll interval honored by resource.StateChangeConf.
+func WithBackoff(initial, max time.Duration, multiplier, jitter float64) OptionsFunc {
+	return func(o *Options) {
+		o.Backoff = &Backoff{
+			Initial:    initial,
+			Max:        max,
+			Multiplier: multiplier,
+			Jitter:     jitter,
+		}
+	}
+}
+
+// WithMaxPolls times out, as if the timeout had elapsed, after the specified number of refreshes.
+func WithMaxPolls(maxPolls int) OptionsFunc {
+	return func(o *Options) {
+		o.MaxPolls = maxPolls
+	}
+}
+
+// WithSlowPollAfter only polls every interval once threshold has elapsed since the first refresh.
+func WithSlowPollAfter(threshold, interval time.Duration) OptionsFunc {
+	return func(o *Options) {
+		o.SlowPollThreshold = threshold
+		o.SlowPollInterval = interval
+	}
+}
+
+// WithTargetPollInterval only polls every interval while the Target state occurs.
+// Use with ContinuousTargetOccurence so that a long backoff or slow poll interval doesn't delay confirming the Target state.
+func WithTargetPollInterval(interval time.Duration) OptionsFunc {
+	return func(o *Options) {
+		o.TargetPollInterval = interval
+	}
+}
+
 // Retry allows configuration of StateChangeConf's various time arguments.
 // This is especially useful for AWS services that are prone to throttling, such as Route53, where
 // the default durations cause problems.
@@ -195,25 +362,41 @@ func Retry(ctx context.Context, timeout time.Duration, f resource.RetryFunc, opt
 		fn(&options)
 	}
 
+	w := startWaitProgress(ctx, "retry")
+
+	var polls int
 	c := &resource.StateChangeConf{
 		Pending:    []string{"retryableerror"},
 		Target:     []string{"success"},
 		Timeout:    timeout,
 		MinTimeout: 500 * time.Millisecond,
 		Refresh: func() (interface{}, string, error) {
+			if err := throttling.Wait(ctx); err != nil {
+				return nil, "quit", err
+			}
+
+			polls++
+			_, span := tracing.Start(ctx, "tfresource.Retry", tracing.AttrTFPoll.Int(polls))
 			rerr := f()
+			if rerr != nil {
+				tracing.End(span, rerr.Err)
+			} else {
+				tracing.End(span, nil)
+			}
 
 			resultErrMu.Lock()
 			defer resultErrMu.Unlock()
 
 			if rerr == nil {
 				resultErr = nil
+				w.observe(ctx, "success")
 				return 42, "success", nil
 			}
 
 			resultErr = rerr.Err
 
 			if rerr.Retryable {
+				w.observe(ctx, "retryableerror")
 				return 42, "retryableerror", nil
 			}
 
@@ -225,6 +408,8 @@ func Retry(ctx context.Context, timeout time.Duration, f resource.RetryFunc, opt
 
 	_, waitErr := c.WaitForStateContext(ctx)
 
+	w.finish(ctx, waitErr)
+
 	// Need to acquire the lock here to be able to avoid race using resultErr as
 	// the return value
 	resultErrMu.Lock()
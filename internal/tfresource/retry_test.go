@@ -3,6 +3,7 @@ package tfresource_test
 import (
 	"errors"
 	"fmt"
+	"reflect"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -13,6 +14,92 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+func TestRetryWhen(t *testing.T) {
+	ctx := acctest.Context(t)
+	t.Parallel()
+
+	type output struct {
+		Value string
+	}
+
+	testCases := []struct {
+		Name          string
+		Errors        []error
+		ExpectedCalls int32
+		ExpectError   bool
+	}{
+		{
+			Name:          "no error",
+			ExpectedCalls: 1,
+		},
+		{
+			Name:          "retryable error then success",
+			Errors:        []error{errors.New("retryable"), errors.New("retryable")},
+			ExpectedCalls: 3,
+		},
+		{
+			Name:          "non-retryable error",
+			Errors:        []error{errors.New("retryable"), errors.New("non-retryable")},
+			ExpectedCalls: 2,
+			ExpectError:   true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls int32
+
+			got, err := tfresource.RetryWhen(ctx, 5*time.Second,
+				func() (*output, error) {
+					n := atomic.AddInt32(&calls, 1)
+
+					if int(n) <= len(testCase.Errors) {
+						return &output{Value: "partial"}, testCase.Errors[n-1]
+					}
+
+					return &output{Value: "success"}, nil
+				},
+				func(err error) (bool, error) {
+					if err != nil && err.Error() == "retryable" {
+						return true, err
+					}
+
+					return false, err
+				},
+				tfresource.WithPollInterval(10*time.Millisecond),
+			)
+
+			if got, want := atomic.LoadInt32(&calls), testCase.ExpectedCalls; got != want {
+				t.Errorf("calls: got %d, expected %d", got, want)
+			}
+
+			if testCase.ExpectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				if got != nil {
+					t.Errorf("expected zero value output, got %v", got)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			// No type assertion is needed.
+			if got, want := got.Value, "success"; got != want {
+				t.Errorf("output: got %s, expected %s", got, want)
+			}
+		})
+	}
+}
+
 //nolint:tparallel
 func TestRetryWhenAWSErrCodeEquals(t *testing.T) { // nosemgrep:ci.aws-in-func-name
 	ctx := acctest.Context(t)
@@ -80,6 +167,37 @@ func TestRetryWhenAWSErrCodeEquals(t *testing.T) { // nosemgrep:ci.aws-in-func-n
 	}
 }
 
+func TestRetryWhenAWSErrCodeEqualsWithOptions(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	ctx := acctest.Context(t)
+	t.Parallel()
+
+	var calls int32
+
+	got, err := tfresource.RetryWhenAWSErrCodeEqualsWithOptions(ctx, 5*time.Second,
+		func() (string, error) {
+			if atomic.AddInt32(&calls, 1) == 1 {
+				return "", awserr.New("TestCode1", "TestMessage", nil)
+			}
+
+			return "success", nil
+		},
+		[]string{"TestCode1", "TestCode2"},
+		tfresource.WithPollInterval(10*time.Millisecond),
+	)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := atomic.LoadInt32(&calls), int32(2); got != want {
+		t.Errorf("calls: got %d, expected %d", got, want)
+	}
+
+	if got, want := got, "success"; got != want {
+		t.Errorf("output: got %s, expected %s", got, want)
+	}
+}
+
 //nolint:tparallel
 func TestRetryWhenAWSErrMessageContains(t *testing.T) { // nosemgrep:ci.aws-in-func-name
 	ctx := acctest.Context(t)
@@ -474,3 +592,119 @@ func TestOptionsApply(t *testing.T) {
 		})
 	}
 }
+
+func TestOptionsApplyPolling(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		options  []tfresource.OptionsFunc
+		polls    int
+		states   []string        // State returned by each refresh, "pending" by default.
+		expected []time.Duration // PollInterval after each refresh.
+		timeout  bool
+	}{
+		"Backoff": {
+			options:  []tfresource.OptionsFunc{tfresource.WithBackoff(1*time.Second, 10*time.Second, 2, 0)},
+			polls:    6,
+			expected: []time.Duration{2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second, 10 * time.Second},
+		},
+		"Backoff maximum poll interval": {
+			options:  []tfresource.OptionsFunc{tfresource.WithBackoff(1*time.Minute, 1*time.Hour, 3, 0)},
+			polls:    3,
+			expected: []time.Duration{180*time.Second - time.Millisecond, 180*time.Second - time.Millisecond, 180*time.Second - time.Millisecond},
+		},
+		"PollInterval overrides Backoff": {
+			options:  []tfresource.OptionsFunc{tfresource.WithBackoff(1*time.Second, 10*time.Second, 2, 0), tfresource.WithPollInterval(30 * time.Second)},
+			polls:    2,
+			expected: []time.Duration{30 * time.Second, 30 * time.Second},
+		},
+		"MaxPolls": {
+			options:  []tfresource.OptionsFunc{tfresource.WithBackoff(1*time.Second, 1*time.Second, 1, 0), tfresource.WithMaxPolls(2)},
+			polls:    3,
+			expected: []time.Duration{1 * time.Second, 1 * time.Second},
+			timeout:  true,
+		},
+		"SlowPollAfter": {
+			options:  []tfresource.OptionsFunc{tfresource.WithBackoff(1*time.Second, 1*time.Minute, 2, 0), tfresource.WithSlowPollAfter(0, 2*time.Minute)},
+			polls:    2,
+			expected: []time.Duration{2 * time.Minute, 2 * time.Minute},
+		},
+		"TargetPollInterval": {
+			options:  []tfresource.OptionsFunc{tfresource.WithBackoff(1*time.Second, 1*time.Minute, 2, 0), tfresource.WithSlowPollAfter(0, 2*time.Minute), tfresource.WithTargetPollInterval(10 * time.Second)},
+			polls:    4,
+			states:   []string{"pending", "done", "done", "pending"},
+			expected: []time.Duration{2 * time.Minute, 10 * time.Second, 10 * time.Second, 2 * time.Minute},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var options tfresource.Options
+			for _, fn := range testCase.options {
+				fn(&options)
+			}
+
+			var polls int
+			conf := resource.StateChangeConf{
+				Target: []string{"done"},
+				Refresh: func() (interface{}, string, error) {
+					state := "pending"
+					if polls < len(testCase.states) {
+						state = testCase.states[polls]
+					}
+					polls++
+
+					return 42, state, nil
+				},
+			}
+
+			options.Apply(&conf)
+
+			var err error
+			var intervals []time.Duration
+			for i := 0; i < testCase.polls; i++ {
+				if _, _, err = conf.Refresh(); err != nil {
+					break
+				}
+
+				intervals = append(intervals, conf.PollInterval)
+			}
+
+			if got, want := tfresource.TimedOut(err), testCase.timeout; got != want {
+				t.Errorf("timed out: expected %t, got %t (%v)", want, got, err)
+			}
+
+			if got, want := intervals, testCase.expected; !reflect.DeepEqual(got, want) {
+				t.Errorf("PollInterval: expected %s, got %s", want, got)
+			}
+		})
+	}
+}
+
+func TestOptionsApplyBackoffJitter(t *testing.T) {
+	t.Parallel()
+
+	var options tfresource.Options
+	tfresource.WithBackoff(10*time.Second, 10*time.Second, 1, 0.5)(&options)
+
+	conf := resource.StateChangeConf{
+		Refresh: func() (interface{}, string, error) {
+			return 42, "pending", nil
+		},
+	}
+
+	options.Apply(&conf)
+
+	for i := 0; i < 100; i++ {
+		if _, _, err := conf.Refresh(); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got := conf.PollInterval; got < 5*time.Second || got > 15*time.Second {
+			t.Fatalf("PollInterval: expected between 5s and 15s, got %s", got)
+		}
+	}
+}
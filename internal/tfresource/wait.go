@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/throttling"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
 )
 
 type WaitOpts struct {
@@ -23,20 +25,35 @@ const (
 // WaitUntil waits for the function `f` to return `true`.
 // If `f` returns an error, return immediately with that error.
 // If `timeout` is exceeded before `f` returns `true`, return an error.
+// The result of the call to `f` that returned `true` is returned as its own type, `T`.
 // Waits between calls to `f` using exponential backoff, except when waiting for the target state to reoccur.
-func WaitUntil(ctx context.Context, timeout time.Duration, f func() (bool, error), opts WaitOpts) error {
+// Also backs off while any AWS service called during the resource operation is throttled.
+func WaitUntil[T any](ctx context.Context, timeout time.Duration, f func() (T, bool, error), opts WaitOpts) (T, error) {
+	w := startWaitProgress(ctx, "wait")
+
+	var polls int
 	refresh := func() (interface{}, string, error) {
-		done, err := f()
+		if err := throttling.Wait(ctx); err != nil {
+			return nil, targetStateError, err
+		}
+
+		polls++
+		_, span := tracing.Start(ctx, "tfresource.WaitUntil", tracing.AttrTFPoll.Int(polls))
+		output, done, err := f()
+		tracing.End(span, err)
 
 		if err != nil {
 			return nil, targetStateError, err
 		}
 
+		// A nil result is treated by StateChangeConf as not found, so the result is always wrapped.
 		if done {
-			return "", targetStateTrue, nil
+			w.observe(ctx, targetStateTrue)
+			return &output, targetStateTrue, nil
 		}
 
-		return "", targetStateFalse, nil
+		w.observe(ctx, targetStateFalse)
+		return &output, targetStateFalse, nil
 	}
 
 	stateConf := &resource.StateChangeConf{
@@ -50,7 +67,19 @@ func WaitUntil(ctx context.Context, timeout time.Duration, f func() (bool, error
 		PollInterval:              opts.PollInterval,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	w.finish(ctx, err)
+
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	if output, ok := outputRaw.(*T); ok {
+		return *output, nil
+	}
 
-	return err
+	var zero T
+	return zero, nil
 }
@@ -18,38 +18,41 @@ func TestWaitUntil(t *testing.T) { //nolint:tparallel
 
 	testCases := []struct {
 		Name        string
-		F           func() (bool, error)
+		F           func() (int, bool, error)
 		ExpectError bool
+		Expected    int
 	}{
 		{
 			Name: "no error",
-			F: func() (bool, error) {
-				return true, nil
+			F: func() (int, bool, error) {
+				return 1, true, nil
 			},
+			Expected: 1,
 		},
 		{
 			Name: "immediate error",
-			F: func() (bool, error) {
-				return false, errors.New("TestCode")
+			F: func() (int, bool, error) {
+				return 0, false, errors.New("TestCode")
 			},
 			ExpectError: true,
 		},
 		{
 			Name: "never reaches state",
-			F: func() (bool, error) {
-				return false, nil
+			F: func() (int, bool, error) {
+				return 0, false, nil
 			},
 			ExpectError: true,
 		},
 		{
 			Name: "retry then success",
-			F: func() (bool, error) {
+			F: func() (int, bool, error) {
 				if atomic.CompareAndSwapInt32(&retryCount, 0, 1) {
-					return true, nil
+					return 1, true, nil
 				}
 
-				return false, nil
+				return 0, false, nil
 			},
+			Expected: 1,
 		},
 	}
 
@@ -57,13 +60,17 @@ func TestWaitUntil(t *testing.T) { //nolint:tparallel
 		t.Run(testCase.Name, func(t *testing.T) {
 			retryCount = 0
 
-			err := tfresource.WaitUntil(ctx, 5*time.Second, testCase.F, tfresource.WaitOpts{})
+			got, err := tfresource.WaitUntil(ctx, 5*time.Second, testCase.F, tfresource.WaitOpts{})
 
 			if testCase.ExpectError && err == nil {
 				t.Fatal("expected error")
 			} else if !testCase.ExpectError && err != nil {
 				t.Fatalf("unexpected error: %s", err)
 			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %d, expected %d", got, testCase.Expected)
+			}
 		})
 	}
 }
@@ -0,0 +1,68 @@
+package tfresource
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"golang.org/x/exp/slices"
+)
+
+// Waiter waits for a resource to reach one of its target statuses, or to be deleted.
+// It replaces a hand-written status function wrapping a finder in a resource.StateChangeConf.
+type Waiter[T any] struct {
+	Find          func(context.Context) (T, error) // Finds the resource; returns a NotFound error if the resource does not exist
+	Status        func(T) string                   // Returns the resource's status
+	Pending       []string                         // Statuses in which to keep waiting
+	Target        []string                         // Statuses in which to stop waiting; empty to wait for the resource to be deleted
+	FailureStates []string                         // Terminal statuses in which the operation being waited for has failed
+	FailureReason func(T) error                    // Returns why the operation being waited for has failed, e.g. the AWS status reason, or nil if unknown
+}
+
+// Refresh returns a resource.StateRefreshFunc that finds the resource and returns its status.
+// A resource that is not found is reported as deleted.
+func (w Waiter[T]) Refresh(ctx context.Context) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := w.Find(ctx)
+
+		if NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, w.Status(output), nil
+	}
+}
+
+// Wait waits for the resource to reach one of the target statuses, or to be deleted if there are none, and returns the last resource found.
+// If the resource reaches one of the failure statuses, the returned error's LastError is set to the failure reason.
+func (w Waiter[T]) Wait(ctx context.Context, timeout time.Duration, optFns ...OptionsFunc) (T, error) {
+	var options Options
+	for _, fn := range optFns {
+		fn(&options)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: w.Pending,
+		Target:  w.Target,
+		Refresh: w.Refresh(ctx),
+		Timeout: timeout,
+	}
+	options.Apply(stateConf)
+
+	outputRaw, err := WaitForStateContext(ctx, stateConf)
+
+	if output, ok := outputRaw.(T); ok {
+		if err != nil && w.FailureReason != nil && slices.Contains(w.FailureStates, w.Status(output)) {
+			SetLastError(err, w.FailureReason(output))
+		}
+
+		return output, err
+	}
+
+	var zero T
+	return zero, err
+}
@@ -0,0 +1,119 @@
+package tfresource_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type testWaiterResource struct {
+	status string
+	reason string
+}
+
+func TestWaiterWait(t *testing.T) { //nolint:tparallel
+	ctx := acctest.Context(t)
+	t.Parallel()
+
+	errFind := errors.New("find failed")
+
+	testCases := map[string]struct {
+		statuses       []string // Status on each find; "" if the resource is not found.
+		findErr        error
+		target         []string
+		expectedStatus string
+		expectedErr    error
+		expectedReason string
+	}{
+		"target": {
+			statuses:       []string{"CREATING", "CREATING", "ACTIVE"},
+			target:         []string{"ACTIVE"},
+			expectedStatus: "ACTIVE",
+		},
+		"deleted": {
+			statuses: []string{"DELETING", ""},
+			target:   []string{},
+		},
+		"failure state": {
+			statuses:       []string{"CREATING", "FAILED"},
+			target:         []string{"ACTIVE"},
+			expectedStatus: "FAILED",
+			expectedReason: "insufficient capacity",
+		},
+		"find error": {
+			statuses:    []string{"CREATING"},
+			findErr:     errFind,
+			target:      []string{"ACTIVE"},
+			expectedErr: errFind,
+		},
+	}
+
+	for name, testCase := range testCases { //nolint:paralleltest
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			var finds int
+
+			w := tfresource.Waiter[*testWaiterResource]{
+				Find: func(context.Context) (*testWaiterResource, error) {
+					status := testCase.statuses[finds]
+					if finds < len(testCase.statuses)-1 {
+						finds++
+					} else if testCase.findErr != nil {
+						return nil, testCase.findErr
+					}
+
+					if status == "" {
+						return nil, &resource.NotFoundError{}
+					}
+
+					return &testWaiterResource{status: status, reason: "insufficient capacity"}, nil
+				},
+				Status: func(output *testWaiterResource) string {
+					return output.status
+				},
+				Pending:       []string{"CREATING", "DELETING"},
+				Target:        testCase.target,
+				FailureStates: []string{"FAILED"},
+				FailureReason: func(output *testWaiterResource) error {
+					return errors.New(output.reason)
+				},
+			}
+
+			output, err := w.Wait(ctx, 1*time.Minute, tfresource.WithPollInterval(1*time.Millisecond))
+
+			var status string
+			if output != nil {
+				status = output.status
+			}
+
+			if got, want := status, testCase.expectedStatus; got != want {
+				t.Errorf("status: expected %q, got %q", want, got)
+			}
+
+			switch {
+			case testCase.expectedReason != "":
+				var e *resource.UnexpectedStateError
+				if !errors.As(err, &e) {
+					t.Fatalf("expected UnexpectedStateError, got %v", err)
+				}
+
+				if e.LastError == nil || e.LastError.Error() != testCase.expectedReason {
+					t.Errorf("LastError: expected %q, got %v", testCase.expectedReason, e.LastError)
+				}
+
+			case testCase.expectedErr != nil:
+				if !errors.Is(err, testCase.expectedErr) {
+					t.Errorf("expected %v, got %v", testCase.expectedErr, err)
+				}
+
+			case err != nil:
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,219 @@
+// Package throttling slows down the provider's retry and wait loops when AWS throttles its API calls.
+//
+// Throttling errors are observed per AWS service and Region, across all of the provider's API clients.
+// Each throttle increases the service's backoff delay, up to a maximum, and the delay decreases gradually when no throttles are observed.
+// Retry and wait loops wait for the delay of each service that the resource operation has called before polling again.
+package throttling
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// initialDelay is the backoff delay after the first throttle.
+	initialDelay = 1 * time.Second
+	// maxDelay is the maximum backoff delay.
+	maxDelay = 1 * time.Minute
+	// recoveryInterval is the interval without throttles after which the backoff delay is halved.
+	recoveryInterval = 15 * time.Second
+)
+
+// key identifies an AWS service in a Region.
+type key struct {
+	service string
+	region  string
+}
+
+// state is the backoff state of an AWS service in a Region.
+type state struct {
+	delay        time.Duration // Backoff delay as of the last throttle.
+	lastThrottle time.Time
+	throttles    int
+	backoff      time.Duration // Total time spent in backoff.
+}
+
+// Controller keeps the backoff state of the AWS services called by the provider.
+type Controller struct {
+	mu     sync.Mutex
+	now    func() time.Time
+	states map[key]*state
+}
+
+// NewController returns a new Controller.
+func NewController() *Controller {
+	return &Controller{
+		now:    time.Now,
+		states: make(map[key]*state),
+	}
+}
+
+// DefaultController is the Controller shared by all provider configurations in the process.
+var DefaultController = NewController()
+
+// Throttled records a throttling error from the specified AWS service in the specified Region.
+func (c *Controller) Throttled(service, region string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	k := key{service: service, region: region}
+	s, ok := c.states[k]
+
+	if !ok {
+		s = &state{}
+		c.states[k] = s
+	}
+
+	delay := s.currentDelay(now) * 2
+	if delay < initialDelay {
+		delay = initialDelay
+	}
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+
+	s.delay = delay
+	s.lastThrottle = now
+	s.throttles++
+}
+
+// Delay returns the current backoff delay for the specified AWS service in the specified Region.
+func (c *Controller) Delay(service, region string) time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if s, ok := c.states[key{service: service, region: region}]; ok {
+		return s.currentDelay(c.now())
+	}
+
+	return 0
+}
+
+// currentDelay returns the backoff delay, halved for each recoveryInterval since the last throttle.
+func (s *state) currentDelay(now time.Time) time.Duration {
+	delay := s.delay
+
+	for elapsed := now.Sub(s.lastThrottle); elapsed >= recoveryInterval && delay > 0; elapsed -= recoveryInterval {
+		if delay /= 2; delay < initialDelay {
+			delay = 0
+		}
+	}
+
+	return delay
+}
+
+// Wait waits for the longest backoff delay of the AWS services called during the resource operation.
+// It returns early with the context's error if the context is done.
+func (c *Controller) Wait(ctx context.Context) error {
+	op, ok := ctx.Value(operationKey{}).(*operation)
+
+	if !ok {
+		return nil
+	}
+
+	keys := op.calledKeys()
+
+	c.mu.Lock()
+
+	now := c.now()
+
+	var delay time.Duration
+	var k key
+	var s *state
+
+	for _, v := range keys {
+		if st, ok := c.states[v]; ok {
+			if d := st.currentDelay(now); d > delay {
+				delay, k, s = d, v, st
+			}
+		}
+	}
+
+	if delay == 0 {
+		c.mu.Unlock()
+
+		return nil
+	}
+
+	s.backoff += delay
+	throttles, backoff := s.throttles, s.backoff
+
+	c.mu.Unlock()
+
+	tflog.Info(ctx, "Backing off for throttled AWS service", map[string]any{
+		"aws_service":   k.service,
+		"aws_region":    k.region,
+		"delay":         delay.String(),
+		"throttles":     throttles,
+		"total_backoff": backoff.String(),
+	})
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+type operationKey struct{}
+
+// operation holds the AWS services called during a resource operation.
+type operation struct {
+	mu   sync.Mutex
+	keys []key
+}
+
+// NewContext returns a copy of the specified context that records the AWS services called during a resource operation.
+func NewContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, operationKey{}, &operation{})
+}
+
+// Called records that the resource operation has called the specified AWS service in the specified Region.
+func Called(ctx context.Context, service, region string) {
+	op, ok := ctx.Value(operationKey{}).(*operation)
+
+	if !ok {
+		return
+	}
+
+	k := key{service: service, region: region}
+
+	op.mu.Lock()
+	defer op.mu.Unlock()
+
+	for _, v := range op.keys {
+		if v == k {
+			return
+		}
+	}
+
+	op.keys = append(op.keys, k)
+}
+
+func (op *operation) calledKeys() []key {
+	op.mu.Lock()
+	defer op.mu.Unlock()
+
+	keys := make([]key, len(op.keys))
+	copy(keys, op.keys)
+
+	return keys
+}
+
+// Throttled records a throttling error from the specified AWS service in the specified Region with DefaultController.
+func Throttled(service, region string) {
+	DefaultController.Throttled(service, region)
+}
+
+// Wait waits for the longest backoff delay, as kept by DefaultController, of the AWS services called during the resource operation.
+func Wait(ctx context.Context) error {
+	return DefaultController.Wait(ctx)
+}
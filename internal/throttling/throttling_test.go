@@ -0,0 +1,90 @@
+package throttling
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestControllerDelay(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	c := NewController()
+	c.now = func() time.Time { return now }
+
+	if got, want := c.Delay("EC2", "us-west-2"), time.Duration(0); got != want { //lintignore:AWSAT003
+		t.Errorf("initial delay = %s, want %s", got, want)
+	}
+
+	steps := []struct {
+		Name      string
+		Advance   time.Duration
+		Throttles int
+		Expected  time.Duration
+	}{
+		{Name: "first throttle", Throttles: 1, Expected: 1 * time.Second},
+		{Name: "more throttles", Throttles: 3, Expected: 8 * time.Second},
+		{Name: "not yet recovering", Advance: recoveryInterval - time.Second, Expected: 8 * time.Second},
+		{Name: "recovering", Advance: time.Second, Expected: 4 * time.Second},
+		{Name: "recovering further", Advance: 2 * recoveryInterval, Expected: 1 * time.Second},
+		{Name: "throttled while recovering", Throttles: 1, Expected: 2 * time.Second},
+		{Name: "recovered", Advance: 2 * recoveryInterval, Expected: 0},
+		{Name: "maximum", Throttles: 10, Expected: maxDelay},
+	}
+
+	for _, step := range steps {
+		now = now.Add(step.Advance)
+
+		for i := 0; i < step.Throttles; i++ {
+			c.Throttled("EC2", "us-west-2") //lintignore:AWSAT003
+		}
+
+		if got, want := c.Delay("EC2", "us-west-2"), step.Expected; got != want { //lintignore:AWSAT003
+			t.Errorf("%s: delay = %s, want %s", step.Name, got, want)
+		}
+	}
+
+	// Other services and Regions are not affected.
+	if got, want := c.Delay("EC2", "us-east-1"), time.Duration(0); got != want { //lintignore:AWSAT003
+		t.Errorf("other Region delay = %s, want %s", got, want)
+	}
+
+	if got, want := c.Delay("RDS", "us-west-2"), time.Duration(0); got != want { //lintignore:AWSAT003
+		t.Errorf("other service delay = %s, want %s", got, want)
+	}
+}
+
+func TestControllerWait(t *testing.T) {
+	t.Parallel()
+
+	c := NewController()
+	ctx := NewContext(context.Background())
+
+	// No services called.
+	if err := c.Wait(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	Called(ctx, "EC2", "us-west-2") //lintignore:AWSAT003
+	Called(ctx, "RDS", "us-west-2") //lintignore:AWSAT003
+
+	c.Throttled("RDS", "us-west-2") //lintignore:AWSAT003
+
+	// The wait is interrupted by the context.
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	if err := c.Wait(cancelledCtx); err == nil {
+		t.Fatal("expected error")
+	}
+
+	if got, want := c.states[key{service: "RDS", region: "us-west-2"}].backoff, initialDelay; got != want { //lintignore:AWSAT003
+		t.Errorf("total backoff = %s, want %s", got, want)
+	}
+
+	// A context without a resource operation does not wait.
+	if err := c.Wait(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
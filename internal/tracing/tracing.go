@@ -0,0 +1,67 @@
+// Package tracing emits OpenTelemetry spans for provider operations.
+//
+// Spans are emitted through the TracerProvider carried in a context.
+// When a context carries no TracerProvider, i.e. when tracing is not enabled, no spans are started or emitted.
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// InstrumentationName is the name of the provider's OpenTelemetry instrumentation library.
+const InstrumentationName = "github.com/hashicorp/terraform-provider-aws"
+
+// Span attribute keys.
+const (
+	AttrAWSOperation   = attribute.Key("aws.operation")
+	AttrAWSRegion      = attribute.Key("aws.region")
+	AttrAWSRequestID   = attribute.Key("aws.request_id")
+	AttrAWSRetryCount  = attribute.Key("aws.retry_count")
+	AttrAWSService     = attribute.Key("aws.service")
+	AttrHTTPStatusCode = attribute.Key("http.status_code")
+	AttrTFOperation    = attribute.Key("tf.operation")
+	AttrTFPoll         = attribute.Key("tf.poll")
+	AttrTFResourceID   = attribute.Key("tf.resource_id")
+	AttrTFResourceType = attribute.Key("tf.resource_type")
+)
+
+type tracerProviderKey struct{}
+
+// NewContext returns a copy of the specified context carrying the specified TracerProvider.
+// Spans started with the returned context, or a context derived from it, are emitted through the TracerProvider.
+func NewContext(ctx context.Context, tp trace.TracerProvider) context.Context {
+	return context.WithValue(ctx, tracerProviderKey{}, tp)
+}
+
+// Enabled returns whether spans started with the specified context are emitted.
+func Enabled(ctx context.Context) bool {
+	_, ok := ctx.Value(tracerProviderKey{}).(trace.TracerProvider)
+
+	return ok
+}
+
+// Start starts a span with the specified name and attributes as a child of any span in the specified context.
+// If tracing is not enabled the context is returned unchanged with a no-op span.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	tp, ok := ctx.Value(tracerProviderKey{}).(trace.TracerProvider)
+
+	if !ok {
+		return ctx, trace.SpanFromContext(context.Background()) // No-op span.
+	}
+
+	return tp.Tracer(InstrumentationName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End ends the specified span, recording any error.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
@@ -0,0 +1,70 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestStartDisabled(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	got, span := Start(ctx, "test")
+
+	if got != ctx {
+		t.Errorf("expected context to be unchanged")
+	}
+
+	if span.IsRecording() {
+		t.Errorf("expected span not to be recording")
+	}
+
+	End(span, errors.New("test"))
+}
+
+func TestStart(t *testing.T) {
+	t.Parallel()
+
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	ctx := NewContext(context.Background(), tp)
+
+	if !Enabled(ctx) {
+		t.Fatalf("expected tracing to be enabled")
+	}
+
+	ctx, parent := Start(ctx, "parent", AttrTFResourceType.String("aws_test"))
+	_, child := Start(ctx, "child")
+
+	End(child, errors.New("failed"))
+	End(parent, nil)
+
+	spans := exporter.GetSpans()
+
+	if got, want := len(spans), 2; got != want {
+		t.Fatalf("spans: got %d, expected %d", got, want)
+	}
+
+	childSpan, parentSpan := spans[0], spans[1]
+
+	if got, want := childSpan.Parent.SpanID(), parentSpan.SpanContext.SpanID(); got != want {
+		t.Errorf("child's parent span ID: got %s, expected %s", got, want)
+	}
+
+	if got, want := childSpan.Status.Code, codes.Error; got != want {
+		t.Errorf("child status: got %s, expected %s", got, want)
+	}
+
+	if got, want := parentSpan.Status.Code, codes.Unset; got != want {
+		t.Errorf("parent status: got %s, expected %s", got, want)
+	}
+
+	if got, want := len(parentSpan.Attributes), 1; got != want {
+		t.Errorf("parent attributes: got %d, expected %d", got, want)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
@@ -20,17 +21,39 @@ import (
 // to those configured at the provider-level to avoid non-empty plans
 // after resource READ operations as resource and provider-level tags
 // will be indistinguishable when returned from an AWS API.
+// Also returns an error if the merged tags do not satisfy any required tags
+// configured at the provider-level or the tag constraints of the resource's AWS service.
+// Missing required tags are a plan warning instead if the required tags are warn-only.
 func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	resourceTags := tftags.New(ctx, diff.Get("tags").(map[string]interface{}))
 
-	if defaultTagsConfig.TagsEqual(resourceTags) {
+	if defaultTagsConfig.TagsEqual(ctx, resourceTags) {
 		return fmt.Errorf(`"tags" are identical to those in the "default_tags" configuration block of the provider: please de-duplicate and try again`)
 	}
 
-	allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
+	// Tags with unknown values can't be checked until apply.
+	if requiredTagsConfig := defaultTagsConfig.GetRequiredTags(); requiredTagsConfig != nil && diff.NewValueKnown("tags") {
+		if err := requiredTagsConfig.Check(tftags.ResourceTypeFromContext(ctx), defaultTagsConfig.MergeTags(ctx, resourceTags)); err != nil {
+			if !requiredTagsConfig.WarnOnly {
+				return err
+			}
+
+			sdkdiag.AddWarning(ctx, "Required tags", err.Error())
+		}
+	}
+
+	if diff.NewValueKnown("tags") {
+		resourceType := tftags.ResourceTypeFromContext(ctx)
+
+		if err := tftags.ConstraintsForResourceType(resourceType).Validate(resourceType, defaultTagsConfig.MergeTags(ctx, resourceTags)); err != nil {
+			return err
+		}
+	}
+
+	allTags := defaultTagsConfig.MergeTags(ctx, resourceTags).IgnoreConfig(ignoreTagsConfig)
 
 	// To ensure "tags_all" is correctly computed, we explicitly set the attribute diff
 	// when the merger of resource-level tags onto provider-level tags results in n > 0 tags,
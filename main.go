@@ -6,6 +6,7 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 )
 
@@ -35,6 +36,9 @@ func main() {
 		serveOpts...,
 	)
 
+	// Export any buffered trace spans before exiting.
+	conns.ShutdownTracing(context.Background())
+
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -18,6 +18,7 @@ import (
 	"encoding/csv"
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 )
 
@@ -60,6 +61,14 @@ type ServiceDatum struct {
 // serviceData key is the AWS provider service package
 var serviceData map[string]*ServiceDatum
 
+// resourcePrefixes are the TF resource name prefixes of the AWS provider service packages
+var resourcePrefixes []resourcePrefix
+
+type resourcePrefix struct {
+	providerPackage string
+	re              *regexp.Regexp
+}
+
 func init() {
 	serviceData = make(map[string]*ServiceDatum)
 
@@ -88,6 +97,13 @@ func readCSVIntoServiceData() error {
 			continue
 		}
 
+		// Services that live in another service's package, e.g. VPC, are excluded but their resources belong to that package.
+		if l[ColSplitPackageRealPackage] != "" {
+			if err := appendResourcePrefix(l[ColSplitPackageRealPackage], l); err != nil {
+				return err
+			}
+		}
+
 		if l[ColExclude] != "" {
 			continue
 		}
@@ -120,11 +136,69 @@ func readCSVIntoServiceData() error {
 		}
 
 		serviceData[p].Aliases = a
+
+		if l[ColSplitPackageRealPackage] == "" {
+			if err := appendResourcePrefix(p, l); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// appendResourcePrefix records the TF resource name prefix in the specified names_data.csv line as belonging to the specified provider package.
+func appendResourcePrefix(providerPackage string, l []string) error {
+	rp := l[ColResourcePrefixCorrect]
+
+	if l[ColResourcePrefixActual] != "" {
+		rp = l[ColResourcePrefixActual]
+	}
+
+	if rp == "" {
+		return nil
+	}
+
+	// Go regular expressions do not support negative lookahead; the longest prefix match takes precedence instead.
+	re, err := regexp.Compile(`^` + removeNegativeLookaheads(rp))
+	if err != nil {
+		return fmt.Errorf("reading CSV into service data: %s: %w", providerPackage, err)
 	}
 
+	resourcePrefixes = append(resourcePrefixes, resourcePrefix{providerPackage: providerPackage, re: re})
+
 	return nil
 }
 
+// removeNegativeLookaheads removes any negative lookahead groups, e.g. "(?!resolver_)", from the specified regular expression.
+func removeNegativeLookaheads(expr string) string {
+	for {
+		i := strings.Index(expr, "(?!")
+		if i < 0 {
+			return expr
+		}
+
+		depth, j := 0, i
+		for ; j < len(expr); j++ {
+			if expr[j] == '(' {
+				depth++
+			} else if expr[j] == ')' {
+				depth--
+			}
+
+			if depth == 0 {
+				break
+			}
+		}
+
+		if j == len(expr) {
+			return expr
+		}
+
+		expr = expr[:i] + expr[j+1:]
+	}
+}
+
 func ProviderPackageForAlias(serviceAlias string) (string, error) {
 	for k, v := range serviceData {
 		for _, hclKey := range v.Aliases {
@@ -137,6 +211,27 @@ func ProviderPackageForAlias(serviceAlias string) (string, error) {
 	return "", fmt.Errorf("unable to find service for service alias %s", serviceAlias)
 }
 
+// ProviderPackageForResourceType returns the AWS provider service package that implements the specified resource type,
+// i.e. the package whose TF resource name prefix is the longest match for the resource type.
+// Services that live in another service's package, e.g. VPC, return that package.
+func ProviderPackageForResourceType(resourceType string) (string, error) {
+	var providerPackage string
+	var longest int
+
+	for _, v := range resourcePrefixes {
+		if loc := v.re.FindStringIndex(resourceType); loc != nil && loc[1] > longest {
+			providerPackage = v.providerPackage
+			longest = loc[1]
+		}
+	}
+
+	if providerPackage == "" {
+		return "", fmt.Errorf("unable to find service for resource type %s", resourceType)
+	}
+
+	return providerPackage, nil
+}
+
 func ProviderPackages() []string {
 	keys := make([]string, len(serviceData))
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io/fs"
 	"os"
+	"regexp"
 	"testing"
 )
 
@@ -297,6 +298,109 @@ func TestProviderNameUpper(t *testing.T) {
 	}
 }
 
+func TestProviderPackageForResourceType(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName string
+		Input    string
+		Expected string
+		Error    bool
+	}{
+		{
+			TestName: "empty",
+			Input:    "",
+			Expected: "",
+			Error:    true,
+		},
+		{
+			TestName: "correct prefix",
+			Input:    "aws_sqs_queue",
+			Expected: SQS,
+			Error:    false,
+		},
+		{
+			TestName: "actual prefix",
+			Input:    "aws_instance",
+			Expected: EC2,
+			Error:    false,
+		},
+		{
+			TestName: "split package",
+			Input:    "aws_subnet",
+			Expected: EC2,
+			Error:    false,
+		},
+		{
+			TestName: "negative lookahead",
+			Input:    "aws_route53_zone",
+			Expected: Route53,
+			Error:    false,
+		},
+		{
+			TestName: "longest prefix",
+			Input:    "aws_route53_resolver_endpoint",
+			Expected: Route53Resolver,
+			Error:    false,
+		},
+		{
+			TestName: "doesnotexist",
+			Input:    "doesnotexist",
+			Expected: "",
+			Error:    true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ProviderPackageForResourceType(testCase.Input)
+
+			if err != nil && !testCase.Error {
+				t.Errorf("got error (%s), expected no error", err)
+			}
+
+			if err == nil && testCase.Error {
+				t.Errorf("got (%s) and no error, expected error", got)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestTagConstraints(t *testing.T) {
+	t.Parallel()
+
+	for providerPackage, v := range tagsData {
+		if _, ok := serviceData[providerPackage]; !ok {
+			t.Errorf("tags_data.csv: %s is not a provider package in names_data.csv", providerPackage)
+		}
+
+		if _, err := regexp.Compile(v.AllowedCharacters); err != nil {
+			t.Errorf("tags_data.csv: %s: AllowedCharacters: %s", providerPackage, err)
+		}
+	}
+
+	got, ok := TagConstraints(Route53)
+
+	if !ok {
+		t.Fatalf("no tag constraints found for %s", Route53)
+	}
+
+	if got.MaxTags != 50 {
+		t.Errorf("got %d, expected 50", got.MaxTags)
+	}
+
+	if _, ok := TagConstraints("doesnotexist"); ok {
+		t.Errorf("got tag constraints for doesnotexist, expected none")
+	}
+}
+
 func TestFullHumanFriendly(t *testing.T) {
 	t.Parallel()
 
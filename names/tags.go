@@ -0,0 +1,88 @@
+package names
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+)
+
+const (
+	colTagsProviderPackage   = 0
+	colTagsMaxTags           = 1
+	colTagsMaxKeyLength      = 2
+	colTagsMaxValueLength    = 3
+	colTagsAllowedCharacters = 4
+)
+
+// Type TagsDatum corresponds to columns in `tags_data.csv`, the constraints that
+// an AWS service places on the tags of its resources.
+// Zero values mean that the service uses the AWS-wide default.
+type TagsDatum struct {
+	AllowedCharacters string // Regular expression that tag keys and values must match
+	MaxKeyLength      int
+	MaxTags           int
+	MaxValueLength    int
+}
+
+// tagsData key is the AWS provider service package
+var tagsData map[string]*TagsDatum
+
+func init() {
+	tagsData = make(map[string]*TagsDatum)
+
+	// Data from tags_data.csv
+	if err := readCSVIntoTagsData(); err != nil {
+		log.Fatalf("reading CSV into tags data: %s", err)
+	}
+}
+
+//go:embed tags_data.csv
+var tagsDataCSV string
+
+func readCSVIntoTagsData() error {
+	r := csv.NewReader(strings.NewReader(tagsDataCSV))
+
+	d, err := r.ReadAll()
+	if err != nil {
+		return fmt.Errorf("reading CSV into tags data: %w", err)
+	}
+
+	for i, l := range d {
+		if i < 1 { // omit header line
+			continue
+		}
+
+		datum := &TagsDatum{
+			AllowedCharacters: l[colTagsAllowedCharacters],
+		}
+
+		for col, v := range map[int]*int{
+			colTagsMaxTags:        &datum.MaxTags,
+			colTagsMaxKeyLength:   &datum.MaxKeyLength,
+			colTagsMaxValueLength: &datum.MaxValueLength,
+		} {
+			if l[col] == "" {
+				continue
+			}
+
+			if *v, err = strconv.Atoi(l[col]); err != nil {
+				return fmt.Errorf("reading CSV into tags data: %s: %w", l[colTagsProviderPackage], err)
+			}
+		}
+
+		tagsData[l[colTagsProviderPackage]] = datum
+	}
+
+	return nil
+}
+
+// TagConstraints returns the constraints that the specified provider package's
+// AWS service places on the tags of its resources, if any are recorded in `tags_data.csv`.
+func TagConstraints(providerPackage string) (*TagsDatum, bool) {
+	v, ok := tagsData[providerPackage]
+
+	return v, ok
+}